@@ -9,6 +9,7 @@ import (
 	"github.com/influenzanet/study-service/pkg/dbs/studydb"
 	gc "github.com/influenzanet/study-service/pkg/grpc/clients"
 	"github.com/influenzanet/study-service/pkg/grpc/service"
+	"github.com/influenzanet/study-service/pkg/studyengine"
 	"github.com/influenzanet/study-service/pkg/studytimer"
 	"github.com/influenzanet/study-service/pkg/types"
 )
@@ -17,6 +18,7 @@ func main() {
 	conf := config.InitConfig()
 
 	logger.SetLevel(conf.LogLevel)
+	studyengine.SetDefaultEvalLimits(conf.EvalLimits)
 
 	studyDBService := studydb.NewStudyDBService(conf.StudyDBConfig)
 	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
@@ -30,6 +32,13 @@ func main() {
 		logger.Info.Println("Timer task disabled")
 	}
 
+	if !conf.DisableExternalEventDelivery {
+		deliveryService := studytimer.NewExternalEventDeliveryService(conf.Study, studyDBService, globalDBService, conf.ExternalServices)
+		deliveryService.Run()
+	} else {
+		logger.Info.Println("External event delivery disabled")
+	}
+
 	clients := &types.APIClients{}
 
 	loggingClient, close := gc.ConnectToLoggingService(conf.ServiceURLs.LoggingService)
@@ -61,6 +70,10 @@ func ensureDBIndexes(gdb *globaldb.GlobalDBService, sdb *studydb.StudyDBService)
 	for _, i := range instances {
 		sdb.CreateSurveyDefintionIndexForAllStudies(i.InstanceID)
 		sdb.CreateMessageScheduledForIndexForAllStudies(i.InstanceID)
+		sdb.CreateScheduledActionsIndexForAllStudies(i.InstanceID)
+		sdb.CreateRandomizationAllocationsIndexForAllStudies(i.InstanceID)
+		sdb.CreateParticipantStateHistoryIndexForAllStudies(i.InstanceID)
+		sdb.CreateExternalEventOutboxIndexForAllStudies(i.InstanceID)
 		sdb.CreateParticipantIDIndexForAllStudies(i.InstanceID)
 		sdb.CreateUploadedAtIndexForStudyRulesCollection(i.InstanceID)
 		// TODO: ensure other indexes as well
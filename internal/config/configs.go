@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/studyengine"
 	"github.com/influenzanet/study-service/pkg/types"
 	"gopkg.in/yaml.v2"
 )
@@ -22,9 +23,11 @@ type Config struct {
 	ServiceURLs    struct {
 		LoggingService string
 	}
-	PersistentStoreConfig types.PersistentStoreConfig
-	ExternalServices      []types.ExternalService
-	DisableTimerTask      bool
+	PersistentStoreConfig        types.PersistentStoreConfig
+	ExternalServices             []types.ExternalService
+	DisableTimerTask             bool
+	DisableExternalEventDelivery bool
+	EvalLimits                   types.EvalLimits
 }
 
 func InitConfig() Config {
@@ -48,8 +51,10 @@ func InitConfig() Config {
 	conf.PersistentStoreConfig = getPersistentStoreConfig()
 
 	conf.ExternalServices = getExternalServicesConfig()
+	conf.EvalLimits = getEvalLimits()
 
 	conf.DisableTimerTask = os.Getenv("DISABLE_TIMER_TASK") == "true"
+	conf.DisableExternalEventDelivery = os.Getenv(ENV_DISABLE_EXTERNAL_EVENT_DELIVERY) == "true"
 	return conf
 }
 
@@ -106,9 +111,47 @@ func getStudyConfig() types.StudyConfig {
 	if err != nil {
 		logger.Error.Fatal("STUDY_TIMER_EVENT_CHECK_INTERVAL: " + err.Error())
 	}
+
+	studyConf.ExternalEventDeliveryInterval, err = strconv.Atoi(os.Getenv(ENV_EXTERNAL_EVENT_DELIVERY_INTERVAL))
+	if err != nil || studyConf.ExternalEventDeliveryInterval < 1 {
+		studyConf.ExternalEventDeliveryInterval = defaultExternalEventDeliveryInterval
+		logger.Info.Printf("using default external event delivery interval: %d seconds", studyConf.ExternalEventDeliveryInterval)
+	}
+
+	studyConf.ExternalEventRetentionDays = defaultExternalEventRetentionDays
+	if v := os.Getenv(ENV_EXTERNAL_EVENT_RETENTION_DAYS); v != "" {
+		studyConf.ExternalEventRetentionDays, err = strconv.Atoi(v)
+		if err != nil || studyConf.ExternalEventRetentionDays < 0 {
+			logger.Error.Fatal(ENV_EXTERNAL_EVENT_RETENTION_DAYS + ": must be a number of days, 0 keeps delivered events")
+		}
+	}
 	return studyConf
 }
 
+// getEvalLimits reads the limits for the evaluation of study rules, unset variables keep the default of the study engine
+func getEvalLimits() types.EvalLimits {
+	limits := studyengine.DefaultEvalLimits()
+	for _, v := range []struct {
+		env    string
+		target *int
+	}{
+		{ENV_RULES_MAX_DEPTH, &limits.MaxDepth},
+		{ENV_RULES_MAX_NODES, &limits.MaxNodes},
+		{ENV_RULES_TIMEOUT, &limits.TimeoutSeconds},
+	} {
+		if os.Getenv(v.env) == "" {
+			continue
+		}
+		val, err := strconv.Atoi(os.Getenv(v.env))
+		if err != nil || val < 0 {
+			logger.Error.Fatalf("%s: should be a number >= 0 (0 disables the limit)", v.env)
+		}
+		*v.target = val
+	}
+	logger.Info.Printf("limits for the evaluation of study rules: max depth %d, max nodes %d, timeout %d seconds", limits.MaxDepth, limits.MaxNodes, limits.TimeoutSeconds)
+	return limits
+}
+
 func getStudyDBConfig() types.DBConfig {
 	connStr := os.Getenv("STUDY_DB_CONNECTION_STR")
 	username := os.Getenv("STUDY_DB_USERNAME")
@@ -200,5 +243,10 @@ func getExternalServicesConfig() []types.ExternalService {
 		logger.Error.Fatalf("cannot parse external services config file: %v ", err)
 		return []types.ExternalService{}
 	}
+	for _, service := range services.Services {
+		if service.Protocol == types.EXTERNAL_SERVICE_PROTOCOL_GRPC && service.SigningSecret != "" {
+			logger.Error.Fatalf("external service '%s': signingSecret is not supported with protocol grpc, use mTLS instead", service.Name)
+		}
+	}
 	return services.Services
 }
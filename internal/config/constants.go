@@ -1,14 +1,22 @@
 package config
 
 const (
-	ENV_GRPC_MAX_MSG_SIZE             = "GRPC_MAX_MSG_SIZE"
-	ENV_PERSISTENCE_STORE_ROOT_PATH   = "PERSISTENCE_STORE_ROOT_PATH"
-	ENV_PERSISTENCE_MAX_FILE_SIZE     = "PERSISTENCE_STORE_MAX_FILE_SIZE"
-	ENV_EXTERNAL_SERVICES_CONFIG_PATH = "EXTERNAL_SERVICES_CONFIG_PATH"
+	ENV_GRPC_MAX_MSG_SIZE                = "GRPC_MAX_MSG_SIZE"
+	ENV_PERSISTENCE_STORE_ROOT_PATH      = "PERSISTENCE_STORE_ROOT_PATH"
+	ENV_PERSISTENCE_MAX_FILE_SIZE        = "PERSISTENCE_STORE_MAX_FILE_SIZE"
+	ENV_EXTERNAL_SERVICES_CONFIG_PATH    = "EXTERNAL_SERVICES_CONFIG_PATH"
+	ENV_EXTERNAL_EVENT_DELIVERY_INTERVAL = "EXTERNAL_EVENT_DELIVERY_INTERVAL"
+	ENV_DISABLE_EXTERNAL_EVENT_DELIVERY  = "DISABLE_EXTERNAL_EVENT_DELIVERY"
+	ENV_EXTERNAL_EVENT_RETENTION_DAYS    = "EXTERNAL_EVENT_RETENTION_DAYS"
+	ENV_RULES_MAX_DEPTH                  = "STUDY_RULES_MAX_DEPTH"
+	ENV_RULES_MAX_NODES                  = "STUDY_RULES_MAX_NODES"
+	ENV_RULES_TIMEOUT                    = "STUDY_RULES_TIMEOUT"
 )
 
 const (
-	defaultGRPCMaxMsgSize           = 4194304
-	defaultPersistenceStoreRootPath = "files"
-	maxParticipantFileSize          = 1 << 25
+	defaultGRPCMaxMsgSize                = 4194304
+	defaultPersistenceStoreRootPath      = "files"
+	maxParticipantFileSize               = 1 << 25
+	defaultExternalEventDeliveryInterval = 10
+	defaultExternalEventRetentionDays    = 30
 )
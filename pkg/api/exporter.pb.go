@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.28.1
-// 	protoc        v4.25.0
+// 	protoc        (unknown)
 // source: study_service/exporter.proto
 
 package api
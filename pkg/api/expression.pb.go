@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.28.1
-// 	protoc        v4.25.0
+// 	protoc        (unknown)
 // source: study_service/expression.proto
 
 package api
@@ -90,7 +90,6 @@ type ExpressionArg struct {
 
 	Dtype string `protobuf:"bytes,1,opt,name=dtype,proto3" json:"dtype,omitempty"`
 	// Types that are assignable to Data:
-	//
 	//	*ExpressionArg_Exp
 	//	*ExpressionArg_Str
 	//	*ExpressionArg_Num
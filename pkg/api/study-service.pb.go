@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.28.1
-// 	protoc        v4.25.0
+// 	protoc        (unknown)
 // source: study_service/study-service.proto
 
 package api
@@ -65,7 +65,7 @@ func (x ServiceStatus_StatusValue) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServiceStatus_StatusValue.Descriptor instead.
 func (ServiceStatus_StatusValue) EnumDescriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{30, 0}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{31, 0}
 }
 
 type StudiesForUser struct {
@@ -184,7 +184,6 @@ type UploadParticipantFileReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Data:
-	//
 	//	*UploadParticipantFileReq_Info_
 	//	*UploadParticipantFileReq_Chunk
 	Data isUploadParticipantFileReq_Data `protobuf_oneof:"data"`
@@ -265,7 +264,6 @@ type PaginatedFile struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Data:
-	//
 	//	*PaginatedFile_Info
 	//	*PaginatedFile_Chunk
 	Data isPaginatedFile_Data `protobuf_oneof:"data"`
@@ -1335,6 +1333,85 @@ func (x *ParticipantStateQuery) GetStatus() string {
 	return ""
 }
 
+type ParticipantStateHistoryQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token         *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey      string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	ParticipantId string                `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // optional, all participants if empty
+	Since         int64                 `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until         int64                 `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *ParticipantStateHistoryQuery) Reset() {
+	*x = ParticipantStateHistoryQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantStateHistoryQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantStateHistoryQuery) ProtoMessage() {}
+
+func (x *ParticipantStateHistoryQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantStateHistoryQuery.ProtoReflect.Descriptor instead.
+func (*ParticipantStateHistoryQuery) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ParticipantStateHistoryQuery) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ParticipantStateHistoryQuery) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *ParticipantStateHistoryQuery) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantStateHistoryQuery) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ParticipantStateHistoryQuery) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
 type ParticipantStateByIDQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1348,7 +1425,7 @@ type ParticipantStateByIDQuery struct {
 func (x *ParticipantStateByIDQuery) Reset() {
 	*x = ParticipantStateByIDQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[18]
+		mi := &file_study_service_study_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +1438,7 @@ func (x *ParticipantStateByIDQuery) String() string {
 func (*ParticipantStateByIDQuery) ProtoMessage() {}
 
 func (x *ParticipantStateByIDQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[18]
+	mi := &file_study_service_study_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +1451,7 @@ func (x *ParticipantStateByIDQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParticipantStateByIDQuery.ProtoReflect.Descriptor instead.
 func (*ParticipantStateByIDQuery) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{18}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ParticipantStateByIDQuery) GetToken() *api_types.TokenInfos {
@@ -1414,7 +1491,7 @@ type GetPStatesWithPaginationQuery struct {
 func (x *GetPStatesWithPaginationQuery) Reset() {
 	*x = GetPStatesWithPaginationQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[19]
+		mi := &file_study_service_study_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1427,7 +1504,7 @@ func (x *GetPStatesWithPaginationQuery) String() string {
 func (*GetPStatesWithPaginationQuery) ProtoMessage() {}
 
 func (x *GetPStatesWithPaginationQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[19]
+	mi := &file_study_service_study_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1440,7 +1517,7 @@ func (x *GetPStatesWithPaginationQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPStatesWithPaginationQuery.ProtoReflect.Descriptor instead.
 func (*GetPStatesWithPaginationQuery) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{19}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetPStatesWithPaginationQuery) GetToken() *api_types.TokenInfos {
@@ -1500,7 +1577,7 @@ type ParticipantStatesWithPagination struct {
 func (x *ParticipantStatesWithPagination) Reset() {
 	*x = ParticipantStatesWithPagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[20]
+		mi := &file_study_service_study_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1513,7 +1590,7 @@ func (x *ParticipantStatesWithPagination) String() string {
 func (*ParticipantStatesWithPagination) ProtoMessage() {}
 
 func (x *ParticipantStatesWithPagination) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[20]
+	mi := &file_study_service_study_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +1603,7 @@ func (x *ParticipantStatesWithPagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParticipantStatesWithPagination.ProtoReflect.Descriptor instead.
 func (*ParticipantStatesWithPagination) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{20}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ParticipantStatesWithPagination) GetItemCount() int32 {
@@ -1576,7 +1653,7 @@ type StudyResponseStatistics struct {
 func (x *StudyResponseStatistics) Reset() {
 	*x = StudyResponseStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[21]
+		mi := &file_study_service_study_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +1666,7 @@ func (x *StudyResponseStatistics) String() string {
 func (*StudyResponseStatistics) ProtoMessage() {}
 
 func (x *StudyResponseStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[21]
+	mi := &file_study_service_study_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +1679,7 @@ func (x *StudyResponseStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyResponseStatistics.ProtoReflect.Descriptor instead.
 func (*StudyResponseStatistics) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{21}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *StudyResponseStatistics) GetStudyKey() string {
@@ -1634,7 +1711,7 @@ type ProfilesWithConditionReq struct {
 func (x *ProfilesWithConditionReq) Reset() {
 	*x = ProfilesWithConditionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[22]
+		mi := &file_study_service_study_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1647,7 +1724,7 @@ func (x *ProfilesWithConditionReq) String() string {
 func (*ProfilesWithConditionReq) ProtoMessage() {}
 
 func (x *ProfilesWithConditionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[22]
+	mi := &file_study_service_study_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +1737,7 @@ func (x *ProfilesWithConditionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfilesWithConditionReq.ProtoReflect.Descriptor instead.
 func (*ProfilesWithConditionReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{22}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProfilesWithConditionReq) GetInstanceId() string {
@@ -1704,7 +1781,7 @@ type GetParticipantMessagesReq struct {
 func (x *GetParticipantMessagesReq) Reset() {
 	*x = GetParticipantMessagesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[23]
+		mi := &file_study_service_study_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1717,7 +1794,7 @@ func (x *GetParticipantMessagesReq) String() string {
 func (*GetParticipantMessagesReq) ProtoMessage() {}
 
 func (x *GetParticipantMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[23]
+	mi := &file_study_service_study_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,7 +1807,7 @@ func (x *GetParticipantMessagesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetParticipantMessagesReq.ProtoReflect.Descriptor instead.
 func (*GetParticipantMessagesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{23}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetParticipantMessagesReq) GetInstanceId() string {
@@ -1765,7 +1842,7 @@ type GetReseacherMessagesReq struct {
 func (x *GetReseacherMessagesReq) Reset() {
 	*x = GetReseacherMessagesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[24]
+		mi := &file_study_service_study_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1778,7 +1855,7 @@ func (x *GetReseacherMessagesReq) String() string {
 func (*GetReseacherMessagesReq) ProtoMessage() {}
 
 func (x *GetReseacherMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[24]
+	mi := &file_study_service_study_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +1868,7 @@ func (x *GetReseacherMessagesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReseacherMessagesReq.ProtoReflect.Descriptor instead.
 func (*GetReseacherMessagesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{24}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetReseacherMessagesReq) GetInstanceId() string {
@@ -1812,7 +1889,7 @@ type GetStudiesWithPendingParticipantMessagesReq struct {
 func (x *GetStudiesWithPendingParticipantMessagesReq) Reset() {
 	*x = GetStudiesWithPendingParticipantMessagesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[25]
+		mi := &file_study_service_study_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1825,7 +1902,7 @@ func (x *GetStudiesWithPendingParticipantMessagesReq) String() string {
 func (*GetStudiesWithPendingParticipantMessagesReq) ProtoMessage() {}
 
 func (x *GetStudiesWithPendingParticipantMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[25]
+	mi := &file_study_service_study_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1838,7 +1915,7 @@ func (x *GetStudiesWithPendingParticipantMessagesReq) ProtoReflect() protoreflec
 
 // Deprecated: Use GetStudiesWithPendingParticipantMessagesReq.ProtoReflect.Descriptor instead.
 func (*GetStudiesWithPendingParticipantMessagesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{25}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetStudiesWithPendingParticipantMessagesReq) GetInstanceId() string {
@@ -1865,7 +1942,7 @@ type StudyMessage struct {
 func (x *StudyMessage) Reset() {
 	*x = StudyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[26]
+		mi := &file_study_service_study_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1878,7 +1955,7 @@ func (x *StudyMessage) String() string {
 func (*StudyMessage) ProtoMessage() {}
 
 func (x *StudyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[26]
+	mi := &file_study_service_study_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1891,7 +1968,7 @@ func (x *StudyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyMessage.ProtoReflect.Descriptor instead.
 func (*StudyMessage) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{26}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *StudyMessage) GetId() string {
@@ -1947,7 +2024,7 @@ type StudyMessages struct {
 func (x *StudyMessages) Reset() {
 	*x = StudyMessages{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[27]
+		mi := &file_study_service_study_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1960,7 +2037,7 @@ func (x *StudyMessages) String() string {
 func (*StudyMessages) ProtoMessage() {}
 
 func (x *StudyMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[27]
+	mi := &file_study_service_study_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +2050,7 @@ func (x *StudyMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyMessages.ProtoReflect.Descriptor instead.
 func (*StudyMessages) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{27}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *StudyMessages) GetMessages() []*StudyMessage {
@@ -1997,7 +2074,7 @@ type DeleteMessagesFromParticipantReq struct {
 func (x *DeleteMessagesFromParticipantReq) Reset() {
 	*x = DeleteMessagesFromParticipantReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[28]
+		mi := &file_study_service_study_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2010,7 +2087,7 @@ func (x *DeleteMessagesFromParticipantReq) String() string {
 func (*DeleteMessagesFromParticipantReq) ProtoMessage() {}
 
 func (x *DeleteMessagesFromParticipantReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[28]
+	mi := &file_study_service_study_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2023,7 +2100,7 @@ func (x *DeleteMessagesFromParticipantReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessagesFromParticipantReq.ProtoReflect.Descriptor instead.
 func (*DeleteMessagesFromParticipantReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{28}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteMessagesFromParticipantReq) GetInstanceId() string {
@@ -2067,7 +2144,7 @@ type DeleteResearcherMessagesReq struct {
 func (x *DeleteResearcherMessagesReq) Reset() {
 	*x = DeleteResearcherMessagesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[29]
+		mi := &file_study_service_study_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2080,7 +2157,7 @@ func (x *DeleteResearcherMessagesReq) String() string {
 func (*DeleteResearcherMessagesReq) ProtoMessage() {}
 
 func (x *DeleteResearcherMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[29]
+	mi := &file_study_service_study_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,7 +2170,7 @@ func (x *DeleteResearcherMessagesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResearcherMessagesReq.ProtoReflect.Descriptor instead.
 func (*DeleteResearcherMessagesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{29}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteResearcherMessagesReq) GetInstanceId() string {
@@ -2122,15 +2199,16 @@ type ServiceStatus struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  ServiceStatus_StatusValue `protobuf:"varint,1,opt,name=status,proto3,enum=influenzanet.study_service.ServiceStatus_StatusValue" json:"status,omitempty"`
-	Msg     string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Version string                    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Status           ServiceStatus_StatusValue `protobuf:"varint,1,opt,name=status,proto3,enum=influenzanet.study_service.ServiceStatus_StatusValue" json:"status,omitempty"`
+	Msg              string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Version          string                    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	ExternalServices []*ExternalServiceHealth  `protobuf:"bytes,4,rep,name=external_services,json=externalServices,proto3" json:"external_services,omitempty"` // circuit breaker states, only set by Status
 }
 
 func (x *ServiceStatus) Reset() {
 	*x = ServiceStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[30]
+		mi := &file_study_service_study_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2143,7 +2221,7 @@ func (x *ServiceStatus) String() string {
 func (*ServiceStatus) ProtoMessage() {}
 
 func (x *ServiceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[30]
+	mi := &file_study_service_study_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,7 +2234,7 @@ func (x *ServiceStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceStatus.ProtoReflect.Descriptor instead.
 func (*ServiceStatus) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{30}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ServiceStatus) GetStatus() ServiceStatus_StatusValue {
@@ -2180,6 +2258,117 @@ func (x *ServiceStatus) GetVersion() string {
 	return ""
 }
 
+func (x *ServiceStatus) GetExternalServices() []*ExternalServiceHealth {
+	if x != nil {
+		return x.ExternalServices
+	}
+	return nil
+}
+
+// circuit breaker state and call counters of an external service since the start of the service
+type ExternalServiceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State               string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // closed, open or halfOpen
+	ConsecutiveFailures int32  `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	Calls               int64  `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	Failures            int64  `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+	Rejected            int64  `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"` // calls not performed, because the breaker was open
+	LastError           string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastFailureAt       int64  `protobuf:"varint,8,opt,name=last_failure_at,json=lastFailureAt,proto3" json:"last_failure_at,omitempty"`
+}
+
+func (x *ExternalServiceHealth) Reset() {
+	*x = ExternalServiceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalServiceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalServiceHealth) ProtoMessage() {}
+
+func (x *ExternalServiceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalServiceHealth.ProtoReflect.Descriptor instead.
+func (*ExternalServiceHealth) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExternalServiceHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalServiceHealth) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ExternalServiceHealth) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *ExternalServiceHealth) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *ExternalServiceHealth) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *ExternalServiceHealth) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ExternalServiceHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ExternalServiceHealth) GetLastFailureAt() int64 {
+	if x != nil {
+		return x.LastFailureAt
+	}
+	return 0
+}
+
 type NewStudyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2192,7 +2381,7 @@ type NewStudyRequest struct {
 func (x *NewStudyRequest) Reset() {
 	*x = NewStudyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[31]
+		mi := &file_study_service_study_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2205,7 +2394,7 @@ func (x *NewStudyRequest) String() string {
 func (*NewStudyRequest) ProtoMessage() {}
 
 func (x *NewStudyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[31]
+	mi := &file_study_service_study_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2407,7 @@ func (x *NewStudyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewStudyRequest.ProtoReflect.Descriptor instead.
 func (*NewStudyRequest) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{31}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *NewStudyRequest) GetToken() *api_types.TokenInfos {
@@ -2249,7 +2438,7 @@ type SurveyAndContext struct {
 func (x *SurveyAndContext) Reset() {
 	*x = SurveyAndContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[32]
+		mi := &file_study_service_study_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2262,7 +2451,7 @@ func (x *SurveyAndContext) String() string {
 func (*SurveyAndContext) ProtoMessage() {}
 
 func (x *SurveyAndContext) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[32]
+	mi := &file_study_service_study_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,7 +2464,7 @@ func (x *SurveyAndContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyAndContext.ProtoReflect.Descriptor instead.
 func (*SurveyAndContext) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{32}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SurveyAndContext) GetSurvey() *Survey {
@@ -2318,7 +2507,7 @@ type StudyReferenceReq struct {
 func (x *StudyReferenceReq) Reset() {
 	*x = StudyReferenceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[33]
+		mi := &file_study_service_study_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2331,7 +2520,7 @@ func (x *StudyReferenceReq) String() string {
 func (*StudyReferenceReq) ProtoMessage() {}
 
 func (x *StudyReferenceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[33]
+	mi := &file_study_service_study_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,7 +2533,7 @@ func (x *StudyReferenceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyReferenceReq.ProtoReflect.Descriptor instead.
 func (*StudyReferenceReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{33}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *StudyReferenceReq) GetToken() *api_types.TokenInfos {
@@ -2378,7 +2567,7 @@ type StudyRulesHistoryReq struct {
 func (x *StudyRulesHistoryReq) Reset() {
 	*x = StudyRulesHistoryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[34]
+		mi := &file_study_service_study_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2391,7 +2580,7 @@ func (x *StudyRulesHistoryReq) String() string {
 func (*StudyRulesHistoryReq) ProtoMessage() {}
 
 func (x *StudyRulesHistoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[34]
+	mi := &file_study_service_study_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2404,7 +2593,7 @@ func (x *StudyRulesHistoryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyRulesHistoryReq.ProtoReflect.Descriptor instead.
 func (*StudyRulesHistoryReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{34}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *StudyRulesHistoryReq) GetToken() *api_types.TokenInfos {
@@ -2467,7 +2656,7 @@ type SurveyInfoResp struct {
 func (x *SurveyInfoResp) Reset() {
 	*x = SurveyInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[35]
+		mi := &file_study_service_study_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2480,7 +2669,7 @@ func (x *SurveyInfoResp) String() string {
 func (*SurveyInfoResp) ProtoMessage() {}
 
 func (x *SurveyInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[35]
+	mi := &file_study_service_study_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2493,7 +2682,7 @@ func (x *SurveyInfoResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyInfoResp.ProtoReflect.Descriptor instead.
 func (*SurveyInfoResp) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{35}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SurveyInfoResp) GetInfos() []*SurveyInfo {
@@ -2516,7 +2705,7 @@ type AddSurveyReq struct {
 func (x *AddSurveyReq) Reset() {
 	*x = AddSurveyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[36]
+		mi := &file_study_service_study_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2529,7 +2718,7 @@ func (x *AddSurveyReq) String() string {
 func (*AddSurveyReq) ProtoMessage() {}
 
 func (x *AddSurveyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[36]
+	mi := &file_study_service_study_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +2731,7 @@ func (x *AddSurveyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSurveyReq.ProtoReflect.Descriptor instead.
 func (*AddSurveyReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{36}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AddSurveyReq) GetToken() *api_types.TokenInfos {
@@ -2585,7 +2774,7 @@ type SubmitResponseReq struct {
 func (x *SubmitResponseReq) Reset() {
 	*x = SubmitResponseReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[37]
+		mi := &file_study_service_study_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2598,7 +2787,7 @@ func (x *SubmitResponseReq) String() string {
 func (*SubmitResponseReq) ProtoMessage() {}
 
 func (x *SubmitResponseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[37]
+	mi := &file_study_service_study_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2611,7 +2800,7 @@ func (x *SubmitResponseReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitResponseReq.ProtoReflect.Descriptor instead.
 func (*SubmitResponseReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{37}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SubmitResponseReq) GetToken() *api_types.TokenInfos {
@@ -2676,7 +2865,7 @@ type EnterStudyRequest struct {
 func (x *EnterStudyRequest) Reset() {
 	*x = EnterStudyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[38]
+		mi := &file_study_service_study_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2689,7 +2878,7 @@ func (x *EnterStudyRequest) String() string {
 func (*EnterStudyRequest) ProtoMessage() {}
 
 func (x *EnterStudyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[38]
+	mi := &file_study_service_study_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2702,7 +2891,7 @@ func (x *EnterStudyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnterStudyRequest.ProtoReflect.Descriptor instead.
 func (*EnterStudyRequest) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{38}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *EnterStudyRequest) GetToken() *api_types.TokenInfos {
@@ -2739,7 +2928,7 @@ type LeaveStudyMsg struct {
 func (x *LeaveStudyMsg) Reset() {
 	*x = LeaveStudyMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[39]
+		mi := &file_study_service_study_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2752,7 +2941,7 @@ func (x *LeaveStudyMsg) String() string {
 func (*LeaveStudyMsg) ProtoMessage() {}
 
 func (x *LeaveStudyMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[39]
+	mi := &file_study_service_study_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2765,7 +2954,7 @@ func (x *LeaveStudyMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveStudyMsg.ProtoReflect.Descriptor instead.
 func (*LeaveStudyMsg) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{39}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *LeaveStudyMsg) GetToken() *api_types.TokenInfos {
@@ -2800,7 +2989,7 @@ type SurveyVersions struct {
 func (x *SurveyVersions) Reset() {
 	*x = SurveyVersions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[40]
+		mi := &file_study_service_study_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2813,7 +3002,7 @@ func (x *SurveyVersions) String() string {
 func (*SurveyVersions) ProtoMessage() {}
 
 func (x *SurveyVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[40]
+	mi := &file_study_service_study_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,7 +3015,7 @@ func (x *SurveyVersions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyVersions.ProtoReflect.Descriptor instead.
 func (*SurveyVersions) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{40}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SurveyVersions) GetSurveyVersions() []*Survey {
@@ -2852,7 +3041,7 @@ type SurveyReferenceRequest struct {
 func (x *SurveyReferenceRequest) Reset() {
 	*x = SurveyReferenceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[41]
+		mi := &file_study_service_study_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2865,7 +3054,7 @@ func (x *SurveyReferenceRequest) String() string {
 func (*SurveyReferenceRequest) ProtoMessage() {}
 
 func (x *SurveyReferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[41]
+	mi := &file_study_service_study_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2878,7 +3067,7 @@ func (x *SurveyReferenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyReferenceRequest.ProtoReflect.Descriptor instead.
 func (*SurveyReferenceRequest) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{41}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SurveyReferenceRequest) GetInstanceId() string {
@@ -2935,7 +3124,7 @@ type StudyRulesVersionReferenceReq struct {
 func (x *StudyRulesVersionReferenceReq) Reset() {
 	*x = StudyRulesVersionReferenceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[42]
+		mi := &file_study_service_study_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2948,7 +3137,7 @@ func (x *StudyRulesVersionReferenceReq) String() string {
 func (*StudyRulesVersionReferenceReq) ProtoMessage() {}
 
 func (x *StudyRulesVersionReferenceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[42]
+	mi := &file_study_service_study_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,7 +3150,7 @@ func (x *StudyRulesVersionReferenceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyRulesVersionReferenceReq.ProtoReflect.Descriptor instead.
 func (*StudyRulesVersionReferenceReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{42}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *StudyRulesVersionReferenceReq) GetToken() *api_types.TokenInfos {
@@ -2992,7 +3181,7 @@ type SurveyVersionReferenceRequest struct {
 func (x *SurveyVersionReferenceRequest) Reset() {
 	*x = SurveyVersionReferenceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[43]
+		mi := &file_study_service_study_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3005,7 +3194,7 @@ func (x *SurveyVersionReferenceRequest) String() string {
 func (*SurveyVersionReferenceRequest) ProtoMessage() {}
 
 func (x *SurveyVersionReferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[43]
+	mi := &file_study_service_study_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3018,7 +3207,7 @@ func (x *SurveyVersionReferenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyVersionReferenceRequest.ProtoReflect.Descriptor instead.
 func (*SurveyVersionReferenceRequest) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{43}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SurveyVersionReferenceRequest) GetToken() *api_types.TokenInfos {
@@ -3062,7 +3251,7 @@ type GetSurveyKeysRequest struct {
 func (x *GetSurveyKeysRequest) Reset() {
 	*x = GetSurveyKeysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[44]
+		mi := &file_study_service_study_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3075,7 +3264,7 @@ func (x *GetSurveyKeysRequest) String() string {
 func (*GetSurveyKeysRequest) ProtoMessage() {}
 
 func (x *GetSurveyKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[44]
+	mi := &file_study_service_study_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,7 +3277,7 @@ func (x *GetSurveyKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSurveyKeysRequest.ProtoReflect.Descriptor instead.
 func (*GetSurveyKeysRequest) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{44}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetSurveyKeysRequest) GetToken() *api_types.TokenInfos {
@@ -3123,7 +3312,7 @@ type SurveyKeys struct {
 func (x *SurveyKeys) Reset() {
 	*x = SurveyKeys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[45]
+		mi := &file_study_service_study_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3136,7 +3325,7 @@ func (x *SurveyKeys) String() string {
 func (*SurveyKeys) ProtoMessage() {}
 
 func (x *SurveyKeys) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[45]
+	mi := &file_study_service_study_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3149,7 +3338,7 @@ func (x *SurveyKeys) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyKeys.ProtoReflect.Descriptor instead.
 func (*SurveyKeys) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{45}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SurveyKeys) GetKeys() []string {
@@ -3173,7 +3362,7 @@ type CreateReportReq struct {
 func (x *CreateReportReq) Reset() {
 	*x = CreateReportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[46]
+		mi := &file_study_service_study_service_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3186,7 +3375,7 @@ func (x *CreateReportReq) String() string {
 func (*CreateReportReq) ProtoMessage() {}
 
 func (x *CreateReportReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[46]
+	mi := &file_study_service_study_service_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,7 +3388,7 @@ func (x *CreateReportReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReportReq.ProtoReflect.Descriptor instead.
 func (*CreateReportReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{46}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CreateReportReq) GetToken() *api_types.TokenInfos {
@@ -3248,7 +3437,7 @@ type GetReportsForUserReq struct {
 func (x *GetReportsForUserReq) Reset() {
 	*x = GetReportsForUserReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[47]
+		mi := &file_study_service_study_service_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3261,7 +3450,7 @@ func (x *GetReportsForUserReq) String() string {
 func (*GetReportsForUserReq) ProtoMessage() {}
 
 func (x *GetReportsForUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[47]
+	mi := &file_study_service_study_service_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3274,7 +3463,7 @@ func (x *GetReportsForUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReportsForUserReq.ProtoReflect.Descriptor instead.
 func (*GetReportsForUserReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{47}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetReportsForUserReq) GetToken() *api_types.TokenInfos {
@@ -3345,7 +3534,7 @@ type RemoveConfidentialResponsesForProfilesReq struct {
 func (x *RemoveConfidentialResponsesForProfilesReq) Reset() {
 	*x = RemoveConfidentialResponsesForProfilesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[48]
+		mi := &file_study_service_study_service_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3358,7 +3547,7 @@ func (x *RemoveConfidentialResponsesForProfilesReq) String() string {
 func (*RemoveConfidentialResponsesForProfilesReq) ProtoMessage() {}
 
 func (x *RemoveConfidentialResponsesForProfilesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[48]
+	mi := &file_study_service_study_service_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,7 +3560,7 @@ func (x *RemoveConfidentialResponsesForProfilesReq) ProtoReflect() protoreflect.
 
 // Deprecated: Use RemoveConfidentialResponsesForProfilesReq.ProtoReflect.Descriptor instead.
 func (*RemoveConfidentialResponsesForProfilesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{48}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RemoveConfidentialResponsesForProfilesReq) GetToken() *api_types.TokenInfos {
@@ -3399,7 +3588,7 @@ type ReportHistory struct {
 func (x *ReportHistory) Reset() {
 	*x = ReportHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[49]
+		mi := &file_study_service_study_service_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3412,7 +3601,7 @@ func (x *ReportHistory) String() string {
 func (*ReportHistory) ProtoMessage() {}
 
 func (x *ReportHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[49]
+	mi := &file_study_service_study_service_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3425,7 +3614,7 @@ func (x *ReportHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportHistory.ProtoReflect.Descriptor instead.
 func (*ReportHistory) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{49}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ReportHistory) GetReports() []*Report {
@@ -3446,7 +3635,7 @@ type GetStudiesForUserReq struct {
 func (x *GetStudiesForUserReq) Reset() {
 	*x = GetStudiesForUserReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[50]
+		mi := &file_study_service_study_service_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3459,7 +3648,7 @@ func (x *GetStudiesForUserReq) String() string {
 func (*GetStudiesForUserReq) ProtoMessage() {}
 
 func (x *GetStudiesForUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[50]
+	mi := &file_study_service_study_service_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3472,7 +3661,7 @@ func (x *GetStudiesForUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudiesForUserReq.ProtoReflect.Descriptor instead.
 func (*GetStudiesForUserReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{50}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetStudiesForUserReq) GetToken() *api_types.TokenInfos {
@@ -3493,7 +3682,7 @@ type Studies struct {
 func (x *Studies) Reset() {
 	*x = Studies{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[51]
+		mi := &file_study_service_study_service_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3506,7 +3695,7 @@ func (x *Studies) String() string {
 func (*Studies) ProtoMessage() {}
 
 func (x *Studies) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[51]
+	mi := &file_study_service_study_service_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,7 +3708,7 @@ func (x *Studies) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Studies.ProtoReflect.Descriptor instead.
 func (*Studies) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{51}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *Studies) GetStudies() []*Study {
@@ -3542,7 +3731,7 @@ type StudyMemberReq struct {
 func (x *StudyMemberReq) Reset() {
 	*x = StudyMemberReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[52]
+		mi := &file_study_service_study_service_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3555,7 +3744,7 @@ func (x *StudyMemberReq) String() string {
 func (*StudyMemberReq) ProtoMessage() {}
 
 func (x *StudyMemberReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[52]
+	mi := &file_study_service_study_service_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3568,7 +3757,7 @@ func (x *StudyMemberReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyMemberReq.ProtoReflect.Descriptor instead.
 func (*StudyMemberReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{52}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *StudyMemberReq) GetToken() *api_types.TokenInfos {
@@ -3597,15 +3786,17 @@ type StudyRulesReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
-	Rules    []*Expression         `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey  string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	Rules     []*Expression         `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Format    string                `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`                        // "text": SaveStudyRules reads the rules from rules_text
+	RulesText string                `protobuf:"bytes,5,opt,name=rules_text,json=rulesText,proto3" json:"rules_text,omitempty"` // rules in text syntax, rules must be empty then
 }
 
 func (x *StudyRulesReq) Reset() {
 	*x = StudyRulesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[53]
+		mi := &file_study_service_study_service_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3618,7 +3809,7 @@ func (x *StudyRulesReq) String() string {
 func (*StudyRulesReq) ProtoMessage() {}
 
 func (x *StudyRulesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[53]
+	mi := &file_study_service_study_service_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3631,7 +3822,7 @@ func (x *StudyRulesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyRulesReq.ProtoReflect.Descriptor instead.
 func (*StudyRulesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{53}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *StudyRulesReq) GetToken() *api_types.TokenInfos {
@@ -3655,6 +3846,20 @@ func (x *StudyRulesReq) GetRules() []*Expression {
 	return nil
 }
 
+func (x *StudyRulesReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *StudyRulesReq) GetRulesText() string {
+	if x != nil {
+		return x.RulesText
+	}
+	return ""
+}
+
 type RunRulesForSingleParticipantReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3664,12 +3869,13 @@ type RunRulesForSingleParticipantReq struct {
 	StudyKey      string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
 	ParticipantId string                `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 	Rules         []*Expression         `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	TraceRules    bool                  `protobuf:"varint,5,opt,name=trace_rules,json=traceRules,proto3" json:"trace_rules,omitempty"` // return the evaluation trace in the summary
 }
 
 func (x *RunRulesForSingleParticipantReq) Reset() {
 	*x = RunRulesForSingleParticipantReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[54]
+		mi := &file_study_service_study_service_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3682,7 +3888,7 @@ func (x *RunRulesForSingleParticipantReq) String() string {
 func (*RunRulesForSingleParticipantReq) ProtoMessage() {}
 
 func (x *RunRulesForSingleParticipantReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[54]
+	mi := &file_study_service_study_service_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3695,7 +3901,7 @@ func (x *RunRulesForSingleParticipantReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunRulesForSingleParticipantReq.ProtoReflect.Descriptor instead.
 func (*RunRulesForSingleParticipantReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{54}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *RunRulesForSingleParticipantReq) GetToken() *api_types.TokenInfos {
@@ -3726,6 +3932,13 @@ func (x *RunRulesForSingleParticipantReq) GetRules() []*Expression {
 	return nil
 }
 
+func (x *RunRulesForSingleParticipantReq) GetTraceRules() bool {
+	if x != nil {
+		return x.TraceRules
+	}
+	return false
+}
+
 type RunRulesForPreviousResponsesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3740,7 +3953,7 @@ type RunRulesForPreviousResponsesReq struct {
 func (x *RunRulesForPreviousResponsesReq) Reset() {
 	*x = RunRulesForPreviousResponsesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[55]
+		mi := &file_study_service_study_service_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3753,7 +3966,7 @@ func (x *RunRulesForPreviousResponsesReq) String() string {
 func (*RunRulesForPreviousResponsesReq) ProtoMessage() {}
 
 func (x *RunRulesForPreviousResponsesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[55]
+	mi := &file_study_service_study_service_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3766,7 +3979,7 @@ func (x *RunRulesForPreviousResponsesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunRulesForPreviousResponsesReq.ProtoReflect.Descriptor instead.
 func (*RunRulesForPreviousResponsesReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{55}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *RunRulesForPreviousResponsesReq) GetToken() *api_types.TokenInfos {
@@ -3810,7 +4023,7 @@ type StudyStatusReq struct {
 func (x *StudyStatusReq) Reset() {
 	*x = StudyStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[56]
+		mi := &file_study_service_study_service_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3823,7 +4036,7 @@ func (x *StudyStatusReq) String() string {
 func (*StudyStatusReq) ProtoMessage() {}
 
 func (x *StudyStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[56]
+	mi := &file_study_service_study_service_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3836,7 +4049,7 @@ func (x *StudyStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyStatusReq.ProtoReflect.Descriptor instead.
 func (*StudyStatusReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{56}
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *StudyStatusReq) GetToken() *api_types.TokenInfos {
@@ -3860,33 +4073,33 @@ func (x *StudyStatusReq) GetNewStatus() string {
 	return ""
 }
 
-type StudyPropsReq struct {
+type ParticipantStateHistoryConfigReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
-	Props    *Study_Props          `protobuf:"bytes,3,opt,name=props,proto3" json:"props,omitempty"`
+	Token    *api_types.TokenInfos          `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey string                         `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	Config   *ParticipantStateHistoryConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *StudyPropsReq) Reset() {
-	*x = StudyPropsReq{}
+func (x *ParticipantStateHistoryConfigReq) Reset() {
+	*x = ParticipantStateHistoryConfigReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[57]
+		mi := &file_study_service_study_service_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StudyPropsReq) String() string {
+func (x *ParticipantStateHistoryConfigReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudyPropsReq) ProtoMessage() {}
+func (*ParticipantStateHistoryConfigReq) ProtoMessage() {}
 
-func (x *StudyPropsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[57]
+func (x *ParticipantStateHistoryConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3897,59 +4110,59 @@ func (x *StudyPropsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudyPropsReq.ProtoReflect.Descriptor instead.
-func (*StudyPropsReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ParticipantStateHistoryConfigReq.ProtoReflect.Descriptor instead.
+func (*ParticipantStateHistoryConfigReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *StudyPropsReq) GetToken() *api_types.TokenInfos {
+func (x *ParticipantStateHistoryConfigReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-func (x *StudyPropsReq) GetStudyKey() string {
+func (x *ParticipantStateHistoryConfigReq) GetStudyKey() string {
 	if x != nil {
 		return x.StudyKey
 	}
 	return ""
 }
 
-func (x *StudyPropsReq) GetProps() *Study_Props {
+func (x *ParticipantStateHistoryConfigReq) GetConfig() *ParticipantStateHistoryConfig {
 	if x != nil {
-		return x.Props
+		return x.Config
 	}
 	return nil
 }
 
-type RuleRunSummary struct {
+type RuleErrorPolicyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParticipantCount              int32   `protobuf:"varint,1,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
-	ParticipantStateChangePerRule []int32 `protobuf:"varint,2,rep,packed,name=participant_state_change_per_rule,json=participantStateChangePerRule,proto3" json:"participant_state_change_per_rule,omitempty"`
-	Duration                      int64   `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Token           *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey        string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	RuleErrorPolicy string                `protobuf:"bytes,3,opt,name=rule_error_policy,json=ruleErrorPolicy,proto3" json:"rule_error_policy,omitempty"` // skipFailingRule, allOrNothing or empty for the default
 }
 
-func (x *RuleRunSummary) Reset() {
-	*x = RuleRunSummary{}
+func (x *RuleErrorPolicyReq) Reset() {
+	*x = RuleErrorPolicyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[58]
+		mi := &file_study_service_study_service_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RuleRunSummary) String() string {
+func (x *RuleErrorPolicyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleRunSummary) ProtoMessage() {}
+func (*RuleErrorPolicyReq) ProtoMessage() {}
 
-func (x *RuleRunSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[58]
+func (x *RuleErrorPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3960,61 +4173,59 @@ func (x *RuleRunSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleRunSummary.ProtoReflect.Descriptor instead.
-func (*RuleRunSummary) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use RuleErrorPolicyReq.ProtoReflect.Descriptor instead.
+func (*RuleErrorPolicyReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *RuleRunSummary) GetParticipantCount() int32 {
+func (x *RuleErrorPolicyReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.ParticipantCount
+		return x.Token
 	}
-	return 0
+	return nil
 }
 
-func (x *RuleRunSummary) GetParticipantStateChangePerRule() []int32 {
+func (x *RuleErrorPolicyReq) GetStudyKey() string {
 	if x != nil {
-		return x.ParticipantStateChangePerRule
+		return x.StudyKey
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleRunSummary) GetDuration() int64 {
+func (x *RuleErrorPolicyReq) GetRuleErrorPolicy() string {
 	if x != nil {
-		return x.Duration
+		return x.RuleErrorPolicy
 	}
-	return 0
+	return ""
 }
 
-type ConvertTempParticipantReq struct {
+type StudyPropsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token                  *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudyKey               string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
-	ProfileId              string                `protobuf:"bytes,3,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	TemporaryParticipantId string                `protobuf:"bytes,4,opt,name=temporary_participant_id,json=temporaryParticipantId,proto3" json:"temporary_participant_id,omitempty"`
-	Timestamp              int64                 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // second piece of info to confirm knowledge of the participant
+	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	Props    *Study_Props          `protobuf:"bytes,3,opt,name=props,proto3" json:"props,omitempty"`
 }
 
-func (x *ConvertTempParticipantReq) Reset() {
-	*x = ConvertTempParticipantReq{}
+func (x *StudyPropsReq) Reset() {
+	*x = StudyPropsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[59]
+		mi := &file_study_service_study_service_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConvertTempParticipantReq) String() string {
+func (x *StudyPropsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConvertTempParticipantReq) ProtoMessage() {}
+func (*StudyPropsReq) ProtoMessage() {}
 
-func (x *ConvertTempParticipantReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[59]
+func (x *StudyPropsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4025,72 +4236,61 @@ func (x *ConvertTempParticipantReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConvertTempParticipantReq.ProtoReflect.Descriptor instead.
-func (*ConvertTempParticipantReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use StudyPropsReq.ProtoReflect.Descriptor instead.
+func (*StudyPropsReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ConvertTempParticipantReq) GetToken() *api_types.TokenInfos {
+func (x *StudyPropsReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-func (x *ConvertTempParticipantReq) GetStudyKey() string {
+func (x *StudyPropsReq) GetStudyKey() string {
 	if x != nil {
 		return x.StudyKey
 	}
 	return ""
 }
 
-func (x *ConvertTempParticipantReq) GetProfileId() string {
-	if x != nil {
-		return x.ProfileId
-	}
-	return ""
-}
-
-func (x *ConvertTempParticipantReq) GetTemporaryParticipantId() string {
-	if x != nil {
-		return x.TemporaryParticipantId
-	}
-	return ""
-}
-
-func (x *ConvertTempParticipantReq) GetTimestamp() int64 {
+func (x *StudyPropsReq) GetProps() *Study_Props {
 	if x != nil {
-		return x.Timestamp
+		return x.Props
 	}
-	return 0
+	return nil
 }
 
-type RegisterTempParticipantReq struct {
+type RuleRunSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	StudyKey   string `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	ParticipantCount              int32            `protobuf:"varint,1,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+	ParticipantStateChangePerRule []int32          `protobuf:"varint,2,rep,packed,name=participant_state_change_per_rule,json=participantStateChangePerRule,proto3" json:"participant_state_change_per_rule,omitempty"`
+	Duration                      int64            `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	RulesTrace                    []*RuleTraceNode `protobuf:"bytes,4,rep,name=rules_trace,json=rulesTrace,proto3" json:"rules_trace,omitempty"`                               // evaluated rules, if the trace was requested
+	RulesTraceTruncated           bool             `protobuf:"varint,5,opt,name=rules_trace_truncated,json=rulesTraceTruncated,proto3" json:"rules_trace_truncated,omitempty"` // the trace was cut off, later nodes are missing
 }
 
-func (x *RegisterTempParticipantReq) Reset() {
-	*x = RegisterTempParticipantReq{}
+func (x *RuleRunSummary) Reset() {
+	*x = RuleRunSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[60]
+		mi := &file_study_service_study_service_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterTempParticipantReq) String() string {
+func (x *RuleRunSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterTempParticipantReq) ProtoMessage() {}
+func (*RuleRunSummary) ProtoMessage() {}
 
-func (x *RegisterTempParticipantReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[60]
+func (x *RuleRunSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4101,51 +4301,76 @@ func (x *RegisterTempParticipantReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterTempParticipantReq.ProtoReflect.Descriptor instead.
-func (*RegisterTempParticipantReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use RuleRunSummary.ProtoReflect.Descriptor instead.
+func (*RuleRunSummary) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *RegisterTempParticipantReq) GetInstanceId() string {
+func (x *RuleRunSummary) GetParticipantCount() int32 {
 	if x != nil {
-		return x.InstanceId
+		return x.ParticipantCount
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterTempParticipantReq) GetStudyKey() string {
+func (x *RuleRunSummary) GetParticipantStateChangePerRule() []int32 {
 	if x != nil {
-		return x.StudyKey
+		return x.ParticipantStateChangePerRule
 	}
-	return ""
+	return nil
 }
 
-type RegisterTempParticipantResponse struct {
+func (x *RuleRunSummary) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *RuleRunSummary) GetRulesTrace() []*RuleTraceNode {
+	if x != nil {
+		return x.RulesTrace
+	}
+	return nil
+}
+
+func (x *RuleRunSummary) GetRulesTraceTruncated() bool {
+	if x != nil {
+		return x.RulesTraceTruncated
+	}
+	return false
+}
+
+type RuleTraceValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TemporaryParticipantId string `protobuf:"bytes,1,opt,name=temporary_participant_id,json=temporaryParticipantId,proto3" json:"temporary_participant_id,omitempty"`
-	Timestamp              int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are assignable to Value:
+	//	*RuleTraceValue_Str
+	//	*RuleTraceValue_Num
+	//	*RuleTraceValue_Bool
+	//	*RuleTraceValue_Json
+	Value isRuleTraceValue_Value `protobuf_oneof:"value"`
 }
 
-func (x *RegisterTempParticipantResponse) Reset() {
-	*x = RegisterTempParticipantResponse{}
+func (x *RuleTraceValue) Reset() {
+	*x = RuleTraceValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[61]
+		mi := &file_study_service_study_service_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterTempParticipantResponse) String() string {
+func (x *RuleTraceValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterTempParticipantResponse) ProtoMessage() {}
+func (*RuleTraceValue) ProtoMessage() {}
 
-func (x *RegisterTempParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[61]
+func (x *RuleTraceValue) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4156,117 +4381,105 @@ func (x *RegisterTempParticipantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterTempParticipantResponse.ProtoReflect.Descriptor instead.
-func (*RegisterTempParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use RuleTraceValue.ProtoReflect.Descriptor instead.
+func (*RuleTraceValue) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *RegisterTempParticipantResponse) GetTemporaryParticipantId() string {
-	if x != nil {
-		return x.TemporaryParticipantId
+func (m *RuleTraceValue) GetValue() isRuleTraceValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *RuleTraceValue) GetStr() string {
+	if x, ok := x.GetValue().(*RuleTraceValue_Str); ok {
+		return x.Str
 	}
 	return ""
 }
 
-func (x *RegisterTempParticipantResponse) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
+func (x *RuleTraceValue) GetNum() float64 {
+	if x, ok := x.GetValue().(*RuleTraceValue_Num); ok {
+		return x.Num
 	}
 	return 0
 }
 
-type GetAssignedSurveysForTemporaryParticipantReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InstanceId             string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	StudyKey               string `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
-	TemporaryParticipantId string `protobuf:"bytes,3,opt,name=temporary_participant_id,json=temporaryParticipantId,proto3" json:"temporary_participant_id,omitempty"`
+func (x *RuleTraceValue) GetBool() bool {
+	if x, ok := x.GetValue().(*RuleTraceValue_Bool); ok {
+		return x.Bool
+	}
+	return false
 }
 
-func (x *GetAssignedSurveysForTemporaryParticipantReq) Reset() {
-	*x = GetAssignedSurveysForTemporaryParticipantReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[62]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RuleTraceValue) GetJson() string {
+	if x, ok := x.GetValue().(*RuleTraceValue_Json); ok {
+		return x.Json
 	}
+	return ""
 }
 
-func (x *GetAssignedSurveysForTemporaryParticipantReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isRuleTraceValue_Value interface {
+	isRuleTraceValue_Value()
 }
 
-func (*GetAssignedSurveysForTemporaryParticipantReq) ProtoMessage() {}
-
-func (x *GetAssignedSurveysForTemporaryParticipantReq) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[62]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type RuleTraceValue_Str struct {
+	Str string `protobuf:"bytes,1,opt,name=str,proto3,oneof"`
 }
 
-// Deprecated: Use GetAssignedSurveysForTemporaryParticipantReq.ProtoReflect.Descriptor instead.
-func (*GetAssignedSurveysForTemporaryParticipantReq) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{62}
+type RuleTraceValue_Num struct {
+	Num float64 `protobuf:"fixed64,2,opt,name=num,proto3,oneof"`
 }
 
-func (x *GetAssignedSurveysForTemporaryParticipantReq) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
+type RuleTraceValue_Bool struct {
+	Bool bool `protobuf:"varint,3,opt,name=bool,proto3,oneof"`
 }
 
-func (x *GetAssignedSurveysForTemporaryParticipantReq) GetStudyKey() string {
-	if x != nil {
-		return x.StudyKey
-	}
-	return ""
+type RuleTraceValue_Json struct {
+	Json string `protobuf:"bytes,4,opt,name=json,proto3,oneof"` // any other value (lists, objects) as JSON
 }
 
-func (x *GetAssignedSurveysForTemporaryParticipantReq) GetTemporaryParticipantId() string {
-	if x != nil {
-		return x.TemporaryParticipantId
-	}
-	return ""
-}
+func (*RuleTraceValue_Str) isRuleTraceValue_Value() {}
 
-type ConfidentialResponsesQuery struct {
+func (*RuleTraceValue_Num) isRuleTraceValue_Value() {}
+
+func (*RuleTraceValue_Bool) isRuleTraceValue_Value() {}
+
+func (*RuleTraceValue_Json) isRuleTraceValue_Value() {}
+
+type RuleTraceNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token          *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudyKey       string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
-	ParticipantIds []string              `protobuf:"bytes,3,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
-	Condition      *Expression           `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
-	KeyFilter      string                `protobuf:"bytes,5,opt,name=key_filter,json=keyFilter,proto3" json:"key_filter,omitempty"` // optional key filter to retrieve only for this one
+	Kind      string                `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // action or expression
+	Name      string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Args      []*RuleTraceValue     `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`                            // resolved arguments
+	Result    *RuleTraceValue       `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`                        // unset if there is no result
+	StateDiff *ParticipantStateDiff `protobuf:"bytes,5,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"` // effect of an action on the participant state
+	Error     string                `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Children  []*RuleTraceNode      `protobuf:"bytes,7,rep,name=children,proto3" json:"children,omitempty"`
 }
 
-func (x *ConfidentialResponsesQuery) Reset() {
-	*x = ConfidentialResponsesQuery{}
+func (x *RuleTraceNode) Reset() {
+	*x = RuleTraceNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[63]
+		mi := &file_study_service_study_service_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfidentialResponsesQuery) String() string {
+func (x *RuleTraceNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfidentialResponsesQuery) ProtoMessage() {}
+func (*RuleTraceNode) ProtoMessage() {}
 
-func (x *ConfidentialResponsesQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[63]
+func (x *RuleTraceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4277,71 +4490,87 @@ func (x *ConfidentialResponsesQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfidentialResponsesQuery.ProtoReflect.Descriptor instead.
-func (*ConfidentialResponsesQuery) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use RuleTraceNode.ProtoReflect.Descriptor instead.
+func (*RuleTraceNode) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ConfidentialResponsesQuery) GetToken() *api_types.TokenInfos {
+func (x *RuleTraceNode) GetKind() string {
 	if x != nil {
-		return x.Token
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-func (x *ConfidentialResponsesQuery) GetStudyKey() string {
+func (x *RuleTraceNode) GetName() string {
 	if x != nil {
-		return x.StudyKey
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ConfidentialResponsesQuery) GetParticipantIds() []string {
+func (x *RuleTraceNode) GetArgs() []*RuleTraceValue {
 	if x != nil {
-		return x.ParticipantIds
+		return x.Args
 	}
 	return nil
 }
 
-func (x *ConfidentialResponsesQuery) GetCondition() *Expression {
+func (x *RuleTraceNode) GetResult() *RuleTraceValue {
 	if x != nil {
-		return x.Condition
+		return x.Result
 	}
 	return nil
 }
 
-func (x *ConfidentialResponsesQuery) GetKeyFilter() string {
+func (x *RuleTraceNode) GetStateDiff() *ParticipantStateDiff {
 	if x != nil {
-		return x.KeyFilter
+		return x.StateDiff
+	}
+	return nil
+}
+
+func (x *RuleTraceNode) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type ConfidentialResponses struct {
+func (x *RuleTraceNode) GetChildren() []*RuleTraceNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type DryRunDBCalls struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*SurveyResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	FindSurveyResponses int32 `protobuf:"varint,1,opt,name=find_survey_responses,json=findSurveyResponses,proto3" json:"find_survey_responses,omitempty"` // queries sent to the DB
+	FindReports         int32 `protobuf:"varint,2,opt,name=find_reports,json=findReports,proto3" json:"find_reports,omitempty"`                           // queries sent to the DB
+	CachedLookups       int32 `protobuf:"varint,3,opt,name=cached_lookups,json=cachedLookups,proto3" json:"cached_lookups,omitempty"`                     // lookups answered from the cache
 }
 
-func (x *ConfidentialResponses) Reset() {
-	*x = ConfidentialResponses{}
+func (x *DryRunDBCalls) Reset() {
+	*x = DryRunDBCalls{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[64]
+		mi := &file_study_service_study_service_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConfidentialResponses) String() string {
+func (x *DryRunDBCalls) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfidentialResponses) ProtoMessage() {}
+func (*DryRunDBCalls) ProtoMessage() {}
 
-func (x *ConfidentialResponses) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[64]
+func (x *DryRunDBCalls) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4352,52 +4581,65 @@ func (x *ConfidentialResponses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfidentialResponses.ProtoReflect.Descriptor instead.
-func (*ConfidentialResponses) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use DryRunDBCalls.ProtoReflect.Descriptor instead.
+func (*DryRunDBCalls) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ConfidentialResponses) GetResponses() []*SurveyResponse {
+func (x *DryRunDBCalls) GetFindSurveyResponses() int32 {
 	if x != nil {
-		return x.Responses
+		return x.FindSurveyResponses
 	}
-	return nil
+	return 0
 }
 
-type UploadParticipantFileReq_Info struct {
+func (x *DryRunDBCalls) GetFindReports() int32 {
+	if x != nil {
+		return x.FindReports
+	}
+	return 0
+}
+
+func (x *DryRunDBCalls) GetCachedLookups() int32 {
+	if x != nil {
+		return x.CachedLookups
+	}
+	return 0
+}
+
+type DryRunParticipantResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
-	SubStudy string                `protobuf:"bytes,3,opt,name=sub_study,json=subStudy,proto3" json:"sub_study,omitempty"`
-	// Types that are assignable to Participant:
-	//
-	//	*UploadParticipantFileReq_Info_ProfileId
-	//	*UploadParticipantFileReq_Info_ParticipantId
-	Participant          isUploadParticipantFileReq_Info_Participant `protobuf_oneof:"participant"`
-	FileType             *FileType                                   `protobuf:"bytes,6,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
-	VisibleToParticipant bool                                        `protobuf:"varint,7,opt,name=visible_to_participant,json=visibleToParticipant,proto3" json:"visible_to_participant,omitempty"`
+	ParticipantId                string                      `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Diff                         *ParticipantStateDiff       `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	ReportsToCreate              []*Report                   `protobuf:"bytes,3,rep,name=reports_to_create,json=reportsToCreate,proto3" json:"reports_to_create,omitempty"`
+	ResearcherMessages           []*StudyMessage             `protobuf:"bytes,4,rep,name=researcher_messages,json=researcherMessages,proto3" json:"researcher_messages,omitempty"`
+	ConfidentialResponsesRemoved []string                    `protobuf:"bytes,5,rep,name=confidential_responses_removed,json=confidentialResponsesRemoved,proto3" json:"confidential_responses_removed,omitempty"`
+	ExternalEvents               []*ExternalEventOutboxEntry `protobuf:"bytes,6,rep,name=external_events,json=externalEvents,proto3" json:"external_events,omitempty"` // queued for asynchronous delivery
+	StateChangedByRule           []bool                      `protobuf:"varint,7,rep,packed,name=state_changed_by_rule,json=stateChangedByRule,proto3" json:"state_changed_by_rule,omitempty"`
+	DbCalls                      *DryRunDBCalls              `protobuf:"bytes,8,opt,name=db_calls,json=dbCalls,proto3" json:"db_calls,omitempty"` // lookups of the rules for the participant
+	Error                        string                      `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *UploadParticipantFileReq_Info) Reset() {
-	*x = UploadParticipantFileReq_Info{}
+func (x *DryRunParticipantResult) Reset() {
+	*x = DryRunParticipantResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[65]
+		mi := &file_study_service_study_service_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadParticipantFileReq_Info) String() string {
+func (x *DryRunParticipantResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadParticipantFileReq_Info) ProtoMessage() {}
+func (*DryRunParticipantResult) ProtoMessage() {}
 
-func (x *UploadParticipantFileReq_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[65]
+func (x *DryRunParticipantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4408,112 +4650,185 @@ func (x *UploadParticipantFileReq_Info) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadParticipantFileReq_Info.ProtoReflect.Descriptor instead.
-func (*UploadParticipantFileReq_Info) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use DryRunParticipantResult.ProtoReflect.Descriptor instead.
+func (*DryRunParticipantResult) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UploadParticipantFileReq_Info) GetToken() *api_types.TokenInfos {
+func (x *DryRunParticipantResult) GetParticipantId() string {
 	if x != nil {
-		return x.Token
+		return x.ParticipantId
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadParticipantFileReq_Info) GetStudyKey() string {
+func (x *DryRunParticipantResult) GetDiff() *ParticipantStateDiff {
 	if x != nil {
-		return x.StudyKey
+		return x.Diff
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadParticipantFileReq_Info) GetSubStudy() string {
+func (x *DryRunParticipantResult) GetReportsToCreate() []*Report {
 	if x != nil {
-		return x.SubStudy
+		return x.ReportsToCreate
 	}
-	return ""
+	return nil
 }
 
-func (m *UploadParticipantFileReq_Info) GetParticipant() isUploadParticipantFileReq_Info_Participant {
-	if m != nil {
-		return m.Participant
+func (x *DryRunParticipantResult) GetResearcherMessages() []*StudyMessage {
+	if x != nil {
+		return x.ResearcherMessages
 	}
 	return nil
 }
 
-func (x *UploadParticipantFileReq_Info) GetProfileId() string {
-	if x, ok := x.GetParticipant().(*UploadParticipantFileReq_Info_ProfileId); ok {
-		return x.ProfileId
+func (x *DryRunParticipantResult) GetConfidentialResponsesRemoved() []string {
+	if x != nil {
+		return x.ConfidentialResponsesRemoved
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadParticipantFileReq_Info) GetParticipantId() string {
-	if x, ok := x.GetParticipant().(*UploadParticipantFileReq_Info_ParticipantId); ok {
-		return x.ParticipantId
+func (x *DryRunParticipantResult) GetExternalEvents() []*ExternalEventOutboxEntry {
+	if x != nil {
+		return x.ExternalEvents
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadParticipantFileReq_Info) GetFileType() *FileType {
+func (x *DryRunParticipantResult) GetStateChangedByRule() []bool {
 	if x != nil {
-		return x.FileType
+		return x.StateChangedByRule
 	}
 	return nil
 }
 
-func (x *UploadParticipantFileReq_Info) GetVisibleToParticipant() bool {
+func (x *DryRunParticipantResult) GetDbCalls() *DryRunDBCalls {
 	if x != nil {
-		return x.VisibleToParticipant
+		return x.DbCalls
 	}
-	return false
+	return nil
 }
 
-type isUploadParticipantFileReq_Info_Participant interface {
-	isUploadParticipantFileReq_Info_Participant()
+func (x *DryRunParticipantResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-type UploadParticipantFileReq_Info_ProfileId struct {
-	ProfileId string `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3,oneof"`
+type DryRunRulesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*DryRunRulesResp_ParticipantResult
+	//	*DryRunRulesResp_Summary
+	Data isDryRunRulesResp_Data `protobuf_oneof:"data"`
 }
 
-type UploadParticipantFileReq_Info_ParticipantId struct {
-	ParticipantId string `protobuf:"bytes,5,opt,name=participant_id,json=participantId,proto3,oneof"` // if researcher upload for participant directly
+func (x *DryRunRulesResp) Reset() {
+	*x = DryRunRulesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*UploadParticipantFileReq_Info_ProfileId) isUploadParticipantFileReq_Info_Participant() {}
+func (x *DryRunRulesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*UploadParticipantFileReq_Info_ParticipantId) isUploadParticipantFileReq_Info_Participant() {}
+func (*DryRunRulesResp) ProtoMessage() {}
 
-type RunRulesForPreviousResponsesReq_ResponseFilter struct {
+func (x *DryRunRulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunRulesResp.ProtoReflect.Descriptor instead.
+func (*DryRunRulesResp) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (m *DryRunRulesResp) GetData() isDryRunRulesResp_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *DryRunRulesResp) GetParticipantResult() *DryRunParticipantResult {
+	if x, ok := x.GetData().(*DryRunRulesResp_ParticipantResult); ok {
+		return x.ParticipantResult
+	}
+	return nil
+}
+
+func (x *DryRunRulesResp) GetSummary() *RuleRunSummary {
+	if x, ok := x.GetData().(*DryRunRulesResp_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isDryRunRulesResp_Data interface {
+	isDryRunRulesResp_Data()
+}
+
+type DryRunRulesResp_ParticipantResult struct {
+	// changes for one participant, only sent if the rules would change something or fail
+	ParticipantResult *DryRunParticipantResult `protobuf:"bytes,1,opt,name=participant_result,json=participantResult,proto3,oneof"`
+}
+
+type DryRunRulesResp_Summary struct {
+	// sent as last message
+	Summary *RuleRunSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*DryRunRulesResp_ParticipantResult) isDryRunRulesResp_Data() {}
+
+func (*DryRunRulesResp_Summary) isDryRunRulesResp_Data() {}
+
+type ConvertTempParticipantReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SurveyKeys        []string `protobuf:"bytes,1,rep,name=survey_keys,json=surveyKeys,proto3" json:"survey_keys,omitempty"`
-	ParticipantIds    []string `protobuf:"bytes,2,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
-	ParticipantStatus []string `protobuf:"bytes,3,rep,name=participant_status,json=participantStatus,proto3" json:"participant_status,omitempty"`
-	From              int64    `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
-	Until             int64    `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+	Token                  *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey               string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	ProfileId              string                `protobuf:"bytes,3,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	TemporaryParticipantId string                `protobuf:"bytes,4,opt,name=temporary_participant_id,json=temporaryParticipantId,proto3" json:"temporary_participant_id,omitempty"`
+	Timestamp              int64                 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // second piece of info to confirm knowledge of the participant
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) Reset() {
-	*x = RunRulesForPreviousResponsesReq_ResponseFilter{}
+func (x *ConvertTempParticipantReq) Reset() {
+	*x = ConvertTempParticipantReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_service_proto_msgTypes[70]
+		mi := &file_study_service_study_service_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) String() string {
+func (x *ConvertTempParticipantReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunRulesForPreviousResponsesReq_ResponseFilter) ProtoMessage() {}
+func (*ConvertTempParticipantReq) ProtoMessage() {}
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_service_proto_msgTypes[70]
+func (x *ConvertTempParticipantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4524,97 +4839,1133 @@ func (x *RunRulesForPreviousResponsesReq_ResponseFilter) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunRulesForPreviousResponsesReq_ResponseFilter.ProtoReflect.Descriptor instead.
-func (*RunRulesForPreviousResponsesReq_ResponseFilter) Descriptor() ([]byte, []int) {
-	return file_study_service_study_service_proto_rawDescGZIP(), []int{55, 0}
+// Deprecated: Use ConvertTempParticipantReq.ProtoReflect.Descriptor instead.
+func (*ConvertTempParticipantReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetSurveyKeys() []string {
+func (x *ConvertTempParticipantReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.SurveyKeys
+		return x.Token
 	}
 	return nil
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetParticipantIds() []string {
+func (x *ConvertTempParticipantReq) GetStudyKey() string {
 	if x != nil {
-		return x.ParticipantIds
+		return x.StudyKey
 	}
-	return nil
+	return ""
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetParticipantStatus() []string {
+func (x *ConvertTempParticipantReq) GetProfileId() string {
 	if x != nil {
-		return x.ParticipantStatus
+		return x.ProfileId
 	}
-	return nil
+	return ""
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetFrom() int64 {
+func (x *ConvertTempParticipantReq) GetTemporaryParticipantId() string {
 	if x != nil {
-		return x.From
+		return x.TemporaryParticipantId
+	}
+	return ""
+}
+
+func (x *ConvertTempParticipantReq) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetUntil() int64 {
+type RegisterTempParticipantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	StudyKey   string `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+}
+
+func (x *RegisterTempParticipantReq) Reset() {
+	*x = RegisterTempParticipantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterTempParticipantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTempParticipantReq) ProtoMessage() {}
+
+func (x *RegisterTempParticipantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterTempParticipantReq.ProtoReflect.Descriptor instead.
+func (*RegisterTempParticipantReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RegisterTempParticipantReq) GetInstanceId() string {
 	if x != nil {
-		return x.Until
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *RegisterTempParticipantReq) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+type RegisterTempParticipantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemporaryParticipantId string `protobuf:"bytes,1,opt,name=temporary_participant_id,json=temporaryParticipantId,proto3" json:"temporary_participant_id,omitempty"`
+	Timestamp              int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *RegisterTempParticipantResponse) Reset() {
+	*x = RegisterTempParticipantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterTempParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTempParticipantResponse) ProtoMessage() {}
+
+func (x *RegisterTempParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterTempParticipantResponse.ProtoReflect.Descriptor instead.
+func (*RegisterTempParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RegisterTempParticipantResponse) GetTemporaryParticipantId() string {
+	if x != nil {
+		return x.TemporaryParticipantId
+	}
+	return ""
+}
+
+func (x *RegisterTempParticipantResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
 	}
 	return 0
 }
 
-var File_study_service_study_service_proto protoreflect.FileDescriptor
+type GetAssignedSurveysForTemporaryParticipantReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_study_service_study_service_proto_rawDesc = []byte{
-	0x0a, 0x21, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a,
-	0x16, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1c, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1a, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x2d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x25, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x54, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
-	0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd7, 0x03, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x4f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0xc9, 0x02,
-	0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75,
-	0x62, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x75, 0x62, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	InstanceId             string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	StudyKey               string `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	TemporaryParticipantId string `protobuf:"bytes,3,opt,name=temporary_participant_id,json=temporaryParticipantId,proto3" json:"temporary_participant_id,omitempty"`
+}
+
+func (x *GetAssignedSurveysForTemporaryParticipantReq) Reset() {
+	*x = GetAssignedSurveysForTemporaryParticipantReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssignedSurveysForTemporaryParticipantReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignedSurveysForTemporaryParticipantReq) ProtoMessage() {}
+
+func (x *GetAssignedSurveysForTemporaryParticipantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignedSurveysForTemporaryParticipantReq.ProtoReflect.Descriptor instead.
+func (*GetAssignedSurveysForTemporaryParticipantReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetAssignedSurveysForTemporaryParticipantReq) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetAssignedSurveysForTemporaryParticipantReq) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *GetAssignedSurveysForTemporaryParticipantReq) GetTemporaryParticipantId() string {
+	if x != nil {
+		return x.TemporaryParticipantId
+	}
+	return ""
+}
+
+type ConfidentialResponsesQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token          *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey       string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	ParticipantIds []string              `protobuf:"bytes,3,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
+	Condition      *Expression           `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	KeyFilter      string                `protobuf:"bytes,5,opt,name=key_filter,json=keyFilter,proto3" json:"key_filter,omitempty"` // optional key filter to retrieve only for this one
+}
+
+func (x *ConfidentialResponsesQuery) Reset() {
+	*x = ConfidentialResponsesQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfidentialResponsesQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidentialResponsesQuery) ProtoMessage() {}
+
+func (x *ConfidentialResponsesQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidentialResponsesQuery.ProtoReflect.Descriptor instead.
+func (*ConfidentialResponsesQuery) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ConfidentialResponsesQuery) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ConfidentialResponsesQuery) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *ConfidentialResponsesQuery) GetParticipantIds() []string {
+	if x != nil {
+		return x.ParticipantIds
+	}
+	return nil
+}
+
+func (x *ConfidentialResponsesQuery) GetCondition() *Expression {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *ConfidentialResponsesQuery) GetKeyFilter() string {
+	if x != nil {
+		return x.KeyFilter
+	}
+	return ""
+}
+
+type ConfidentialResponses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*SurveyResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *ConfidentialResponses) Reset() {
+	*x = ConfidentialResponses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfidentialResponses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidentialResponses) ProtoMessage() {}
+
+func (x *ConfidentialResponses) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidentialResponses.ProtoReflect.Descriptor instead.
+func (*ConfidentialResponses) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ConfidentialResponses) GetResponses() []*SurveyResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type GetRandomizationAllocationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token            *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey         string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	RandomizationKey string                `protobuf:"bytes,3,opt,name=randomization_key,json=randomizationKey,proto3" json:"randomization_key,omitempty"` // optional, all randomization keys of the study if empty
+}
+
+func (x *GetRandomizationAllocationsReq) Reset() {
+	*x = GetRandomizationAllocationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRandomizationAllocationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandomizationAllocationsReq) ProtoMessage() {}
+
+func (x *GetRandomizationAllocationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandomizationAllocationsReq.ProtoReflect.Descriptor instead.
+func (*GetRandomizationAllocationsReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetRandomizationAllocationsReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GetRandomizationAllocationsReq) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *GetRandomizationAllocationsReq) GetRandomizationKey() string {
+	if x != nil {
+		return x.RandomizationKey
+	}
+	return ""
+}
+
+// participants allocated by RANDOMIZE_TO_ARM for one randomization key and stratum
+type RandomizationAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RandomizationKey string           `protobuf:"bytes,1,opt,name=randomization_key,json=randomizationKey,proto3" json:"randomization_key,omitempty"`
+	Stratum          string           `protobuf:"bytes,2,opt,name=stratum,proto3" json:"stratum,omitempty"`
+	Total            int64            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Counts           map[string]int64 `protobuf:"bytes,4,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // participants per arm
+	UpdatedAt        int64            `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *RandomizationAllocation) Reset() {
+	*x = RandomizationAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomizationAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomizationAllocation) ProtoMessage() {}
+
+func (x *RandomizationAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomizationAllocation.ProtoReflect.Descriptor instead.
+func (*RandomizationAllocation) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RandomizationAllocation) GetRandomizationKey() string {
+	if x != nil {
+		return x.RandomizationKey
+	}
+	return ""
+}
+
+func (x *RandomizationAllocation) GetStratum() string {
+	if x != nil {
+		return x.Stratum
+	}
+	return ""
+}
+
+func (x *RandomizationAllocation) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RandomizationAllocation) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *RandomizationAllocation) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type RandomizationAllocations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allocations []*RandomizationAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+}
+
+func (x *RandomizationAllocations) Reset() {
+	*x = RandomizationAllocations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomizationAllocations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomizationAllocations) ProtoMessage() {}
+
+func (x *RandomizationAllocations) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomizationAllocations.ProtoReflect.Descriptor instead.
+func (*RandomizationAllocations) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RandomizationAllocations) GetAllocations() []*RandomizationAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type ExternalEventOutboxQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	Status   string                `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, delivered or deadLetter, all if empty
+}
+
+func (x *ExternalEventOutboxQuery) Reset() {
+	*x = ExternalEventOutboxQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalEventOutboxQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalEventOutboxQuery) ProtoMessage() {}
+
+func (x *ExternalEventOutboxQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalEventOutboxQuery.ProtoReflect.Descriptor instead.
+func (*ExternalEventOutboxQuery) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExternalEventOutboxQuery) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ExternalEventOutboxQuery) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxQuery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// asynchronous event of EXTERNAL_EVENT_HANDLER in the outbox of a study
+type ExternalEventOutboxEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceName   string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Route         string `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	EventType     string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	ParticipantId string `protobuf:"bytes,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt int64  `protobuf:"varint,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastError     string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt   int64  `protobuf:"varint,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+}
+
+func (x *ExternalEventOutboxEntry) Reset() {
+	*x = ExternalEventOutboxEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalEventOutboxEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalEventOutboxEntry) ProtoMessage() {}
+
+func (x *ExternalEventOutboxEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalEventOutboxEntry.ProtoReflect.Descriptor instead.
+func (*ExternalEventOutboxEntry) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ExternalEventOutboxEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ExternalEventOutboxEntry) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *ExternalEventOutboxEntry) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ExternalEventOutboxEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ExternalEventOutboxEntry) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+type ExternalEventOutbox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ExternalEventOutboxEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ExternalEventOutbox) Reset() {
+	*x = ExternalEventOutbox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalEventOutbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalEventOutbox) ProtoMessage() {}
+
+func (x *ExternalEventOutbox) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalEventOutbox.ProtoReflect.Descriptor instead.
+func (*ExternalEventOutbox) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExternalEventOutbox) GetEntries() []*ExternalEventOutboxEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RedriveExternalEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	EventIds []string              `protobuf:"bytes,3,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"` // all dead letters of the study if empty
+}
+
+func (x *RedriveExternalEventsReq) Reset() {
+	*x = RedriveExternalEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedriveExternalEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveExternalEventsReq) ProtoMessage() {}
+
+func (x *RedriveExternalEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveExternalEventsReq.ProtoReflect.Descriptor instead.
+func (*RedriveExternalEventsReq) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RedriveExternalEventsReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RedriveExternalEventsReq) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *RedriveExternalEventsReq) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+type RedriveExternalEventsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RedriveExternalEventsResp) Reset() {
+	*x = RedriveExternalEventsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedriveExternalEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedriveExternalEventsResp) ProtoMessage() {}
+
+func (x *RedriveExternalEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedriveExternalEventsResp.ProtoReflect.Descriptor instead.
+func (*RedriveExternalEventsResp) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RedriveExternalEventsResp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UploadParticipantFileReq_Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudyKey string                `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	SubStudy string                `protobuf:"bytes,3,opt,name=sub_study,json=subStudy,proto3" json:"sub_study,omitempty"`
+	// Types that are assignable to Participant:
+	//	*UploadParticipantFileReq_Info_ProfileId
+	//	*UploadParticipantFileReq_Info_ParticipantId
+	Participant          isUploadParticipantFileReq_Info_Participant `protobuf_oneof:"participant"`
+	FileType             *FileType                                   `protobuf:"bytes,6,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	VisibleToParticipant bool                                        `protobuf:"varint,7,opt,name=visible_to_participant,json=visibleToParticipant,proto3" json:"visible_to_participant,omitempty"`
+}
+
+func (x *UploadParticipantFileReq_Info) Reset() {
+	*x = UploadParticipantFileReq_Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadParticipantFileReq_Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadParticipantFileReq_Info) ProtoMessage() {}
+
+func (x *UploadParticipantFileReq_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadParticipantFileReq_Info.ProtoReflect.Descriptor instead.
+func (*UploadParticipantFileReq_Info) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *UploadParticipantFileReq_Info) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *UploadParticipantFileReq_Info) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *UploadParticipantFileReq_Info) GetSubStudy() string {
+	if x != nil {
+		return x.SubStudy
+	}
+	return ""
+}
+
+func (m *UploadParticipantFileReq_Info) GetParticipant() isUploadParticipantFileReq_Info_Participant {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+func (x *UploadParticipantFileReq_Info) GetProfileId() string {
+	if x, ok := x.GetParticipant().(*UploadParticipantFileReq_Info_ProfileId); ok {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *UploadParticipantFileReq_Info) GetParticipantId() string {
+	if x, ok := x.GetParticipant().(*UploadParticipantFileReq_Info_ParticipantId); ok {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *UploadParticipantFileReq_Info) GetFileType() *FileType {
+	if x != nil {
+		return x.FileType
+	}
+	return nil
+}
+
+func (x *UploadParticipantFileReq_Info) GetVisibleToParticipant() bool {
+	if x != nil {
+		return x.VisibleToParticipant
+	}
+	return false
+}
+
+type isUploadParticipantFileReq_Info_Participant interface {
+	isUploadParticipantFileReq_Info_Participant()
+}
+
+type UploadParticipantFileReq_Info_ProfileId struct {
+	ProfileId string `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3,oneof"`
+}
+
+type UploadParticipantFileReq_Info_ParticipantId struct {
+	ParticipantId string `protobuf:"bytes,5,opt,name=participant_id,json=participantId,proto3,oneof"` // if researcher upload for participant directly
+}
+
+func (*UploadParticipantFileReq_Info_ProfileId) isUploadParticipantFileReq_Info_Participant() {}
+
+func (*UploadParticipantFileReq_Info_ParticipantId) isUploadParticipantFileReq_Info_Participant() {}
+
+type RunRulesForPreviousResponsesReq_ResponseFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SurveyKeys        []string `protobuf:"bytes,1,rep,name=survey_keys,json=surveyKeys,proto3" json:"survey_keys,omitempty"`
+	ParticipantIds    []string `protobuf:"bytes,2,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
+	ParticipantStatus []string `protobuf:"bytes,3,rep,name=participant_status,json=participantStatus,proto3" json:"participant_status,omitempty"`
+	From              int64    `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	Until             int64    `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) Reset() {
+	*x = RunRulesForPreviousResponsesReq_ResponseFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRulesForPreviousResponsesReq_ResponseFilter) ProtoMessage() {}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRulesForPreviousResponsesReq_ResponseFilter.ProtoReflect.Descriptor instead.
+func (*RunRulesForPreviousResponsesReq_ResponseFilter) Descriptor() ([]byte, []int) {
+	return file_study_service_study_service_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetSurveyKeys() []string {
+	if x != nil {
+		return x.SurveyKeys
+	}
+	return nil
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetParticipantIds() []string {
+	if x != nil {
+		return x.ParticipantIds
+	}
+	return nil
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetParticipantStatus() []string {
+	if x != nil {
+		return x.ParticipantStatus
+	}
+	return nil
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *RunRulesForPreviousResponsesReq_ResponseFilter) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+var File_study_service_study_service_proto protoreflect.FileDescriptor
+
+var file_study_service_study_service_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a,
+	0x16, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x2d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x25, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x54, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
+	0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd7, 0x03, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x4f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0xc9, 0x02,
+	0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75,
+	0x62, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69,
 	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x70,
 	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74,
 	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
@@ -4788,984 +6139,1294 @@ var file_study_service_study_service_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75,
 	0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
 	0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x96,
-	0x01, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd5, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x5e, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x6f,
-	0x72, 0x74, 0x42, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xd4, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc5,
+	0x01, 0x0a, 0x1c, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xd5, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x5e, 0x0a, 0x07,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x1a, 0x39, 0x0a, 0x0b,
+	0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x1f, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x85,
+	0x02, 0x0a, 0x17, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x16, 0x73, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x47, 0x0a,
+	0x19, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc1, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
 	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x17, 0x53, 0x74, 0x75, 0x64, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12,
-	0x83, 0x01, 0x0a, 0x16, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x4d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74,
-	0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x14, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x47, 0x0a, 0x19, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x4e, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x9c, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x6f, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc1,
-	0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x75, 0x64, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55,
+	0x0a, 0x0d, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x44, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x7b, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
 	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x9c, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4f, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x0d, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x9f, 0x01,
-	0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22,
-	0x7b, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f,
-	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0xb2, 0x01, 0x0a,
-	0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0b, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d,
-	0x41, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10,
-	0x01, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x05,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x05,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x22, 0x93, 0x02, 0x0a, 0x10, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
-	0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x75,
-	0x72, 0x76, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x06,
-	0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x5e, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x65, 0x66, 0x69, 0x6c,
-	0x6c, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x11, 0x53,
-	0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x4b, 0x65, 0x79, 0x22, 0xe7, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4e,
-	0x0a, 0x0e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x3c, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x9e,
-	0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12,
-	0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x22,
-	0xf1, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x1f, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0x26, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x01, 0x22, 0x89, 0x02, 0x0a, 0x15, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
+	0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x75,
 	0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
 	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a,
-	0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x35,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b,
-	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49,
-	0x64, 0x22, 0x5d, 0x0a, 0x0e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
-	0x52, 0x0e, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x85, 0x02, 0x0a, 0x16, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38,
-	0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1d, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x75, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x79, 0x52, 0x05, 0x73, 0x74, 0x75, 0x64, 0x79, 0x22, 0x93, 0x02, 0x0a, 0x10, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x52, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x44, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x67, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xe7, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x75,
+	0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x4e, 0x0a, 0x0e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66,
+	0x6f, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x06, 0x73, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x22, 0xf1, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
 	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0xb1, 0x01, 0x0a, 0x1d, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x46, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x1f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x82, 0x01, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d,
+	0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
 	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
 	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75,
 	0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x0e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x73, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x52, 0x0e, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x16, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1d,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x1d, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x3a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xb6, 0x02, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6f,
+	0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x29, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x0d,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x07, 0x53, 0x74,
+	0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69,
+	0x65, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x0d,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
 	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
 	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65,
 	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65,
-	0x79, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x75, 0x6e, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x65, 0x79, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x79, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x54, 0x65, 0x78, 0x74, 0x22, 0xfb, 0x01, 0x0a, 0x1f, 0x52, 0x75, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0xcd, 0x03, 0x0a, 0x1f, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
 	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
 	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e,
 	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xb6, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x79, 0x5f,
-	0x66, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f,
-	0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f,
-	0x6e, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a,
-	0x11, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a,
-	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e,
-	0x74, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x85, 0x01, 0x0a, 0x29, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0xb3,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x20, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12,
 	0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68,
 	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x07, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65,
-	0x73, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x07, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x22, 0xa6,
-	0x01, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
-	0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3c, 0x0a,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x1f,
-	0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x4b, 0x65, 0x79, 0x12, 0x51, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x52, 0x75, 0x6c, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x75,
+	0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xa2, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x75, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12,
 	0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68,
 	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52,
 	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
 	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x70, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x48, 0x0a, 0x21, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x1d, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x74,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x12,
+	0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6e,
+	0x75, 0x6d, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe9, 0x02, 0x0a, 0x0d, 0x52, 0x75, 0x6c, 0x65,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3e, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64,
+	0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
 	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xcd, 0x03, 0x0a, 0x1f, 0x52, 0x75, 0x6e,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79,
-	0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x62,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x1a, 0xb3, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f,
-	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12,
-	0x2d, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x75,
-	0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x44, 0x42,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6e,
+	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x66, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x73, 0x22, 0xe5, 0x04, 0x0a, 0x17, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x4e, 0x0a, 0x11,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x59, 0x0a, 0x13,
+	0x72, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x12, 0x72, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x5d, 0x0a,
+	0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x15,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x08, 0x52, 0x12, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x44, 0x0a, 0x08, 0x64, 0x62, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x44, 0x42, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x62,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x0f,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x64, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x00, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe6, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5a,
+	0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x79, 0x0a, 0x1f, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa6, 0x01, 0x0a, 0x2c, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x46, 0x6f, 0x72, 0x54,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
+	0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xfe,
+	0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x61, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0xa9, 0x02, 0x0a, 0x17, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x72, 0x61, 0x74, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x57, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x71, 0x0a, 0x18, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55,
+	0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe6,
+	0x02, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x4e,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x8b,
+	0x01, 0x0a, 0x18, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
 	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa2,
-	0x01, 0x0a, 0x0d, 0x53, 0x74, 0x75, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x05, 0x70, 0x72,
-	0x6f, 0x70, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x21, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x70, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x1d,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x19, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x5a, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65,
-	0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x79,
-	0x0a, 0x1f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa6, 0x01, 0x0a, 0x2c, 0x47, 0x65,
+	0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x19,
+	0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32,
+	0xc5, 0x42, 0x0a, 0x0f, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x70, 0x69, 0x12, 0x4b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x68, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x2d,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x62, 0x0a, 0x12, 0x47, 0x65,
 	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73,
-	0x46, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x74, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
 	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x44,
-	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x09,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x32, 0xc2, 0x3a, 0x0a, 0x0f, 0x53, 0x74, 0x75, 0x64, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x70, 0x69, 0x12, 0x4b, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x69,
+	0x73, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x75,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x41, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x6c, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x73, 0x12, 0x64, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64,
+	0x79, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x2b, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x29, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
 	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x68, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x65, 0x72,
-	0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x75, 0x0a, 0x15,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
 	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x28, 0x01, 0x12, 0x7a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
 	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
-	0x73, 0x12, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
 	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75,
-	0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x75, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x93, 0x01,
+	0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x36,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x6c, 0x0a, 0x0e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x64, 0x0a, 0x0a, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79,
-	0x4d, 0x73, 0x67, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73,
-	0x12, 0x5c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x75, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x28, 0x01, 0x12, 0x7a, 0x0a, 0x16, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x69,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x75,
-	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x93, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x46, 0x6f,
 	0x72, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x48, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
 	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x3b, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1d, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x54,
-	0x6f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x35, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x74, 0x54, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xa2,
-	0x01, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75,
 	0x72, 0x76, 0x65, 0x79, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x48, 0x2e, 0x69,
+	0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x66, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x46, 0x6f, 0x72, 0x54,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x73, 0x12, 0x66, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x71, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69,
+	0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65,
+	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x23, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65,
+	0x73, 0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x20, 0x48, 0x61, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x29,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
 	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x71, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x58,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x69,
-	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x33,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
 	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x75, 0x64, 0x79, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12,
-	0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75,
-	0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2a,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x88,
+	0x01, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x12, 0x3c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x20, 0x48,
-	0x61, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7e, 0x0a, 0x18, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
 	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66,
+	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x70, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x26,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x45, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x60, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x75, 0x64, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x7e, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x69,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x55, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x23, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x70, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x46, 0x6f,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x26, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x45, 0x2e,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65,
+	0x73, 0x12, 0x5c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x2d, 0x2e,
 	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x60, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e,
-	0x65, 0x77, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12,
+	0x60, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
 	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x12, 0x55, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x69,
-	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x75, 0x64, 0x79, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x60, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74,
-	0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x62, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0xa6, 0x01, 0x0a,
-	0x26, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x79, 0x12, 0x62, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0xa6, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x45, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x35,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x29, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xac,
+	0x01, 0x0a, 0x29, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x48, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
-	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64,
-	0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x47, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x66,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x98, 0x01,
+	0x0a, 0x28, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x47, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12,
-	0x60, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x12, 0x5e, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x50, 0x72,
-	0x6f, 0x70, 0x73, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x69,
+	0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
 	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x12, 0x5e, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64,
-	0x79, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x17, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x61, 0x0a, 0x11, 0x53, 0x61,
-	0x76, 0x65, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12,
-	0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64,
-	0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x77, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x69, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x5e, 0x0a, 0x0e, 0x53, 0x61,
+	0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x29, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x23, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x3c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x79, 0x12, 0x6a, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12,
+	0x5e, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12,
+	0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
 	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x71,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x75, 0x64,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x69, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65,
-	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
 	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79,
-	0x73, 0x12, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x44, 0x65,
-	0x66, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
 	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x17, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x56, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x7b, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66,
+	0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x61, 0x0a, 0x11, 0x53,
+	0x61, 0x76, 0x65, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x54, 0x6f, 0x53, 0x74, 0x75, 0x64, 0x79,
+	0x12, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64,
+	0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x66,
 	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x70, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x77,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x69, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
 	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x44,
+	0x65, 0x66, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x7b, 0x0a, 0x13, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x70, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
 	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x61, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x46, 0x6f, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
 	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x61, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x87,
+	0x01, 0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12,
+	0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69,
 	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x67, 0x0a, 0x0b, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x46, 0x6f, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x12, 0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x87, 0x01,
-	0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3b,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x30,
+	0x01, 0x12, 0x8f, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x34, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x75, 0x6e,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x82, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x75, 0x0a, 0x14,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
 	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x30, 0x01, 0x12, 0x7c, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x31,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x30,
-	0x01, 0x12, 0x9c, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x1a, 0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x35, 0x2e, 0x69, 0x6e,
+	0x65, 0x2e, 0x52, 0x65, 0x64, 0x72, 0x69, 0x76, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x82, 0x01, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x33, 0x2e, 0x69, 0x6e,
 	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x75, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x7c, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
 	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x6b, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x1a, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x9c, 0x01,
+	0x0a, 0x22, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x35, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x6f, 0x0a,
-	0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x29, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x85,
-	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x1a, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2c,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x6b, 0x0a, 0x13,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
 	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x57, 0x69, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x43, 0x53, 0x56, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
 	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x43, 0x53, 0x56, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x1a, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
 	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x74,
-	0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x22, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x74, 0x4a,
-	0x53, 0x4f, 0x4e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
 	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x30, 0x01, 0x12,
-	0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x53, 0x56, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x30, 0x01, 0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49,
-	0x6e, 0x66, 0x6f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2c, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x57, 0x69, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x43, 0x53, 0x56, 0x12,
+	0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x43,
+	0x53, 0x56, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x74, 0x4a, 0x53, 0x4f, 0x4e,
+	0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e,
 	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65,
-	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x29,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x53, 0x56, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x77,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5781,7 +7442,7 @@ func file_study_service_study_service_proto_rawDescGZIP() []byte {
 }
 
 var file_study_service_study_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_study_service_study_service_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_study_service_study_service_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_study_service_study_service_proto_goTypes = []interface{}{
 	(ServiceStatus_StatusValue)(0),                         // 0: influenzanet.study_service.ServiceStatus.StatusValue
 	(*StudiesForUser)(nil),                                 // 1: influenzanet.study_service.StudiesForUser
@@ -5802,282 +7463,342 @@ var file_study_service_study_service_proto_goTypes = []interface{}{
 	(*ReportHistoryQuery)(nil),                             // 16: influenzanet.study_service.ReportHistoryQuery
 	(*FileInfoQuery)(nil),                                  // 17: influenzanet.study_service.FileInfoQuery
 	(*ParticipantStateQuery)(nil),                          // 18: influenzanet.study_service.ParticipantStateQuery
-	(*ParticipantStateByIDQuery)(nil),                      // 19: influenzanet.study_service.ParticipantStateByIDQuery
-	(*GetPStatesWithPaginationQuery)(nil),                  // 20: influenzanet.study_service.GetPStatesWithPaginationQuery
-	(*ParticipantStatesWithPagination)(nil),                // 21: influenzanet.study_service.ParticipantStatesWithPagination
-	(*StudyResponseStatistics)(nil),                        // 22: influenzanet.study_service.StudyResponseStatistics
-	(*ProfilesWithConditionReq)(nil),                       // 23: influenzanet.study_service.ProfilesWithConditionReq
-	(*GetParticipantMessagesReq)(nil),                      // 24: influenzanet.study_service.GetParticipantMessagesReq
-	(*GetReseacherMessagesReq)(nil),                        // 25: influenzanet.study_service.GetReseacherMessagesReq
-	(*GetStudiesWithPendingParticipantMessagesReq)(nil),    // 26: influenzanet.study_service.GetStudiesWithPendingParticipantMessagesReq
-	(*StudyMessage)(nil),                                   // 27: influenzanet.study_service.StudyMessage
-	(*StudyMessages)(nil),                                  // 28: influenzanet.study_service.StudyMessages
-	(*DeleteMessagesFromParticipantReq)(nil),               // 29: influenzanet.study_service.DeleteMessagesFromParticipantReq
-	(*DeleteResearcherMessagesReq)(nil),                    // 30: influenzanet.study_service.DeleteResearcherMessagesReq
-	(*ServiceStatus)(nil),                                  // 31: influenzanet.study_service.ServiceStatus
-	(*NewStudyRequest)(nil),                                // 32: influenzanet.study_service.NewStudyRequest
-	(*SurveyAndContext)(nil),                               // 33: influenzanet.study_service.SurveyAndContext
-	(*StudyReferenceReq)(nil),                              // 34: influenzanet.study_service.StudyReferenceReq
-	(*StudyRulesHistoryReq)(nil),                           // 35: influenzanet.study_service.StudyRulesHistoryReq
-	(*SurveyInfoResp)(nil),                                 // 36: influenzanet.study_service.SurveyInfoResp
-	(*AddSurveyReq)(nil),                                   // 37: influenzanet.study_service.AddSurveyReq
-	(*SubmitResponseReq)(nil),                              // 38: influenzanet.study_service.SubmitResponseReq
-	(*EnterStudyRequest)(nil),                              // 39: influenzanet.study_service.EnterStudyRequest
-	(*LeaveStudyMsg)(nil),                                  // 40: influenzanet.study_service.LeaveStudyMsg
-	(*SurveyVersions)(nil),                                 // 41: influenzanet.study_service.SurveyVersions
-	(*SurveyReferenceRequest)(nil),                         // 42: influenzanet.study_service.SurveyReferenceRequest
-	(*StudyRulesVersionReferenceReq)(nil),                  // 43: influenzanet.study_service.StudyRulesVersionReferenceReq
-	(*SurveyVersionReferenceRequest)(nil),                  // 44: influenzanet.study_service.SurveyVersionReferenceRequest
-	(*GetSurveyKeysRequest)(nil),                           // 45: influenzanet.study_service.GetSurveyKeysRequest
-	(*SurveyKeys)(nil),                                     // 46: influenzanet.study_service.SurveyKeys
-	(*CreateReportReq)(nil),                                // 47: influenzanet.study_service.CreateReportReq
-	(*GetReportsForUserReq)(nil),                           // 48: influenzanet.study_service.GetReportsForUserReq
-	(*RemoveConfidentialResponsesForProfilesReq)(nil),      // 49: influenzanet.study_service.RemoveConfidentialResponsesForProfilesReq
-	(*ReportHistory)(nil),                                  // 50: influenzanet.study_service.ReportHistory
-	(*GetStudiesForUserReq)(nil),                           // 51: influenzanet.study_service.GetStudiesForUserReq
-	(*Studies)(nil),                                        // 52: influenzanet.study_service.Studies
-	(*StudyMemberReq)(nil),                                 // 53: influenzanet.study_service.StudyMemberReq
-	(*StudyRulesReq)(nil),                                  // 54: influenzanet.study_service.StudyRulesReq
-	(*RunRulesForSingleParticipantReq)(nil),                // 55: influenzanet.study_service.RunRulesForSingleParticipantReq
-	(*RunRulesForPreviousResponsesReq)(nil),                // 56: influenzanet.study_service.RunRulesForPreviousResponsesReq
-	(*StudyStatusReq)(nil),                                 // 57: influenzanet.study_service.StudyStatusReq
-	(*StudyPropsReq)(nil),                                  // 58: influenzanet.study_service.StudyPropsReq
-	(*RuleRunSummary)(nil),                                 // 59: influenzanet.study_service.RuleRunSummary
-	(*ConvertTempParticipantReq)(nil),                      // 60: influenzanet.study_service.ConvertTempParticipantReq
-	(*RegisterTempParticipantReq)(nil),                     // 61: influenzanet.study_service.RegisterTempParticipantReq
-	(*RegisterTempParticipantResponse)(nil),                // 62: influenzanet.study_service.RegisterTempParticipantResponse
-	(*GetAssignedSurveysForTemporaryParticipantReq)(nil),   // 63: influenzanet.study_service.GetAssignedSurveysForTemporaryParticipantReq
-	(*ConfidentialResponsesQuery)(nil),                     // 64: influenzanet.study_service.ConfidentialResponsesQuery
-	(*ConfidentialResponses)(nil),                          // 65: influenzanet.study_service.ConfidentialResponses
-	(*UploadParticipantFileReq_Info)(nil),                  // 66: influenzanet.study_service.UploadParticipantFileReq.Info
-	nil,                                                    // 67: influenzanet.study_service.SurveyResponseQuery.ContextQueryEntry
-	nil,                                                    // 68: influenzanet.study_service.GetPStatesWithPaginationQuery.SortByEntry
-	nil,                                                    // 69: influenzanet.study_service.StudyResponseStatistics.SurveyResponseCountsEntry
-	nil,                                                    // 70: influenzanet.study_service.StudyMessage.PayloadEntry
-	(*RunRulesForPreviousResponsesReq_ResponseFilter)(nil), // 71: influenzanet.study_service.RunRulesForPreviousResponsesReq.ResponseFilter
-	(*StudyForUser)(nil),                                   // 72: influenzanet.study_service.StudyForUser
-	(*api_types.TokenInfos)(nil),                           // 73: influenzanet.shared.TokenInfos
-	(*ParticipantState)(nil),                               // 74: influenzanet.study_service.ParticipantState
-	(*ExpressionArg)(nil),                                  // 75: influenzanet.study_service.ExpressionArg
-	(*Study)(nil),                                          // 76: influenzanet.study_service.Study
-	(*Survey)(nil),                                         // 77: influenzanet.study_service.Survey
-	(*SurveyContext)(nil),                                  // 78: influenzanet.study_service.SurveyContext
-	(*SurveyResponse)(nil),                                 // 79: influenzanet.study_service.SurveyResponse
-	(*SurveyInfo)(nil),                                     // 80: influenzanet.study_service.SurveyInfo
-	(*Report)(nil),                                         // 81: influenzanet.study_service.Report
-	(*Study_Member)(nil),                                   // 82: influenzanet.study_service.Study.Member
-	(*Expression)(nil),                                     // 83: influenzanet.study_service.Expression
-	(*Study_Props)(nil),                                    // 84: influenzanet.study_service.Study.Props
-	(*emptypb.Empty)(nil),                                  // 85: google.protobuf.Empty
-	(*ResponseExportQuery)(nil),                            // 86: influenzanet.study_service.ResponseExportQuery
-	(*SurveyInfoExportQuery)(nil),                          // 87: influenzanet.study_service.SurveyInfoExportQuery
-	(*AssignedSurveys)(nil),                                // 88: influenzanet.study_service.AssignedSurveys
-	(*Chunk)(nil),                                          // 89: influenzanet.study_service.Chunk
-	(*StudyRules)(nil),                                     // 90: influenzanet.study_service.StudyRules
-	(*StudyRulesHistory)(nil),                              // 91: influenzanet.study_service.StudyRulesHistory
-	(*SurveyInfoExport)(nil),                               // 92: influenzanet.study_service.SurveyInfoExport
+	(*ParticipantStateHistoryQuery)(nil),                   // 19: influenzanet.study_service.ParticipantStateHistoryQuery
+	(*ParticipantStateByIDQuery)(nil),                      // 20: influenzanet.study_service.ParticipantStateByIDQuery
+	(*GetPStatesWithPaginationQuery)(nil),                  // 21: influenzanet.study_service.GetPStatesWithPaginationQuery
+	(*ParticipantStatesWithPagination)(nil),                // 22: influenzanet.study_service.ParticipantStatesWithPagination
+	(*StudyResponseStatistics)(nil),                        // 23: influenzanet.study_service.StudyResponseStatistics
+	(*ProfilesWithConditionReq)(nil),                       // 24: influenzanet.study_service.ProfilesWithConditionReq
+	(*GetParticipantMessagesReq)(nil),                      // 25: influenzanet.study_service.GetParticipantMessagesReq
+	(*GetReseacherMessagesReq)(nil),                        // 26: influenzanet.study_service.GetReseacherMessagesReq
+	(*GetStudiesWithPendingParticipantMessagesReq)(nil),    // 27: influenzanet.study_service.GetStudiesWithPendingParticipantMessagesReq
+	(*StudyMessage)(nil),                                   // 28: influenzanet.study_service.StudyMessage
+	(*StudyMessages)(nil),                                  // 29: influenzanet.study_service.StudyMessages
+	(*DeleteMessagesFromParticipantReq)(nil),               // 30: influenzanet.study_service.DeleteMessagesFromParticipantReq
+	(*DeleteResearcherMessagesReq)(nil),                    // 31: influenzanet.study_service.DeleteResearcherMessagesReq
+	(*ServiceStatus)(nil),                                  // 32: influenzanet.study_service.ServiceStatus
+	(*ExternalServiceHealth)(nil),                          // 33: influenzanet.study_service.ExternalServiceHealth
+	(*NewStudyRequest)(nil),                                // 34: influenzanet.study_service.NewStudyRequest
+	(*SurveyAndContext)(nil),                               // 35: influenzanet.study_service.SurveyAndContext
+	(*StudyReferenceReq)(nil),                              // 36: influenzanet.study_service.StudyReferenceReq
+	(*StudyRulesHistoryReq)(nil),                           // 37: influenzanet.study_service.StudyRulesHistoryReq
+	(*SurveyInfoResp)(nil),                                 // 38: influenzanet.study_service.SurveyInfoResp
+	(*AddSurveyReq)(nil),                                   // 39: influenzanet.study_service.AddSurveyReq
+	(*SubmitResponseReq)(nil),                              // 40: influenzanet.study_service.SubmitResponseReq
+	(*EnterStudyRequest)(nil),                              // 41: influenzanet.study_service.EnterStudyRequest
+	(*LeaveStudyMsg)(nil),                                  // 42: influenzanet.study_service.LeaveStudyMsg
+	(*SurveyVersions)(nil),                                 // 43: influenzanet.study_service.SurveyVersions
+	(*SurveyReferenceRequest)(nil),                         // 44: influenzanet.study_service.SurveyReferenceRequest
+	(*StudyRulesVersionReferenceReq)(nil),                  // 45: influenzanet.study_service.StudyRulesVersionReferenceReq
+	(*SurveyVersionReferenceRequest)(nil),                  // 46: influenzanet.study_service.SurveyVersionReferenceRequest
+	(*GetSurveyKeysRequest)(nil),                           // 47: influenzanet.study_service.GetSurveyKeysRequest
+	(*SurveyKeys)(nil),                                     // 48: influenzanet.study_service.SurveyKeys
+	(*CreateReportReq)(nil),                                // 49: influenzanet.study_service.CreateReportReq
+	(*GetReportsForUserReq)(nil),                           // 50: influenzanet.study_service.GetReportsForUserReq
+	(*RemoveConfidentialResponsesForProfilesReq)(nil),      // 51: influenzanet.study_service.RemoveConfidentialResponsesForProfilesReq
+	(*ReportHistory)(nil),                                  // 52: influenzanet.study_service.ReportHistory
+	(*GetStudiesForUserReq)(nil),                           // 53: influenzanet.study_service.GetStudiesForUserReq
+	(*Studies)(nil),                                        // 54: influenzanet.study_service.Studies
+	(*StudyMemberReq)(nil),                                 // 55: influenzanet.study_service.StudyMemberReq
+	(*StudyRulesReq)(nil),                                  // 56: influenzanet.study_service.StudyRulesReq
+	(*RunRulesForSingleParticipantReq)(nil),                // 57: influenzanet.study_service.RunRulesForSingleParticipantReq
+	(*RunRulesForPreviousResponsesReq)(nil),                // 58: influenzanet.study_service.RunRulesForPreviousResponsesReq
+	(*StudyStatusReq)(nil),                                 // 59: influenzanet.study_service.StudyStatusReq
+	(*ParticipantStateHistoryConfigReq)(nil),               // 60: influenzanet.study_service.ParticipantStateHistoryConfigReq
+	(*RuleErrorPolicyReq)(nil),                             // 61: influenzanet.study_service.RuleErrorPolicyReq
+	(*StudyPropsReq)(nil),                                  // 62: influenzanet.study_service.StudyPropsReq
+	(*RuleRunSummary)(nil),                                 // 63: influenzanet.study_service.RuleRunSummary
+	(*RuleTraceValue)(nil),                                 // 64: influenzanet.study_service.RuleTraceValue
+	(*RuleTraceNode)(nil),                                  // 65: influenzanet.study_service.RuleTraceNode
+	(*DryRunDBCalls)(nil),                                  // 66: influenzanet.study_service.DryRunDBCalls
+	(*DryRunParticipantResult)(nil),                        // 67: influenzanet.study_service.DryRunParticipantResult
+	(*DryRunRulesResp)(nil),                                // 68: influenzanet.study_service.DryRunRulesResp
+	(*ConvertTempParticipantReq)(nil),                      // 69: influenzanet.study_service.ConvertTempParticipantReq
+	(*RegisterTempParticipantReq)(nil),                     // 70: influenzanet.study_service.RegisterTempParticipantReq
+	(*RegisterTempParticipantResponse)(nil),                // 71: influenzanet.study_service.RegisterTempParticipantResponse
+	(*GetAssignedSurveysForTemporaryParticipantReq)(nil),   // 72: influenzanet.study_service.GetAssignedSurveysForTemporaryParticipantReq
+	(*ConfidentialResponsesQuery)(nil),                     // 73: influenzanet.study_service.ConfidentialResponsesQuery
+	(*ConfidentialResponses)(nil),                          // 74: influenzanet.study_service.ConfidentialResponses
+	(*GetRandomizationAllocationsReq)(nil),                 // 75: influenzanet.study_service.GetRandomizationAllocationsReq
+	(*RandomizationAllocation)(nil),                        // 76: influenzanet.study_service.RandomizationAllocation
+	(*RandomizationAllocations)(nil),                       // 77: influenzanet.study_service.RandomizationAllocations
+	(*ExternalEventOutboxQuery)(nil),                       // 78: influenzanet.study_service.ExternalEventOutboxQuery
+	(*ExternalEventOutboxEntry)(nil),                       // 79: influenzanet.study_service.ExternalEventOutboxEntry
+	(*ExternalEventOutbox)(nil),                            // 80: influenzanet.study_service.ExternalEventOutbox
+	(*RedriveExternalEventsReq)(nil),                       // 81: influenzanet.study_service.RedriveExternalEventsReq
+	(*RedriveExternalEventsResp)(nil),                      // 82: influenzanet.study_service.RedriveExternalEventsResp
+	(*UploadParticipantFileReq_Info)(nil),                  // 83: influenzanet.study_service.UploadParticipantFileReq.Info
+	nil,                                                    // 84: influenzanet.study_service.SurveyResponseQuery.ContextQueryEntry
+	nil,                                                    // 85: influenzanet.study_service.GetPStatesWithPaginationQuery.SortByEntry
+	nil,                                                    // 86: influenzanet.study_service.StudyResponseStatistics.SurveyResponseCountsEntry
+	nil,                                                    // 87: influenzanet.study_service.StudyMessage.PayloadEntry
+	(*RunRulesForPreviousResponsesReq_ResponseFilter)(nil), // 88: influenzanet.study_service.RunRulesForPreviousResponsesReq.ResponseFilter
+	nil,                                   // 89: influenzanet.study_service.RandomizationAllocation.CountsEntry
+	(*StudyForUser)(nil),                  // 90: influenzanet.study_service.StudyForUser
+	(*api_types.TokenInfos)(nil),          // 91: influenzanet.shared.TokenInfos
+	(*ParticipantState)(nil),              // 92: influenzanet.study_service.ParticipantState
+	(*ExpressionArg)(nil),                 // 93: influenzanet.study_service.ExpressionArg
+	(*Study)(nil),                         // 94: influenzanet.study_service.Study
+	(*Survey)(nil),                        // 95: influenzanet.study_service.Survey
+	(*SurveyContext)(nil),                 // 96: influenzanet.study_service.SurveyContext
+	(*SurveyResponse)(nil),                // 97: influenzanet.study_service.SurveyResponse
+	(*SurveyInfo)(nil),                    // 98: influenzanet.study_service.SurveyInfo
+	(*Report)(nil),                        // 99: influenzanet.study_service.Report
+	(*Study_Member)(nil),                  // 100: influenzanet.study_service.Study.Member
+	(*Expression)(nil),                    // 101: influenzanet.study_service.Expression
+	(*ParticipantStateHistoryConfig)(nil), // 102: influenzanet.study_service.ParticipantStateHistoryConfig
+	(*Study_Props)(nil),                   // 103: influenzanet.study_service.Study.Props
+	(*ParticipantStateDiff)(nil),          // 104: influenzanet.study_service.ParticipantStateDiff
+	(*emptypb.Empty)(nil),                 // 105: google.protobuf.Empty
+	(*ResponseExportQuery)(nil),           // 106: influenzanet.study_service.ResponseExportQuery
+	(*SurveyInfoExportQuery)(nil),         // 107: influenzanet.study_service.SurveyInfoExportQuery
+	(*AssignedSurveys)(nil),               // 108: influenzanet.study_service.AssignedSurveys
+	(*Chunk)(nil),                         // 109: influenzanet.study_service.Chunk
+	(*StudyRules)(nil),                    // 110: influenzanet.study_service.StudyRules
+	(*StudyRulesHistory)(nil),             // 111: influenzanet.study_service.StudyRulesHistory
+	(*ParticipantStateHistoryEntry)(nil),  // 112: influenzanet.study_service.ParticipantStateHistoryEntry
+	(*SurveyInfoExport)(nil),              // 113: influenzanet.study_service.SurveyInfoExport
 }
 var file_study_service_study_service_proto_depIdxs = []int32{
-	72,  // 0: influenzanet.study_service.StudiesForUser.studies:type_name -> influenzanet.study_service.StudyForUser
-	66,  // 1: influenzanet.study_service.UploadParticipantFileReq.info:type_name -> influenzanet.study_service.UploadParticipantFileReq.Info
+	90,  // 0: influenzanet.study_service.StudiesForUser.studies:type_name -> influenzanet.study_service.StudyForUser
+	83,  // 1: influenzanet.study_service.UploadParticipantFileReq.info:type_name -> influenzanet.study_service.UploadParticipantFileReq.Info
 	5,   // 2: influenzanet.study_service.PaginatedFile.info:type_name -> influenzanet.study_service.PaginationInfo
-	73,  // 3: influenzanet.study_service.GetParticipantFileReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 3: influenzanet.study_service.GetParticipantFileReq.token:type_name -> influenzanet.shared.TokenInfos
 	7,   // 4: influenzanet.study_service.NotificationSubscriptions.subscriptions:type_name -> influenzanet.study_service.Subscription
-	73,  // 5: influenzanet.study_service.UpdateResearcherNotificationSubscriptionsReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 5: influenzanet.study_service.UpdateResearcherNotificationSubscriptionsReq.token:type_name -> influenzanet.shared.TokenInfos
 	7,   // 6: influenzanet.study_service.UpdateResearcherNotificationSubscriptionsReq.subscriptions:type_name -> influenzanet.study_service.Subscription
-	73,  // 7: influenzanet.study_service.GetResearcherNotificationSubscriptionsReq.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 8: influenzanet.study_service.DeleteParticipantFilesReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 7: influenzanet.study_service.GetResearcherNotificationSubscriptionsReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 8: influenzanet.study_service.DeleteParticipantFilesReq.token:type_name -> influenzanet.shared.TokenInfos
 	12,  // 9: influenzanet.study_service.FileInfo.referenced_in:type_name -> influenzanet.study_service.FileObjectReference
 	13,  // 10: influenzanet.study_service.FileInfos.file_infos:type_name -> influenzanet.study_service.FileInfo
-	73,  // 11: influenzanet.study_service.SurveyResponseQuery.token:type_name -> influenzanet.shared.TokenInfos
-	67,  // 12: influenzanet.study_service.SurveyResponseQuery.context_query:type_name -> influenzanet.study_service.SurveyResponseQuery.ContextQueryEntry
-	73,  // 13: influenzanet.study_service.ReportHistoryQuery.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 14: influenzanet.study_service.FileInfoQuery.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 15: influenzanet.study_service.ParticipantStateQuery.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 16: influenzanet.study_service.ParticipantStateByIDQuery.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 17: influenzanet.study_service.GetPStatesWithPaginationQuery.token:type_name -> influenzanet.shared.TokenInfos
-	68,  // 18: influenzanet.study_service.GetPStatesWithPaginationQuery.sort_by:type_name -> influenzanet.study_service.GetPStatesWithPaginationQuery.SortByEntry
-	74,  // 19: influenzanet.study_service.ParticipantStatesWithPagination.items:type_name -> influenzanet.study_service.ParticipantState
-	69,  // 20: influenzanet.study_service.StudyResponseStatistics.survey_response_counts:type_name -> influenzanet.study_service.StudyResponseStatistics.SurveyResponseCountsEntry
-	75,  // 21: influenzanet.study_service.ProfilesWithConditionReq.condition:type_name -> influenzanet.study_service.ExpressionArg
-	70,  // 22: influenzanet.study_service.StudyMessage.payload:type_name -> influenzanet.study_service.StudyMessage.PayloadEntry
-	27,  // 23: influenzanet.study_service.StudyMessages.messages:type_name -> influenzanet.study_service.StudyMessage
-	0,   // 24: influenzanet.study_service.ServiceStatus.status:type_name -> influenzanet.study_service.ServiceStatus.StatusValue
-	73,  // 25: influenzanet.study_service.NewStudyRequest.token:type_name -> influenzanet.shared.TokenInfos
-	76,  // 26: influenzanet.study_service.NewStudyRequest.study:type_name -> influenzanet.study_service.Study
-	77,  // 27: influenzanet.study_service.SurveyAndContext.survey:type_name -> influenzanet.study_service.Survey
-	78,  // 28: influenzanet.study_service.SurveyAndContext.context:type_name -> influenzanet.study_service.SurveyContext
-	79,  // 29: influenzanet.study_service.SurveyAndContext.prefill:type_name -> influenzanet.study_service.SurveyResponse
-	73,  // 30: influenzanet.study_service.StudyReferenceReq.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 31: influenzanet.study_service.StudyRulesHistoryReq.token:type_name -> influenzanet.shared.TokenInfos
-	80,  // 32: influenzanet.study_service.SurveyInfoResp.infos:type_name -> influenzanet.study_service.SurveyInfo
-	73,  // 33: influenzanet.study_service.AddSurveyReq.token:type_name -> influenzanet.shared.TokenInfos
-	77,  // 34: influenzanet.study_service.AddSurveyReq.survey:type_name -> influenzanet.study_service.Survey
-	73,  // 35: influenzanet.study_service.SubmitResponseReq.token:type_name -> influenzanet.shared.TokenInfos
-	79,  // 36: influenzanet.study_service.SubmitResponseReq.response:type_name -> influenzanet.study_service.SurveyResponse
-	73,  // 37: influenzanet.study_service.EnterStudyRequest.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 38: influenzanet.study_service.LeaveStudyMsg.token:type_name -> influenzanet.shared.TokenInfos
-	77,  // 39: influenzanet.study_service.SurveyVersions.survey_versions:type_name -> influenzanet.study_service.Survey
-	73,  // 40: influenzanet.study_service.SurveyReferenceRequest.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 41: influenzanet.study_service.StudyRulesVersionReferenceReq.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 42: influenzanet.study_service.SurveyVersionReferenceRequest.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 43: influenzanet.study_service.GetSurveyKeysRequest.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 44: influenzanet.study_service.CreateReportReq.token:type_name -> influenzanet.shared.TokenInfos
-	81,  // 45: influenzanet.study_service.CreateReportReq.report:type_name -> influenzanet.study_service.Report
-	73,  // 46: influenzanet.study_service.GetReportsForUserReq.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 47: influenzanet.study_service.RemoveConfidentialResponsesForProfilesReq.token:type_name -> influenzanet.shared.TokenInfos
-	81,  // 48: influenzanet.study_service.ReportHistory.reports:type_name -> influenzanet.study_service.Report
-	73,  // 49: influenzanet.study_service.GetStudiesForUserReq.token:type_name -> influenzanet.shared.TokenInfos
-	76,  // 50: influenzanet.study_service.Studies.studies:type_name -> influenzanet.study_service.Study
-	73,  // 51: influenzanet.study_service.StudyMemberReq.token:type_name -> influenzanet.shared.TokenInfos
-	82,  // 52: influenzanet.study_service.StudyMemberReq.member:type_name -> influenzanet.study_service.Study.Member
-	73,  // 53: influenzanet.study_service.StudyRulesReq.token:type_name -> influenzanet.shared.TokenInfos
-	83,  // 54: influenzanet.study_service.StudyRulesReq.rules:type_name -> influenzanet.study_service.Expression
-	73,  // 55: influenzanet.study_service.RunRulesForSingleParticipantReq.token:type_name -> influenzanet.shared.TokenInfos
-	83,  // 56: influenzanet.study_service.RunRulesForSingleParticipantReq.rules:type_name -> influenzanet.study_service.Expression
-	73,  // 57: influenzanet.study_service.RunRulesForPreviousResponsesReq.token:type_name -> influenzanet.shared.TokenInfos
-	83,  // 58: influenzanet.study_service.RunRulesForPreviousResponsesReq.rules:type_name -> influenzanet.study_service.Expression
-	71,  // 59: influenzanet.study_service.RunRulesForPreviousResponsesReq.filter:type_name -> influenzanet.study_service.RunRulesForPreviousResponsesReq.ResponseFilter
-	73,  // 60: influenzanet.study_service.StudyStatusReq.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 61: influenzanet.study_service.StudyPropsReq.token:type_name -> influenzanet.shared.TokenInfos
-	84,  // 62: influenzanet.study_service.StudyPropsReq.props:type_name -> influenzanet.study_service.Study.Props
-	73,  // 63: influenzanet.study_service.ConvertTempParticipantReq.token:type_name -> influenzanet.shared.TokenInfos
-	73,  // 64: influenzanet.study_service.ConfidentialResponsesQuery.token:type_name -> influenzanet.shared.TokenInfos
-	83,  // 65: influenzanet.study_service.ConfidentialResponsesQuery.condition:type_name -> influenzanet.study_service.Expression
-	79,  // 66: influenzanet.study_service.ConfidentialResponses.responses:type_name -> influenzanet.study_service.SurveyResponse
-	73,  // 67: influenzanet.study_service.UploadParticipantFileReq.Info.token:type_name -> influenzanet.shared.TokenInfos
-	2,   // 68: influenzanet.study_service.UploadParticipantFileReq.Info.file_type:type_name -> influenzanet.study_service.FileType
-	85,  // 69: influenzanet.study_service.StudyServiceApi.Status:input_type -> google.protobuf.Empty
-	39,  // 70: influenzanet.study_service.StudyServiceApi.EnterStudy:input_type -> influenzanet.study_service.EnterStudyRequest
-	73,  // 71: influenzanet.study_service.StudyServiceApi.GetAssignedSurveys:input_type -> influenzanet.shared.TokenInfos
-	42,  // 72: influenzanet.study_service.StudyServiceApi.GetAssignedSurvey:input_type -> influenzanet.study_service.SurveyReferenceRequest
-	38,  // 73: influenzanet.study_service.StudyServiceApi.SubmitResponse:input_type -> influenzanet.study_service.SubmitResponseReq
-	40,  // 74: influenzanet.study_service.StudyServiceApi.LeaveStudy:input_type -> influenzanet.study_service.LeaveStudyMsg
-	73,  // 75: influenzanet.study_service.StudyServiceApi.ProfileDeleted:input_type -> influenzanet.shared.TokenInfos
-	73,  // 76: influenzanet.study_service.StudyServiceApi.DeleteParticipantData:input_type -> influenzanet.shared.TokenInfos
-	3,   // 77: influenzanet.study_service.StudyServiceApi.UploadParticipantFile:input_type -> influenzanet.study_service.UploadParticipantFileReq
-	11,  // 78: influenzanet.study_service.StudyServiceApi.DeleteParticipantFiles:input_type -> influenzanet.study_service.DeleteParticipantFilesReq
-	6,   // 79: influenzanet.study_service.StudyServiceApi.GetParticipantFile:input_type -> influenzanet.study_service.GetParticipantFileReq
-	61,  // 80: influenzanet.study_service.StudyServiceApi.RegisterTemporaryParticipant:input_type -> influenzanet.study_service.RegisterTempParticipantReq
-	60,  // 81: influenzanet.study_service.StudyServiceApi.ConvertTemporaryToParticipant:input_type -> influenzanet.study_service.ConvertTempParticipantReq
-	63,  // 82: influenzanet.study_service.StudyServiceApi.GetAssignedSurveysForTemporaryParticipant:input_type -> influenzanet.study_service.GetAssignedSurveysForTemporaryParticipantReq
-	47,  // 83: influenzanet.study_service.StudyServiceApi.CreateReport:input_type -> influenzanet.study_service.CreateReportReq
-	51,  // 84: influenzanet.study_service.StudyServiceApi.GetStudiesForUser:input_type -> influenzanet.study_service.GetStudiesForUserReq
-	73,  // 85: influenzanet.study_service.StudyServiceApi.GetActiveStudies:input_type -> influenzanet.shared.TokenInfos
-	34,  // 86: influenzanet.study_service.StudyServiceApi.GetStudySurveyInfos:input_type -> influenzanet.study_service.StudyReferenceReq
-	23,  // 87: influenzanet.study_service.StudyServiceApi.HasParticipantStateWithCondition:input_type -> influenzanet.study_service.ProfilesWithConditionReq
-	24,  // 88: influenzanet.study_service.StudyServiceApi.GetParticipantMessages:input_type -> influenzanet.study_service.GetParticipantMessagesReq
-	25,  // 89: influenzanet.study_service.StudyServiceApi.GetResearcherMessages:input_type -> influenzanet.study_service.GetReseacherMessagesReq
-	29,  // 90: influenzanet.study_service.StudyServiceApi.DeleteMessagesFromParticipant:input_type -> influenzanet.study_service.DeleteMessagesFromParticipantReq
-	30,  // 91: influenzanet.study_service.StudyServiceApi.DeleteResearcherMessages:input_type -> influenzanet.study_service.DeleteResearcherMessagesReq
-	48,  // 92: influenzanet.study_service.StudyServiceApi.GetReportsForUser:input_type -> influenzanet.study_service.GetReportsForUserReq
-	49,  // 93: influenzanet.study_service.StudyServiceApi.RemoveConfidentialResponsesForProfiles:input_type -> influenzanet.study_service.RemoveConfidentialResponsesForProfilesReq
-	32,  // 94: influenzanet.study_service.StudyServiceApi.CreateNewStudy:input_type -> influenzanet.study_service.NewStudyRequest
-	73,  // 95: influenzanet.study_service.StudyServiceApi.GetAllStudies:input_type -> influenzanet.shared.TokenInfos
-	34,  // 96: influenzanet.study_service.StudyServiceApi.GetStudy:input_type -> influenzanet.study_service.StudyReferenceReq
-	53,  // 97: influenzanet.study_service.StudyServiceApi.SaveStudyMember:input_type -> influenzanet.study_service.StudyMemberReq
-	53,  // 98: influenzanet.study_service.StudyServiceApi.RemoveStudyMember:input_type -> influenzanet.study_service.StudyMemberReq
-	10,  // 99: influenzanet.study_service.StudyServiceApi.GetResearcherNotificationSubscriptions:input_type -> influenzanet.study_service.GetResearcherNotificationSubscriptionsReq
-	9,   // 100: influenzanet.study_service.StudyServiceApi.UpdateResearcherNotificationSubscriptions:input_type -> influenzanet.study_service.UpdateResearcherNotificationSubscriptionsReq
-	26,  // 101: influenzanet.study_service.StudyServiceApi.GetStudiesWithPendingParticipantMessages:input_type -> influenzanet.study_service.GetStudiesWithPendingParticipantMessagesReq
-	57,  // 102: influenzanet.study_service.StudyServiceApi.SaveStudyStatus:input_type -> influenzanet.study_service.StudyStatusReq
-	58,  // 103: influenzanet.study_service.StudyServiceApi.SaveStudyProps:input_type -> influenzanet.study_service.StudyPropsReq
-	54,  // 104: influenzanet.study_service.StudyServiceApi.SaveStudyRules:input_type -> influenzanet.study_service.StudyRulesReq
-	34,  // 105: influenzanet.study_service.StudyServiceApi.GetCurrentStudyRules:input_type -> influenzanet.study_service.StudyReferenceReq
-	35,  // 106: influenzanet.study_service.StudyServiceApi.GetStudyRulesHistory:input_type -> influenzanet.study_service.StudyRulesHistoryReq
-	43,  // 107: influenzanet.study_service.StudyServiceApi.RemoveStudyRulesVersion:input_type -> influenzanet.study_service.StudyRulesVersionReferenceReq
-	37,  // 108: influenzanet.study_service.StudyServiceApi.SaveSurveyToStudy:input_type -> influenzanet.study_service.AddSurveyReq
-	42,  // 109: influenzanet.study_service.StudyServiceApi.GetSurveyVersionInfos:input_type -> influenzanet.study_service.SurveyReferenceRequest
-	45,  // 110: influenzanet.study_service.StudyServiceApi.GetSurveyKeys:input_type -> influenzanet.study_service.GetSurveyKeysRequest
-	44,  // 111: influenzanet.study_service.StudyServiceApi.GetSurveyDefForStudy:input_type -> influenzanet.study_service.SurveyVersionReferenceRequest
-	44,  // 112: influenzanet.study_service.StudyServiceApi.RemoveSurveyVersion:input_type -> influenzanet.study_service.SurveyVersionReferenceRequest
-	42,  // 113: influenzanet.study_service.StudyServiceApi.UnpublishSurvey:input_type -> influenzanet.study_service.SurveyReferenceRequest
-	34,  // 114: influenzanet.study_service.StudyServiceApi.DeleteStudy:input_type -> influenzanet.study_service.StudyReferenceReq
-	54,  // 115: influenzanet.study_service.StudyServiceApi.RunRules:input_type -> influenzanet.study_service.StudyRulesReq
-	55,  // 116: influenzanet.study_service.StudyServiceApi.RunRulesForSingleParticipant:input_type -> influenzanet.study_service.RunRulesForSingleParticipantReq
-	56,  // 117: influenzanet.study_service.StudyServiceApi.RunRulesForPreviousResponses:input_type -> influenzanet.study_service.RunRulesForPreviousResponsesReq
-	15,  // 118: influenzanet.study_service.StudyServiceApi.GetStudyResponseStatistics:input_type -> influenzanet.study_service.SurveyResponseQuery
-	15,  // 119: influenzanet.study_service.StudyServiceApi.StreamStudyResponses:input_type -> influenzanet.study_service.SurveyResponseQuery
-	18,  // 120: influenzanet.study_service.StudyServiceApi.StreamParticipantStates:input_type -> influenzanet.study_service.ParticipantStateQuery
-	20,  // 121: influenzanet.study_service.StudyServiceApi.GetParticipantStatesWithPagination:input_type -> influenzanet.study_service.GetPStatesWithPaginationQuery
-	19,  // 122: influenzanet.study_service.StudyServiceApi.GetParticipantStateByID:input_type -> influenzanet.study_service.ParticipantStateByIDQuery
-	16,  // 123: influenzanet.study_service.StudyServiceApi.StreamReportHistory:input_type -> influenzanet.study_service.ReportHistoryQuery
-	17,  // 124: influenzanet.study_service.StudyServiceApi.StreamParticipantFileInfos:input_type -> influenzanet.study_service.FileInfoQuery
-	64,  // 125: influenzanet.study_service.StudyServiceApi.GetConfidentialResponses:input_type -> influenzanet.study_service.ConfidentialResponsesQuery
-	86,  // 126: influenzanet.study_service.StudyServiceApi.GetResponsesWideFormatCSV:input_type -> influenzanet.study_service.ResponseExportQuery
-	86,  // 127: influenzanet.study_service.StudyServiceApi.GetResponsesLongFormatCSV:input_type -> influenzanet.study_service.ResponseExportQuery
-	86,  // 128: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSON:input_type -> influenzanet.study_service.ResponseExportQuery
-	86,  // 129: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSONWithPagination:input_type -> influenzanet.study_service.ResponseExportQuery
-	87,  // 130: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreviewCSV:input_type -> influenzanet.study_service.SurveyInfoExportQuery
-	87,  // 131: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreview:input_type -> influenzanet.study_service.SurveyInfoExportQuery
-	31,  // 132: influenzanet.study_service.StudyServiceApi.Status:output_type -> influenzanet.study_service.ServiceStatus
-	88,  // 133: influenzanet.study_service.StudyServiceApi.EnterStudy:output_type -> influenzanet.study_service.AssignedSurveys
-	88,  // 134: influenzanet.study_service.StudyServiceApi.GetAssignedSurveys:output_type -> influenzanet.study_service.AssignedSurveys
-	33,  // 135: influenzanet.study_service.StudyServiceApi.GetAssignedSurvey:output_type -> influenzanet.study_service.SurveyAndContext
-	88,  // 136: influenzanet.study_service.StudyServiceApi.SubmitResponse:output_type -> influenzanet.study_service.AssignedSurveys
-	88,  // 137: influenzanet.study_service.StudyServiceApi.LeaveStudy:output_type -> influenzanet.study_service.AssignedSurveys
-	31,  // 138: influenzanet.study_service.StudyServiceApi.ProfileDeleted:output_type -> influenzanet.study_service.ServiceStatus
-	31,  // 139: influenzanet.study_service.StudyServiceApi.DeleteParticipantData:output_type -> influenzanet.study_service.ServiceStatus
-	13,  // 140: influenzanet.study_service.StudyServiceApi.UploadParticipantFile:output_type -> influenzanet.study_service.FileInfo
-	31,  // 141: influenzanet.study_service.StudyServiceApi.DeleteParticipantFiles:output_type -> influenzanet.study_service.ServiceStatus
-	89,  // 142: influenzanet.study_service.StudyServiceApi.GetParticipantFile:output_type -> influenzanet.study_service.Chunk
-	62,  // 143: influenzanet.study_service.StudyServiceApi.RegisterTemporaryParticipant:output_type -> influenzanet.study_service.RegisterTempParticipantResponse
-	31,  // 144: influenzanet.study_service.StudyServiceApi.ConvertTemporaryToParticipant:output_type -> influenzanet.study_service.ServiceStatus
-	88,  // 145: influenzanet.study_service.StudyServiceApi.GetAssignedSurveysForTemporaryParticipant:output_type -> influenzanet.study_service.AssignedSurveys
-	31,  // 146: influenzanet.study_service.StudyServiceApi.CreateReport:output_type -> influenzanet.study_service.ServiceStatus
-	1,   // 147: influenzanet.study_service.StudyServiceApi.GetStudiesForUser:output_type -> influenzanet.study_service.StudiesForUser
-	52,  // 148: influenzanet.study_service.StudyServiceApi.GetActiveStudies:output_type -> influenzanet.study_service.Studies
-	36,  // 149: influenzanet.study_service.StudyServiceApi.GetStudySurveyInfos:output_type -> influenzanet.study_service.SurveyInfoResp
-	31,  // 150: influenzanet.study_service.StudyServiceApi.HasParticipantStateWithCondition:output_type -> influenzanet.study_service.ServiceStatus
-	28,  // 151: influenzanet.study_service.StudyServiceApi.GetParticipantMessages:output_type -> influenzanet.study_service.StudyMessages
-	28,  // 152: influenzanet.study_service.StudyServiceApi.GetResearcherMessages:output_type -> influenzanet.study_service.StudyMessages
-	31,  // 153: influenzanet.study_service.StudyServiceApi.DeleteMessagesFromParticipant:output_type -> influenzanet.study_service.ServiceStatus
-	31,  // 154: influenzanet.study_service.StudyServiceApi.DeleteResearcherMessages:output_type -> influenzanet.study_service.ServiceStatus
-	50,  // 155: influenzanet.study_service.StudyServiceApi.GetReportsForUser:output_type -> influenzanet.study_service.ReportHistory
-	31,  // 156: influenzanet.study_service.StudyServiceApi.RemoveConfidentialResponsesForProfiles:output_type -> influenzanet.study_service.ServiceStatus
-	76,  // 157: influenzanet.study_service.StudyServiceApi.CreateNewStudy:output_type -> influenzanet.study_service.Study
-	52,  // 158: influenzanet.study_service.StudyServiceApi.GetAllStudies:output_type -> influenzanet.study_service.Studies
-	76,  // 159: influenzanet.study_service.StudyServiceApi.GetStudy:output_type -> influenzanet.study_service.Study
-	76,  // 160: influenzanet.study_service.StudyServiceApi.SaveStudyMember:output_type -> influenzanet.study_service.Study
-	76,  // 161: influenzanet.study_service.StudyServiceApi.RemoveStudyMember:output_type -> influenzanet.study_service.Study
-	8,   // 162: influenzanet.study_service.StudyServiceApi.GetResearcherNotificationSubscriptions:output_type -> influenzanet.study_service.NotificationSubscriptions
-	8,   // 163: influenzanet.study_service.StudyServiceApi.UpdateResearcherNotificationSubscriptions:output_type -> influenzanet.study_service.NotificationSubscriptions
-	52,  // 164: influenzanet.study_service.StudyServiceApi.GetStudiesWithPendingParticipantMessages:output_type -> influenzanet.study_service.Studies
-	76,  // 165: influenzanet.study_service.StudyServiceApi.SaveStudyStatus:output_type -> influenzanet.study_service.Study
-	76,  // 166: influenzanet.study_service.StudyServiceApi.SaveStudyProps:output_type -> influenzanet.study_service.Study
-	76,  // 167: influenzanet.study_service.StudyServiceApi.SaveStudyRules:output_type -> influenzanet.study_service.Study
-	90,  // 168: influenzanet.study_service.StudyServiceApi.GetCurrentStudyRules:output_type -> influenzanet.study_service.StudyRules
-	91,  // 169: influenzanet.study_service.StudyServiceApi.GetStudyRulesHistory:output_type -> influenzanet.study_service.StudyRulesHistory
-	31,  // 170: influenzanet.study_service.StudyServiceApi.RemoveStudyRulesVersion:output_type -> influenzanet.study_service.ServiceStatus
-	77,  // 171: influenzanet.study_service.StudyServiceApi.SaveSurveyToStudy:output_type -> influenzanet.study_service.Survey
-	41,  // 172: influenzanet.study_service.StudyServiceApi.GetSurveyVersionInfos:output_type -> influenzanet.study_service.SurveyVersions
-	46,  // 173: influenzanet.study_service.StudyServiceApi.GetSurveyKeys:output_type -> influenzanet.study_service.SurveyKeys
-	77,  // 174: influenzanet.study_service.StudyServiceApi.GetSurveyDefForStudy:output_type -> influenzanet.study_service.Survey
-	31,  // 175: influenzanet.study_service.StudyServiceApi.RemoveSurveyVersion:output_type -> influenzanet.study_service.ServiceStatus
-	31,  // 176: influenzanet.study_service.StudyServiceApi.UnpublishSurvey:output_type -> influenzanet.study_service.ServiceStatus
-	31,  // 177: influenzanet.study_service.StudyServiceApi.DeleteStudy:output_type -> influenzanet.study_service.ServiceStatus
-	59,  // 178: influenzanet.study_service.StudyServiceApi.RunRules:output_type -> influenzanet.study_service.RuleRunSummary
-	59,  // 179: influenzanet.study_service.StudyServiceApi.RunRulesForSingleParticipant:output_type -> influenzanet.study_service.RuleRunSummary
-	59,  // 180: influenzanet.study_service.StudyServiceApi.RunRulesForPreviousResponses:output_type -> influenzanet.study_service.RuleRunSummary
-	22,  // 181: influenzanet.study_service.StudyServiceApi.GetStudyResponseStatistics:output_type -> influenzanet.study_service.StudyResponseStatistics
-	79,  // 182: influenzanet.study_service.StudyServiceApi.StreamStudyResponses:output_type -> influenzanet.study_service.SurveyResponse
-	74,  // 183: influenzanet.study_service.StudyServiceApi.StreamParticipantStates:output_type -> influenzanet.study_service.ParticipantState
-	21,  // 184: influenzanet.study_service.StudyServiceApi.GetParticipantStatesWithPagination:output_type -> influenzanet.study_service.ParticipantStatesWithPagination
-	74,  // 185: influenzanet.study_service.StudyServiceApi.GetParticipantStateByID:output_type -> influenzanet.study_service.ParticipantState
-	81,  // 186: influenzanet.study_service.StudyServiceApi.StreamReportHistory:output_type -> influenzanet.study_service.Report
-	13,  // 187: influenzanet.study_service.StudyServiceApi.StreamParticipantFileInfos:output_type -> influenzanet.study_service.FileInfo
-	65,  // 188: influenzanet.study_service.StudyServiceApi.GetConfidentialResponses:output_type -> influenzanet.study_service.ConfidentialResponses
-	89,  // 189: influenzanet.study_service.StudyServiceApi.GetResponsesWideFormatCSV:output_type -> influenzanet.study_service.Chunk
-	89,  // 190: influenzanet.study_service.StudyServiceApi.GetResponsesLongFormatCSV:output_type -> influenzanet.study_service.Chunk
-	89,  // 191: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSON:output_type -> influenzanet.study_service.Chunk
-	4,   // 192: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSONWithPagination:output_type -> influenzanet.study_service.PaginatedFile
-	89,  // 193: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreviewCSV:output_type -> influenzanet.study_service.Chunk
-	92,  // 194: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreview:output_type -> influenzanet.study_service.SurveyInfoExport
-	132, // [132:195] is the sub-list for method output_type
-	69,  // [69:132] is the sub-list for method input_type
-	69,  // [69:69] is the sub-list for extension type_name
-	69,  // [69:69] is the sub-list for extension extendee
-	0,   // [0:69] is the sub-list for field type_name
+	91,  // 11: influenzanet.study_service.SurveyResponseQuery.token:type_name -> influenzanet.shared.TokenInfos
+	84,  // 12: influenzanet.study_service.SurveyResponseQuery.context_query:type_name -> influenzanet.study_service.SurveyResponseQuery.ContextQueryEntry
+	91,  // 13: influenzanet.study_service.ReportHistoryQuery.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 14: influenzanet.study_service.FileInfoQuery.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 15: influenzanet.study_service.ParticipantStateQuery.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 16: influenzanet.study_service.ParticipantStateHistoryQuery.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 17: influenzanet.study_service.ParticipantStateByIDQuery.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 18: influenzanet.study_service.GetPStatesWithPaginationQuery.token:type_name -> influenzanet.shared.TokenInfos
+	85,  // 19: influenzanet.study_service.GetPStatesWithPaginationQuery.sort_by:type_name -> influenzanet.study_service.GetPStatesWithPaginationQuery.SortByEntry
+	92,  // 20: influenzanet.study_service.ParticipantStatesWithPagination.items:type_name -> influenzanet.study_service.ParticipantState
+	86,  // 21: influenzanet.study_service.StudyResponseStatistics.survey_response_counts:type_name -> influenzanet.study_service.StudyResponseStatistics.SurveyResponseCountsEntry
+	93,  // 22: influenzanet.study_service.ProfilesWithConditionReq.condition:type_name -> influenzanet.study_service.ExpressionArg
+	87,  // 23: influenzanet.study_service.StudyMessage.payload:type_name -> influenzanet.study_service.StudyMessage.PayloadEntry
+	28,  // 24: influenzanet.study_service.StudyMessages.messages:type_name -> influenzanet.study_service.StudyMessage
+	0,   // 25: influenzanet.study_service.ServiceStatus.status:type_name -> influenzanet.study_service.ServiceStatus.StatusValue
+	33,  // 26: influenzanet.study_service.ServiceStatus.external_services:type_name -> influenzanet.study_service.ExternalServiceHealth
+	91,  // 27: influenzanet.study_service.NewStudyRequest.token:type_name -> influenzanet.shared.TokenInfos
+	94,  // 28: influenzanet.study_service.NewStudyRequest.study:type_name -> influenzanet.study_service.Study
+	95,  // 29: influenzanet.study_service.SurveyAndContext.survey:type_name -> influenzanet.study_service.Survey
+	96,  // 30: influenzanet.study_service.SurveyAndContext.context:type_name -> influenzanet.study_service.SurveyContext
+	97,  // 31: influenzanet.study_service.SurveyAndContext.prefill:type_name -> influenzanet.study_service.SurveyResponse
+	91,  // 32: influenzanet.study_service.StudyReferenceReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 33: influenzanet.study_service.StudyRulesHistoryReq.token:type_name -> influenzanet.shared.TokenInfos
+	98,  // 34: influenzanet.study_service.SurveyInfoResp.infos:type_name -> influenzanet.study_service.SurveyInfo
+	91,  // 35: influenzanet.study_service.AddSurveyReq.token:type_name -> influenzanet.shared.TokenInfos
+	95,  // 36: influenzanet.study_service.AddSurveyReq.survey:type_name -> influenzanet.study_service.Survey
+	91,  // 37: influenzanet.study_service.SubmitResponseReq.token:type_name -> influenzanet.shared.TokenInfos
+	97,  // 38: influenzanet.study_service.SubmitResponseReq.response:type_name -> influenzanet.study_service.SurveyResponse
+	91,  // 39: influenzanet.study_service.EnterStudyRequest.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 40: influenzanet.study_service.LeaveStudyMsg.token:type_name -> influenzanet.shared.TokenInfos
+	95,  // 41: influenzanet.study_service.SurveyVersions.survey_versions:type_name -> influenzanet.study_service.Survey
+	91,  // 42: influenzanet.study_service.SurveyReferenceRequest.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 43: influenzanet.study_service.StudyRulesVersionReferenceReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 44: influenzanet.study_service.SurveyVersionReferenceRequest.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 45: influenzanet.study_service.GetSurveyKeysRequest.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 46: influenzanet.study_service.CreateReportReq.token:type_name -> influenzanet.shared.TokenInfos
+	99,  // 47: influenzanet.study_service.CreateReportReq.report:type_name -> influenzanet.study_service.Report
+	91,  // 48: influenzanet.study_service.GetReportsForUserReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 49: influenzanet.study_service.RemoveConfidentialResponsesForProfilesReq.token:type_name -> influenzanet.shared.TokenInfos
+	99,  // 50: influenzanet.study_service.ReportHistory.reports:type_name -> influenzanet.study_service.Report
+	91,  // 51: influenzanet.study_service.GetStudiesForUserReq.token:type_name -> influenzanet.shared.TokenInfos
+	94,  // 52: influenzanet.study_service.Studies.studies:type_name -> influenzanet.study_service.Study
+	91,  // 53: influenzanet.study_service.StudyMemberReq.token:type_name -> influenzanet.shared.TokenInfos
+	100, // 54: influenzanet.study_service.StudyMemberReq.member:type_name -> influenzanet.study_service.Study.Member
+	91,  // 55: influenzanet.study_service.StudyRulesReq.token:type_name -> influenzanet.shared.TokenInfos
+	101, // 56: influenzanet.study_service.StudyRulesReq.rules:type_name -> influenzanet.study_service.Expression
+	91,  // 57: influenzanet.study_service.RunRulesForSingleParticipantReq.token:type_name -> influenzanet.shared.TokenInfos
+	101, // 58: influenzanet.study_service.RunRulesForSingleParticipantReq.rules:type_name -> influenzanet.study_service.Expression
+	91,  // 59: influenzanet.study_service.RunRulesForPreviousResponsesReq.token:type_name -> influenzanet.shared.TokenInfos
+	101, // 60: influenzanet.study_service.RunRulesForPreviousResponsesReq.rules:type_name -> influenzanet.study_service.Expression
+	88,  // 61: influenzanet.study_service.RunRulesForPreviousResponsesReq.filter:type_name -> influenzanet.study_service.RunRulesForPreviousResponsesReq.ResponseFilter
+	91,  // 62: influenzanet.study_service.StudyStatusReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 63: influenzanet.study_service.ParticipantStateHistoryConfigReq.token:type_name -> influenzanet.shared.TokenInfos
+	102, // 64: influenzanet.study_service.ParticipantStateHistoryConfigReq.config:type_name -> influenzanet.study_service.ParticipantStateHistoryConfig
+	91,  // 65: influenzanet.study_service.RuleErrorPolicyReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 66: influenzanet.study_service.StudyPropsReq.token:type_name -> influenzanet.shared.TokenInfos
+	103, // 67: influenzanet.study_service.StudyPropsReq.props:type_name -> influenzanet.study_service.Study.Props
+	65,  // 68: influenzanet.study_service.RuleRunSummary.rules_trace:type_name -> influenzanet.study_service.RuleTraceNode
+	64,  // 69: influenzanet.study_service.RuleTraceNode.args:type_name -> influenzanet.study_service.RuleTraceValue
+	64,  // 70: influenzanet.study_service.RuleTraceNode.result:type_name -> influenzanet.study_service.RuleTraceValue
+	104, // 71: influenzanet.study_service.RuleTraceNode.state_diff:type_name -> influenzanet.study_service.ParticipantStateDiff
+	65,  // 72: influenzanet.study_service.RuleTraceNode.children:type_name -> influenzanet.study_service.RuleTraceNode
+	104, // 73: influenzanet.study_service.DryRunParticipantResult.diff:type_name -> influenzanet.study_service.ParticipantStateDiff
+	99,  // 74: influenzanet.study_service.DryRunParticipantResult.reports_to_create:type_name -> influenzanet.study_service.Report
+	28,  // 75: influenzanet.study_service.DryRunParticipantResult.researcher_messages:type_name -> influenzanet.study_service.StudyMessage
+	79,  // 76: influenzanet.study_service.DryRunParticipantResult.external_events:type_name -> influenzanet.study_service.ExternalEventOutboxEntry
+	66,  // 77: influenzanet.study_service.DryRunParticipantResult.db_calls:type_name -> influenzanet.study_service.DryRunDBCalls
+	67,  // 78: influenzanet.study_service.DryRunRulesResp.participant_result:type_name -> influenzanet.study_service.DryRunParticipantResult
+	63,  // 79: influenzanet.study_service.DryRunRulesResp.summary:type_name -> influenzanet.study_service.RuleRunSummary
+	91,  // 80: influenzanet.study_service.ConvertTempParticipantReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 81: influenzanet.study_service.ConfidentialResponsesQuery.token:type_name -> influenzanet.shared.TokenInfos
+	101, // 82: influenzanet.study_service.ConfidentialResponsesQuery.condition:type_name -> influenzanet.study_service.Expression
+	97,  // 83: influenzanet.study_service.ConfidentialResponses.responses:type_name -> influenzanet.study_service.SurveyResponse
+	91,  // 84: influenzanet.study_service.GetRandomizationAllocationsReq.token:type_name -> influenzanet.shared.TokenInfos
+	89,  // 85: influenzanet.study_service.RandomizationAllocation.counts:type_name -> influenzanet.study_service.RandomizationAllocation.CountsEntry
+	76,  // 86: influenzanet.study_service.RandomizationAllocations.allocations:type_name -> influenzanet.study_service.RandomizationAllocation
+	91,  // 87: influenzanet.study_service.ExternalEventOutboxQuery.token:type_name -> influenzanet.shared.TokenInfos
+	79,  // 88: influenzanet.study_service.ExternalEventOutbox.entries:type_name -> influenzanet.study_service.ExternalEventOutboxEntry
+	91,  // 89: influenzanet.study_service.RedriveExternalEventsReq.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 90: influenzanet.study_service.UploadParticipantFileReq.Info.token:type_name -> influenzanet.shared.TokenInfos
+	2,   // 91: influenzanet.study_service.UploadParticipantFileReq.Info.file_type:type_name -> influenzanet.study_service.FileType
+	105, // 92: influenzanet.study_service.StudyServiceApi.Status:input_type -> google.protobuf.Empty
+	41,  // 93: influenzanet.study_service.StudyServiceApi.EnterStudy:input_type -> influenzanet.study_service.EnterStudyRequest
+	91,  // 94: influenzanet.study_service.StudyServiceApi.GetAssignedSurveys:input_type -> influenzanet.shared.TokenInfos
+	44,  // 95: influenzanet.study_service.StudyServiceApi.GetAssignedSurvey:input_type -> influenzanet.study_service.SurveyReferenceRequest
+	40,  // 96: influenzanet.study_service.StudyServiceApi.SubmitResponse:input_type -> influenzanet.study_service.SubmitResponseReq
+	42,  // 97: influenzanet.study_service.StudyServiceApi.LeaveStudy:input_type -> influenzanet.study_service.LeaveStudyMsg
+	91,  // 98: influenzanet.study_service.StudyServiceApi.ProfileDeleted:input_type -> influenzanet.shared.TokenInfos
+	91,  // 99: influenzanet.study_service.StudyServiceApi.DeleteParticipantData:input_type -> influenzanet.shared.TokenInfos
+	3,   // 100: influenzanet.study_service.StudyServiceApi.UploadParticipantFile:input_type -> influenzanet.study_service.UploadParticipantFileReq
+	11,  // 101: influenzanet.study_service.StudyServiceApi.DeleteParticipantFiles:input_type -> influenzanet.study_service.DeleteParticipantFilesReq
+	6,   // 102: influenzanet.study_service.StudyServiceApi.GetParticipantFile:input_type -> influenzanet.study_service.GetParticipantFileReq
+	70,  // 103: influenzanet.study_service.StudyServiceApi.RegisterTemporaryParticipant:input_type -> influenzanet.study_service.RegisterTempParticipantReq
+	69,  // 104: influenzanet.study_service.StudyServiceApi.ConvertTemporaryToParticipant:input_type -> influenzanet.study_service.ConvertTempParticipantReq
+	72,  // 105: influenzanet.study_service.StudyServiceApi.GetAssignedSurveysForTemporaryParticipant:input_type -> influenzanet.study_service.GetAssignedSurveysForTemporaryParticipantReq
+	49,  // 106: influenzanet.study_service.StudyServiceApi.CreateReport:input_type -> influenzanet.study_service.CreateReportReq
+	53,  // 107: influenzanet.study_service.StudyServiceApi.GetStudiesForUser:input_type -> influenzanet.study_service.GetStudiesForUserReq
+	91,  // 108: influenzanet.study_service.StudyServiceApi.GetActiveStudies:input_type -> influenzanet.shared.TokenInfos
+	36,  // 109: influenzanet.study_service.StudyServiceApi.GetStudySurveyInfos:input_type -> influenzanet.study_service.StudyReferenceReq
+	24,  // 110: influenzanet.study_service.StudyServiceApi.HasParticipantStateWithCondition:input_type -> influenzanet.study_service.ProfilesWithConditionReq
+	25,  // 111: influenzanet.study_service.StudyServiceApi.GetParticipantMessages:input_type -> influenzanet.study_service.GetParticipantMessagesReq
+	26,  // 112: influenzanet.study_service.StudyServiceApi.GetResearcherMessages:input_type -> influenzanet.study_service.GetReseacherMessagesReq
+	30,  // 113: influenzanet.study_service.StudyServiceApi.DeleteMessagesFromParticipant:input_type -> influenzanet.study_service.DeleteMessagesFromParticipantReq
+	31,  // 114: influenzanet.study_service.StudyServiceApi.DeleteResearcherMessages:input_type -> influenzanet.study_service.DeleteResearcherMessagesReq
+	50,  // 115: influenzanet.study_service.StudyServiceApi.GetReportsForUser:input_type -> influenzanet.study_service.GetReportsForUserReq
+	51,  // 116: influenzanet.study_service.StudyServiceApi.RemoveConfidentialResponsesForProfiles:input_type -> influenzanet.study_service.RemoveConfidentialResponsesForProfilesReq
+	34,  // 117: influenzanet.study_service.StudyServiceApi.CreateNewStudy:input_type -> influenzanet.study_service.NewStudyRequest
+	91,  // 118: influenzanet.study_service.StudyServiceApi.GetAllStudies:input_type -> influenzanet.shared.TokenInfos
+	36,  // 119: influenzanet.study_service.StudyServiceApi.GetStudy:input_type -> influenzanet.study_service.StudyReferenceReq
+	55,  // 120: influenzanet.study_service.StudyServiceApi.SaveStudyMember:input_type -> influenzanet.study_service.StudyMemberReq
+	55,  // 121: influenzanet.study_service.StudyServiceApi.RemoveStudyMember:input_type -> influenzanet.study_service.StudyMemberReq
+	10,  // 122: influenzanet.study_service.StudyServiceApi.GetResearcherNotificationSubscriptions:input_type -> influenzanet.study_service.GetResearcherNotificationSubscriptionsReq
+	9,   // 123: influenzanet.study_service.StudyServiceApi.UpdateResearcherNotificationSubscriptions:input_type -> influenzanet.study_service.UpdateResearcherNotificationSubscriptionsReq
+	27,  // 124: influenzanet.study_service.StudyServiceApi.GetStudiesWithPendingParticipantMessages:input_type -> influenzanet.study_service.GetStudiesWithPendingParticipantMessagesReq
+	59,  // 125: influenzanet.study_service.StudyServiceApi.SaveStudyStatus:input_type -> influenzanet.study_service.StudyStatusReq
+	62,  // 126: influenzanet.study_service.StudyServiceApi.SaveStudyProps:input_type -> influenzanet.study_service.StudyPropsReq
+	60,  // 127: influenzanet.study_service.StudyServiceApi.UpdateParticipantStateHistoryConfig:input_type -> influenzanet.study_service.ParticipantStateHistoryConfigReq
+	61,  // 128: influenzanet.study_service.StudyServiceApi.UpdateRuleErrorPolicy:input_type -> influenzanet.study_service.RuleErrorPolicyReq
+	56,  // 129: influenzanet.study_service.StudyServiceApi.SaveStudyRules:input_type -> influenzanet.study_service.StudyRulesReq
+	36,  // 130: influenzanet.study_service.StudyServiceApi.GetCurrentStudyRules:input_type -> influenzanet.study_service.StudyReferenceReq
+	36,  // 131: influenzanet.study_service.StudyServiceApi.GetCurrentStudyRulesText:input_type -> influenzanet.study_service.StudyReferenceReq
+	37,  // 132: influenzanet.study_service.StudyServiceApi.GetStudyRulesHistory:input_type -> influenzanet.study_service.StudyRulesHistoryReq
+	45,  // 133: influenzanet.study_service.StudyServiceApi.RemoveStudyRulesVersion:input_type -> influenzanet.study_service.StudyRulesVersionReferenceReq
+	39,  // 134: influenzanet.study_service.StudyServiceApi.SaveSurveyToStudy:input_type -> influenzanet.study_service.AddSurveyReq
+	44,  // 135: influenzanet.study_service.StudyServiceApi.GetSurveyVersionInfos:input_type -> influenzanet.study_service.SurveyReferenceRequest
+	47,  // 136: influenzanet.study_service.StudyServiceApi.GetSurveyKeys:input_type -> influenzanet.study_service.GetSurveyKeysRequest
+	46,  // 137: influenzanet.study_service.StudyServiceApi.GetSurveyDefForStudy:input_type -> influenzanet.study_service.SurveyVersionReferenceRequest
+	46,  // 138: influenzanet.study_service.StudyServiceApi.RemoveSurveyVersion:input_type -> influenzanet.study_service.SurveyVersionReferenceRequest
+	44,  // 139: influenzanet.study_service.StudyServiceApi.UnpublishSurvey:input_type -> influenzanet.study_service.SurveyReferenceRequest
+	36,  // 140: influenzanet.study_service.StudyServiceApi.DeleteStudy:input_type -> influenzanet.study_service.StudyReferenceReq
+	56,  // 141: influenzanet.study_service.StudyServiceApi.RunRules:input_type -> influenzanet.study_service.StudyRulesReq
+	57,  // 142: influenzanet.study_service.StudyServiceApi.RunRulesForSingleParticipant:input_type -> influenzanet.study_service.RunRulesForSingleParticipantReq
+	58,  // 143: influenzanet.study_service.StudyServiceApi.RunRulesForPreviousResponses:input_type -> influenzanet.study_service.RunRulesForPreviousResponsesReq
+	56,  // 144: influenzanet.study_service.StudyServiceApi.DryRunRules:input_type -> influenzanet.study_service.StudyRulesReq
+	75,  // 145: influenzanet.study_service.StudyServiceApi.GetRandomizationAllocations:input_type -> influenzanet.study_service.GetRandomizationAllocationsReq
+	78,  // 146: influenzanet.study_service.StudyServiceApi.GetExternalEventOutbox:input_type -> influenzanet.study_service.ExternalEventOutboxQuery
+	81,  // 147: influenzanet.study_service.StudyServiceApi.RedriveExternalEvents:input_type -> influenzanet.study_service.RedriveExternalEventsReq
+	15,  // 148: influenzanet.study_service.StudyServiceApi.GetStudyResponseStatistics:input_type -> influenzanet.study_service.SurveyResponseQuery
+	15,  // 149: influenzanet.study_service.StudyServiceApi.StreamStudyResponses:input_type -> influenzanet.study_service.SurveyResponseQuery
+	18,  // 150: influenzanet.study_service.StudyServiceApi.StreamParticipantStates:input_type -> influenzanet.study_service.ParticipantStateQuery
+	19,  // 151: influenzanet.study_service.StudyServiceApi.StreamParticipantStateHistory:input_type -> influenzanet.study_service.ParticipantStateHistoryQuery
+	21,  // 152: influenzanet.study_service.StudyServiceApi.GetParticipantStatesWithPagination:input_type -> influenzanet.study_service.GetPStatesWithPaginationQuery
+	20,  // 153: influenzanet.study_service.StudyServiceApi.GetParticipantStateByID:input_type -> influenzanet.study_service.ParticipantStateByIDQuery
+	16,  // 154: influenzanet.study_service.StudyServiceApi.StreamReportHistory:input_type -> influenzanet.study_service.ReportHistoryQuery
+	17,  // 155: influenzanet.study_service.StudyServiceApi.StreamParticipantFileInfos:input_type -> influenzanet.study_service.FileInfoQuery
+	73,  // 156: influenzanet.study_service.StudyServiceApi.GetConfidentialResponses:input_type -> influenzanet.study_service.ConfidentialResponsesQuery
+	106, // 157: influenzanet.study_service.StudyServiceApi.GetResponsesWideFormatCSV:input_type -> influenzanet.study_service.ResponseExportQuery
+	106, // 158: influenzanet.study_service.StudyServiceApi.GetResponsesLongFormatCSV:input_type -> influenzanet.study_service.ResponseExportQuery
+	106, // 159: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSON:input_type -> influenzanet.study_service.ResponseExportQuery
+	106, // 160: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSONWithPagination:input_type -> influenzanet.study_service.ResponseExportQuery
+	107, // 161: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreviewCSV:input_type -> influenzanet.study_service.SurveyInfoExportQuery
+	107, // 162: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreview:input_type -> influenzanet.study_service.SurveyInfoExportQuery
+	32,  // 163: influenzanet.study_service.StudyServiceApi.Status:output_type -> influenzanet.study_service.ServiceStatus
+	108, // 164: influenzanet.study_service.StudyServiceApi.EnterStudy:output_type -> influenzanet.study_service.AssignedSurveys
+	108, // 165: influenzanet.study_service.StudyServiceApi.GetAssignedSurveys:output_type -> influenzanet.study_service.AssignedSurveys
+	35,  // 166: influenzanet.study_service.StudyServiceApi.GetAssignedSurvey:output_type -> influenzanet.study_service.SurveyAndContext
+	108, // 167: influenzanet.study_service.StudyServiceApi.SubmitResponse:output_type -> influenzanet.study_service.AssignedSurveys
+	108, // 168: influenzanet.study_service.StudyServiceApi.LeaveStudy:output_type -> influenzanet.study_service.AssignedSurveys
+	32,  // 169: influenzanet.study_service.StudyServiceApi.ProfileDeleted:output_type -> influenzanet.study_service.ServiceStatus
+	32,  // 170: influenzanet.study_service.StudyServiceApi.DeleteParticipantData:output_type -> influenzanet.study_service.ServiceStatus
+	13,  // 171: influenzanet.study_service.StudyServiceApi.UploadParticipantFile:output_type -> influenzanet.study_service.FileInfo
+	32,  // 172: influenzanet.study_service.StudyServiceApi.DeleteParticipantFiles:output_type -> influenzanet.study_service.ServiceStatus
+	109, // 173: influenzanet.study_service.StudyServiceApi.GetParticipantFile:output_type -> influenzanet.study_service.Chunk
+	71,  // 174: influenzanet.study_service.StudyServiceApi.RegisterTemporaryParticipant:output_type -> influenzanet.study_service.RegisterTempParticipantResponse
+	32,  // 175: influenzanet.study_service.StudyServiceApi.ConvertTemporaryToParticipant:output_type -> influenzanet.study_service.ServiceStatus
+	108, // 176: influenzanet.study_service.StudyServiceApi.GetAssignedSurveysForTemporaryParticipant:output_type -> influenzanet.study_service.AssignedSurveys
+	32,  // 177: influenzanet.study_service.StudyServiceApi.CreateReport:output_type -> influenzanet.study_service.ServiceStatus
+	1,   // 178: influenzanet.study_service.StudyServiceApi.GetStudiesForUser:output_type -> influenzanet.study_service.StudiesForUser
+	54,  // 179: influenzanet.study_service.StudyServiceApi.GetActiveStudies:output_type -> influenzanet.study_service.Studies
+	38,  // 180: influenzanet.study_service.StudyServiceApi.GetStudySurveyInfos:output_type -> influenzanet.study_service.SurveyInfoResp
+	32,  // 181: influenzanet.study_service.StudyServiceApi.HasParticipantStateWithCondition:output_type -> influenzanet.study_service.ServiceStatus
+	29,  // 182: influenzanet.study_service.StudyServiceApi.GetParticipantMessages:output_type -> influenzanet.study_service.StudyMessages
+	29,  // 183: influenzanet.study_service.StudyServiceApi.GetResearcherMessages:output_type -> influenzanet.study_service.StudyMessages
+	32,  // 184: influenzanet.study_service.StudyServiceApi.DeleteMessagesFromParticipant:output_type -> influenzanet.study_service.ServiceStatus
+	32,  // 185: influenzanet.study_service.StudyServiceApi.DeleteResearcherMessages:output_type -> influenzanet.study_service.ServiceStatus
+	52,  // 186: influenzanet.study_service.StudyServiceApi.GetReportsForUser:output_type -> influenzanet.study_service.ReportHistory
+	32,  // 187: influenzanet.study_service.StudyServiceApi.RemoveConfidentialResponsesForProfiles:output_type -> influenzanet.study_service.ServiceStatus
+	94,  // 188: influenzanet.study_service.StudyServiceApi.CreateNewStudy:output_type -> influenzanet.study_service.Study
+	54,  // 189: influenzanet.study_service.StudyServiceApi.GetAllStudies:output_type -> influenzanet.study_service.Studies
+	94,  // 190: influenzanet.study_service.StudyServiceApi.GetStudy:output_type -> influenzanet.study_service.Study
+	94,  // 191: influenzanet.study_service.StudyServiceApi.SaveStudyMember:output_type -> influenzanet.study_service.Study
+	94,  // 192: influenzanet.study_service.StudyServiceApi.RemoveStudyMember:output_type -> influenzanet.study_service.Study
+	8,   // 193: influenzanet.study_service.StudyServiceApi.GetResearcherNotificationSubscriptions:output_type -> influenzanet.study_service.NotificationSubscriptions
+	8,   // 194: influenzanet.study_service.StudyServiceApi.UpdateResearcherNotificationSubscriptions:output_type -> influenzanet.study_service.NotificationSubscriptions
+	54,  // 195: influenzanet.study_service.StudyServiceApi.GetStudiesWithPendingParticipantMessages:output_type -> influenzanet.study_service.Studies
+	94,  // 196: influenzanet.study_service.StudyServiceApi.SaveStudyStatus:output_type -> influenzanet.study_service.Study
+	94,  // 197: influenzanet.study_service.StudyServiceApi.SaveStudyProps:output_type -> influenzanet.study_service.Study
+	94,  // 198: influenzanet.study_service.StudyServiceApi.UpdateParticipantStateHistoryConfig:output_type -> influenzanet.study_service.Study
+	94,  // 199: influenzanet.study_service.StudyServiceApi.UpdateRuleErrorPolicy:output_type -> influenzanet.study_service.Study
+	94,  // 200: influenzanet.study_service.StudyServiceApi.SaveStudyRules:output_type -> influenzanet.study_service.Study
+	110, // 201: influenzanet.study_service.StudyServiceApi.GetCurrentStudyRules:output_type -> influenzanet.study_service.StudyRules
+	110, // 202: influenzanet.study_service.StudyServiceApi.GetCurrentStudyRulesText:output_type -> influenzanet.study_service.StudyRules
+	111, // 203: influenzanet.study_service.StudyServiceApi.GetStudyRulesHistory:output_type -> influenzanet.study_service.StudyRulesHistory
+	32,  // 204: influenzanet.study_service.StudyServiceApi.RemoveStudyRulesVersion:output_type -> influenzanet.study_service.ServiceStatus
+	95,  // 205: influenzanet.study_service.StudyServiceApi.SaveSurveyToStudy:output_type -> influenzanet.study_service.Survey
+	43,  // 206: influenzanet.study_service.StudyServiceApi.GetSurveyVersionInfos:output_type -> influenzanet.study_service.SurveyVersions
+	48,  // 207: influenzanet.study_service.StudyServiceApi.GetSurveyKeys:output_type -> influenzanet.study_service.SurveyKeys
+	95,  // 208: influenzanet.study_service.StudyServiceApi.GetSurveyDefForStudy:output_type -> influenzanet.study_service.Survey
+	32,  // 209: influenzanet.study_service.StudyServiceApi.RemoveSurveyVersion:output_type -> influenzanet.study_service.ServiceStatus
+	32,  // 210: influenzanet.study_service.StudyServiceApi.UnpublishSurvey:output_type -> influenzanet.study_service.ServiceStatus
+	32,  // 211: influenzanet.study_service.StudyServiceApi.DeleteStudy:output_type -> influenzanet.study_service.ServiceStatus
+	63,  // 212: influenzanet.study_service.StudyServiceApi.RunRules:output_type -> influenzanet.study_service.RuleRunSummary
+	63,  // 213: influenzanet.study_service.StudyServiceApi.RunRulesForSingleParticipant:output_type -> influenzanet.study_service.RuleRunSummary
+	63,  // 214: influenzanet.study_service.StudyServiceApi.RunRulesForPreviousResponses:output_type -> influenzanet.study_service.RuleRunSummary
+	68,  // 215: influenzanet.study_service.StudyServiceApi.DryRunRules:output_type -> influenzanet.study_service.DryRunRulesResp
+	77,  // 216: influenzanet.study_service.StudyServiceApi.GetRandomizationAllocations:output_type -> influenzanet.study_service.RandomizationAllocations
+	80,  // 217: influenzanet.study_service.StudyServiceApi.GetExternalEventOutbox:output_type -> influenzanet.study_service.ExternalEventOutbox
+	82,  // 218: influenzanet.study_service.StudyServiceApi.RedriveExternalEvents:output_type -> influenzanet.study_service.RedriveExternalEventsResp
+	23,  // 219: influenzanet.study_service.StudyServiceApi.GetStudyResponseStatistics:output_type -> influenzanet.study_service.StudyResponseStatistics
+	97,  // 220: influenzanet.study_service.StudyServiceApi.StreamStudyResponses:output_type -> influenzanet.study_service.SurveyResponse
+	92,  // 221: influenzanet.study_service.StudyServiceApi.StreamParticipantStates:output_type -> influenzanet.study_service.ParticipantState
+	112, // 222: influenzanet.study_service.StudyServiceApi.StreamParticipantStateHistory:output_type -> influenzanet.study_service.ParticipantStateHistoryEntry
+	22,  // 223: influenzanet.study_service.StudyServiceApi.GetParticipantStatesWithPagination:output_type -> influenzanet.study_service.ParticipantStatesWithPagination
+	92,  // 224: influenzanet.study_service.StudyServiceApi.GetParticipantStateByID:output_type -> influenzanet.study_service.ParticipantState
+	99,  // 225: influenzanet.study_service.StudyServiceApi.StreamReportHistory:output_type -> influenzanet.study_service.Report
+	13,  // 226: influenzanet.study_service.StudyServiceApi.StreamParticipantFileInfos:output_type -> influenzanet.study_service.FileInfo
+	74,  // 227: influenzanet.study_service.StudyServiceApi.GetConfidentialResponses:output_type -> influenzanet.study_service.ConfidentialResponses
+	109, // 228: influenzanet.study_service.StudyServiceApi.GetResponsesWideFormatCSV:output_type -> influenzanet.study_service.Chunk
+	109, // 229: influenzanet.study_service.StudyServiceApi.GetResponsesLongFormatCSV:output_type -> influenzanet.study_service.Chunk
+	109, // 230: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSON:output_type -> influenzanet.study_service.Chunk
+	4,   // 231: influenzanet.study_service.StudyServiceApi.GetResponsesFlatJSONWithPagination:output_type -> influenzanet.study_service.PaginatedFile
+	109, // 232: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreviewCSV:output_type -> influenzanet.study_service.Chunk
+	113, // 233: influenzanet.study_service.StudyServiceApi.GetSurveyInfoPreview:output_type -> influenzanet.study_service.SurveyInfoExport
+	163, // [163:234] is the sub-list for method output_type
+	92,  // [92:163] is the sub-list for method input_type
+	92,  // [92:92] is the sub-list for extension type_name
+	92,  // [92:92] is the sub-list for extension extendee
+	0,   // [0:92] is the sub-list for field type_name
 }
 
 func init() { file_study_service_study_service_proto_init() }
@@ -6105,8 +7826,188 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileType); i {
+		file_study_service_study_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadParticipantFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginatedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParticipantFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationSubscriptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResearcherNotificationSubscriptionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResearcherNotificationSubscriptionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteParticipantFilesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileObjectReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyResponseQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportHistoryQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfoQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6117,8 +8018,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadParticipantFileReq); i {
+		file_study_service_study_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantStateQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6129,8 +8030,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginatedFile); i {
+		file_study_service_study_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantStateHistoryQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6141,8 +8042,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationInfo); i {
+		file_study_service_study_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantStateByIDQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6153,8 +8054,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetParticipantFileReq); i {
+		file_study_service_study_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPStatesWithPaginationQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6165,8 +8066,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subscription); i {
+		file_study_service_study_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantStatesWithPagination); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6177,8 +8078,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationSubscriptions); i {
+		file_study_service_study_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyResponseStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6189,8 +8090,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateResearcherNotificationSubscriptionsReq); i {
+		file_study_service_study_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfilesWithConditionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6201,8 +8102,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResearcherNotificationSubscriptionsReq); i {
+		file_study_service_study_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParticipantMessagesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6213,8 +8114,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteParticipantFilesReq); i {
+		file_study_service_study_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReseacherMessagesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6225,8 +8126,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileObjectReference); i {
+		file_study_service_study_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudiesWithPendingParticipantMessagesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6237,8 +8138,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
+		file_study_service_study_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6249,8 +8150,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfos); i {
+		file_study_service_study_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyMessages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6261,8 +8162,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyResponseQuery); i {
+		file_study_service_study_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMessagesFromParticipantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6273,8 +8174,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportHistoryQuery); i {
+		file_study_service_study_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResearcherMessagesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6285,8 +8186,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfoQuery); i {
+		file_study_service_study_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6297,8 +8198,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParticipantStateQuery); i {
+		file_study_service_study_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalServiceHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6309,8 +8210,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParticipantStateByIDQuery); i {
+		file_study_service_study_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewStudyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6321,8 +8222,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPStatesWithPaginationQuery); i {
+		file_study_service_study_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyAndContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6333,8 +8234,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParticipantStatesWithPagination); i {
+		file_study_service_study_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyReferenceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6345,8 +8246,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyResponseStatistics); i {
+		file_study_service_study_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyRulesHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6357,8 +8258,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfilesWithConditionReq); i {
+		file_study_service_study_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6369,8 +8270,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetParticipantMessagesReq); i {
+		file_study_service_study_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSurveyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6381,8 +8282,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReseacherMessagesReq); i {
+		file_study_service_study_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitResponseReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6393,8 +8294,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudiesWithPendingParticipantMessagesReq); i {
+		file_study_service_study_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterStudyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6405,8 +8306,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyMessage); i {
+		file_study_service_study_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveStudyMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6417,8 +8318,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyMessages); i {
+		file_study_service_study_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyVersions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6429,8 +8330,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMessagesFromParticipantReq); i {
+		file_study_service_study_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyReferenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6441,8 +8342,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteResearcherMessagesReq); i {
+		file_study_service_study_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyRulesVersionReferenceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6453,8 +8354,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStatus); i {
+		file_study_service_study_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyVersionReferenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6465,8 +8366,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewStudyRequest); i {
+		file_study_service_study_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSurveyKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6477,8 +8378,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyAndContext); i {
+		file_study_service_study_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurveyKeys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6489,8 +8390,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyReferenceReq); i {
+		file_study_service_study_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6501,8 +8402,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyRulesHistoryReq); i {
+		file_study_service_study_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportsForUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6513,8 +8414,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyInfoResp); i {
+		file_study_service_study_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveConfidentialResponsesForProfilesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6525,8 +8426,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSurveyReq); i {
+		file_study_service_study_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6537,8 +8438,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitResponseReq); i {
+		file_study_service_study_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudiesForUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6549,8 +8450,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnterStudyRequest); i {
+		file_study_service_study_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Studies); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6561,8 +8462,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveStudyMsg); i {
+		file_study_service_study_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyMemberReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6573,8 +8474,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyVersions); i {
+		file_study_service_study_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyRulesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6585,8 +8486,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyReferenceRequest); i {
+		file_study_service_study_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunRulesForSingleParticipantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6597,8 +8498,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyRulesVersionReferenceReq); i {
+		file_study_service_study_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunRulesForPreviousResponsesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6609,8 +8510,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyVersionReferenceRequest); i {
+		file_study_service_study_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6621,8 +8522,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSurveyKeysRequest); i {
+		file_study_service_study_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantStateHistoryConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6633,8 +8534,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyKeys); i {
+		file_study_service_study_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleErrorPolicyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6645,8 +8546,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReportReq); i {
+		file_study_service_study_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudyPropsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6657,8 +8558,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReportsForUserReq); i {
+		file_study_service_study_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleRunSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6669,8 +8570,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveConfidentialResponsesForProfilesReq); i {
+		file_study_service_study_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleTraceValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6681,8 +8582,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportHistory); i {
+		file_study_service_study_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleTraceNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6693,8 +8594,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudiesForUserReq); i {
+		file_study_service_study_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunDBCalls); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6705,8 +8606,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Studies); i {
+		file_study_service_study_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunParticipantResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6717,8 +8618,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyMemberReq); i {
+		file_study_service_study_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunRulesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6729,8 +8630,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyRulesReq); i {
+		file_study_service_study_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertTempParticipantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6741,8 +8642,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunRulesForSingleParticipantReq); i {
+		file_study_service_study_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterTempParticipantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6753,8 +8654,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunRulesForPreviousResponsesReq); i {
+		file_study_service_study_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterTempParticipantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6765,8 +8666,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyStatusReq); i {
+		file_study_service_study_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssignedSurveysForTemporaryParticipantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6777,8 +8678,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyPropsReq); i {
+		file_study_service_study_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfidentialResponsesQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6789,8 +8690,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuleRunSummary); i {
+		file_study_service_study_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfidentialResponses); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6801,8 +8702,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConvertTempParticipantReq); i {
+		file_study_service_study_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRandomizationAllocationsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6813,8 +8714,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTempParticipantReq); i {
+		file_study_service_study_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomizationAllocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6825,8 +8726,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTempParticipantResponse); i {
+		file_study_service_study_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomizationAllocations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6837,8 +8738,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAssignedSurveysForTemporaryParticipantReq); i {
+		file_study_service_study_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalEventOutboxQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6849,8 +8750,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfidentialResponsesQuery); i {
+		file_study_service_study_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalEventOutboxEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6861,8 +8762,8 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfidentialResponses); i {
+		file_study_service_study_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalEventOutbox); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6873,7 +8774,31 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_study_service_study_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedriveExternalEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedriveExternalEventsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadParticipantFileReq_Info); i {
 			case 0:
 				return &v.state
@@ -6885,7 +8810,7 @@ func file_study_service_study_service_proto_init() {
 				return nil
 			}
 		}
-		file_study_service_study_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_study_service_study_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RunRulesForPreviousResponsesReq_ResponseFilter); i {
 			case 0:
 				return &v.state
@@ -6906,7 +8831,17 @@ func file_study_service_study_service_proto_init() {
 		(*PaginatedFile_Info)(nil),
 		(*PaginatedFile_Chunk)(nil),
 	}
-	file_study_service_study_service_proto_msgTypes[65].OneofWrappers = []interface{}{
+	file_study_service_study_service_proto_msgTypes[63].OneofWrappers = []interface{}{
+		(*RuleTraceValue_Str)(nil),
+		(*RuleTraceValue_Num)(nil),
+		(*RuleTraceValue_Bool)(nil),
+		(*RuleTraceValue_Json)(nil),
+	}
+	file_study_service_study_service_proto_msgTypes[67].OneofWrappers = []interface{}{
+		(*DryRunRulesResp_ParticipantResult)(nil),
+		(*DryRunRulesResp_Summary)(nil),
+	}
+	file_study_service_study_service_proto_msgTypes[82].OneofWrappers = []interface{}{
 		(*UploadParticipantFileReq_Info_ProfileId)(nil),
 		(*UploadParticipantFileReq_Info_ParticipantId)(nil),
 	}
@@ -6916,7 +8851,7 @@ func file_study_service_study_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_study_service_study_service_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   71,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
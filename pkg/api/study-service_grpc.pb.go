@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
-// - protoc             v4.25.0
+// - protoc             (unknown)
 // source: study_service/study-service.proto
 
 package api
@@ -66,8 +66,11 @@ type StudyServiceApiClient interface {
 	GetStudiesWithPendingParticipantMessages(ctx context.Context, in *GetStudiesWithPendingParticipantMessagesReq, opts ...grpc.CallOption) (*Studies, error)
 	SaveStudyStatus(ctx context.Context, in *StudyStatusReq, opts ...grpc.CallOption) (*Study, error)
 	SaveStudyProps(ctx context.Context, in *StudyPropsReq, opts ...grpc.CallOption) (*Study, error)
+	UpdateParticipantStateHistoryConfig(ctx context.Context, in *ParticipantStateHistoryConfigReq, opts ...grpc.CallOption) (*Study, error)
+	UpdateRuleErrorPolicy(ctx context.Context, in *RuleErrorPolicyReq, opts ...grpc.CallOption) (*Study, error)
 	SaveStudyRules(ctx context.Context, in *StudyRulesReq, opts ...grpc.CallOption) (*Study, error)
 	GetCurrentStudyRules(ctx context.Context, in *StudyReferenceReq, opts ...grpc.CallOption) (*StudyRules, error)
+	GetCurrentStudyRulesText(ctx context.Context, in *StudyReferenceReq, opts ...grpc.CallOption) (*StudyRules, error)
 	GetStudyRulesHistory(ctx context.Context, in *StudyRulesHistoryReq, opts ...grpc.CallOption) (*StudyRulesHistory, error)
 	RemoveStudyRulesVersion(ctx context.Context, in *StudyRulesVersionReferenceReq, opts ...grpc.CallOption) (*ServiceStatus, error)
 	SaveSurveyToStudy(ctx context.Context, in *AddSurveyReq, opts ...grpc.CallOption) (*Survey, error)
@@ -80,10 +83,16 @@ type StudyServiceApiClient interface {
 	RunRules(ctx context.Context, in *StudyRulesReq, opts ...grpc.CallOption) (*RuleRunSummary, error)
 	RunRulesForSingleParticipant(ctx context.Context, in *RunRulesForSingleParticipantReq, opts ...grpc.CallOption) (*RuleRunSummary, error)
 	RunRulesForPreviousResponses(ctx context.Context, in *RunRulesForPreviousResponsesReq, opts ...grpc.CallOption) (*RuleRunSummary, error)
+	// evaluates the rules like RunRules without persisting anything:
+	DryRunRules(ctx context.Context, in *StudyRulesReq, opts ...grpc.CallOption) (StudyServiceApi_DryRunRulesClient, error)
+	GetRandomizationAllocations(ctx context.Context, in *GetRandomizationAllocationsReq, opts ...grpc.CallOption) (*RandomizationAllocations, error)
+	GetExternalEventOutbox(ctx context.Context, in *ExternalEventOutboxQuery, opts ...grpc.CallOption) (*ExternalEventOutbox, error)
+	RedriveExternalEvents(ctx context.Context, in *RedriveExternalEventsReq, opts ...grpc.CallOption) (*RedriveExternalEventsResp, error)
 	// Data access:
 	GetStudyResponseStatistics(ctx context.Context, in *SurveyResponseQuery, opts ...grpc.CallOption) (*StudyResponseStatistics, error)
 	StreamStudyResponses(ctx context.Context, in *SurveyResponseQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamStudyResponsesClient, error)
 	StreamParticipantStates(ctx context.Context, in *ParticipantStateQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamParticipantStatesClient, error)
+	StreamParticipantStateHistory(ctx context.Context, in *ParticipantStateHistoryQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamParticipantStateHistoryClient, error)
 	GetParticipantStatesWithPagination(ctx context.Context, in *GetPStatesWithPaginationQuery, opts ...grpc.CallOption) (*ParticipantStatesWithPagination, error)
 	GetParticipantStateByID(ctx context.Context, in *ParticipantStateByIDQuery, opts ...grpc.CallOption) (*ParticipantState, error)
 	StreamReportHistory(ctx context.Context, in *ReportHistoryQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamReportHistoryClient, error)
@@ -468,6 +477,24 @@ func (c *studyServiceApiClient) SaveStudyProps(ctx context.Context, in *StudyPro
 	return out, nil
 }
 
+func (c *studyServiceApiClient) UpdateParticipantStateHistoryConfig(ctx context.Context, in *ParticipantStateHistoryConfigReq, opts ...grpc.CallOption) (*Study, error) {
+	out := new(Study)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/UpdateParticipantStateHistoryConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studyServiceApiClient) UpdateRuleErrorPolicy(ctx context.Context, in *RuleErrorPolicyReq, opts ...grpc.CallOption) (*Study, error) {
+	out := new(Study)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/UpdateRuleErrorPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *studyServiceApiClient) SaveStudyRules(ctx context.Context, in *StudyRulesReq, opts ...grpc.CallOption) (*Study, error) {
 	out := new(Study)
 	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/SaveStudyRules", in, out, opts...)
@@ -486,6 +513,15 @@ func (c *studyServiceApiClient) GetCurrentStudyRules(ctx context.Context, in *St
 	return out, nil
 }
 
+func (c *studyServiceApiClient) GetCurrentStudyRulesText(ctx context.Context, in *StudyReferenceReq, opts ...grpc.CallOption) (*StudyRules, error) {
+	out := new(StudyRules)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/GetCurrentStudyRulesText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *studyServiceApiClient) GetStudyRulesHistory(ctx context.Context, in *StudyRulesHistoryReq, opts ...grpc.CallOption) (*StudyRulesHistory, error) {
 	out := new(StudyRulesHistory)
 	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/GetStudyRulesHistory", in, out, opts...)
@@ -594,6 +630,65 @@ func (c *studyServiceApiClient) RunRulesForPreviousResponses(ctx context.Context
 	return out, nil
 }
 
+func (c *studyServiceApiClient) DryRunRules(ctx context.Context, in *StudyRulesReq, opts ...grpc.CallOption) (StudyServiceApi_DryRunRulesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[2], "/influenzanet.study_service.StudyServiceApi/DryRunRules", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &studyServiceApiDryRunRulesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StudyServiceApi_DryRunRulesClient interface {
+	Recv() (*DryRunRulesResp, error)
+	grpc.ClientStream
+}
+
+type studyServiceApiDryRunRulesClient struct {
+	grpc.ClientStream
+}
+
+func (x *studyServiceApiDryRunRulesClient) Recv() (*DryRunRulesResp, error) {
+	m := new(DryRunRulesResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *studyServiceApiClient) GetRandomizationAllocations(ctx context.Context, in *GetRandomizationAllocationsReq, opts ...grpc.CallOption) (*RandomizationAllocations, error) {
+	out := new(RandomizationAllocations)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/GetRandomizationAllocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studyServiceApiClient) GetExternalEventOutbox(ctx context.Context, in *ExternalEventOutboxQuery, opts ...grpc.CallOption) (*ExternalEventOutbox, error) {
+	out := new(ExternalEventOutbox)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/GetExternalEventOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studyServiceApiClient) RedriveExternalEvents(ctx context.Context, in *RedriveExternalEventsReq, opts ...grpc.CallOption) (*RedriveExternalEventsResp, error) {
+	out := new(RedriveExternalEventsResp)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/RedriveExternalEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *studyServiceApiClient) GetStudyResponseStatistics(ctx context.Context, in *SurveyResponseQuery, opts ...grpc.CallOption) (*StudyResponseStatistics, error) {
 	out := new(StudyResponseStatistics)
 	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/GetStudyResponseStatistics", in, out, opts...)
@@ -604,7 +699,7 @@ func (c *studyServiceApiClient) GetStudyResponseStatistics(ctx context.Context,
 }
 
 func (c *studyServiceApiClient) StreamStudyResponses(ctx context.Context, in *SurveyResponseQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamStudyResponsesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[2], "/influenzanet.study_service.StudyServiceApi/StreamStudyResponses", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[3], "/influenzanet.study_service.StudyServiceApi/StreamStudyResponses", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -636,7 +731,7 @@ func (x *studyServiceApiStreamStudyResponsesClient) Recv() (*SurveyResponse, err
 }
 
 func (c *studyServiceApiClient) StreamParticipantStates(ctx context.Context, in *ParticipantStateQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamParticipantStatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[3], "/influenzanet.study_service.StudyServiceApi/StreamParticipantStates", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[4], "/influenzanet.study_service.StudyServiceApi/StreamParticipantStates", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -667,6 +762,38 @@ func (x *studyServiceApiStreamParticipantStatesClient) Recv() (*ParticipantState
 	return m, nil
 }
 
+func (c *studyServiceApiClient) StreamParticipantStateHistory(ctx context.Context, in *ParticipantStateHistoryQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamParticipantStateHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[5], "/influenzanet.study_service.StudyServiceApi/StreamParticipantStateHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &studyServiceApiStreamParticipantStateHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StudyServiceApi_StreamParticipantStateHistoryClient interface {
+	Recv() (*ParticipantStateHistoryEntry, error)
+	grpc.ClientStream
+}
+
+type studyServiceApiStreamParticipantStateHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *studyServiceApiStreamParticipantStateHistoryClient) Recv() (*ParticipantStateHistoryEntry, error) {
+	m := new(ParticipantStateHistoryEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *studyServiceApiClient) GetParticipantStatesWithPagination(ctx context.Context, in *GetPStatesWithPaginationQuery, opts ...grpc.CallOption) (*ParticipantStatesWithPagination, error) {
 	out := new(ParticipantStatesWithPagination)
 	err := c.cc.Invoke(ctx, "/influenzanet.study_service.StudyServiceApi/GetParticipantStatesWithPagination", in, out, opts...)
@@ -686,7 +813,7 @@ func (c *studyServiceApiClient) GetParticipantStateByID(ctx context.Context, in
 }
 
 func (c *studyServiceApiClient) StreamReportHistory(ctx context.Context, in *ReportHistoryQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamReportHistoryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[4], "/influenzanet.study_service.StudyServiceApi/StreamReportHistory", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[6], "/influenzanet.study_service.StudyServiceApi/StreamReportHistory", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -718,7 +845,7 @@ func (x *studyServiceApiStreamReportHistoryClient) Recv() (*Report, error) {
 }
 
 func (c *studyServiceApiClient) StreamParticipantFileInfos(ctx context.Context, in *FileInfoQuery, opts ...grpc.CallOption) (StudyServiceApi_StreamParticipantFileInfosClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[5], "/influenzanet.study_service.StudyServiceApi/StreamParticipantFileInfos", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[7], "/influenzanet.study_service.StudyServiceApi/StreamParticipantFileInfos", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -759,7 +886,7 @@ func (c *studyServiceApiClient) GetConfidentialResponses(ctx context.Context, in
 }
 
 func (c *studyServiceApiClient) GetResponsesWideFormatCSV(ctx context.Context, in *ResponseExportQuery, opts ...grpc.CallOption) (StudyServiceApi_GetResponsesWideFormatCSVClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[6], "/influenzanet.study_service.StudyServiceApi/GetResponsesWideFormatCSV", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[8], "/influenzanet.study_service.StudyServiceApi/GetResponsesWideFormatCSV", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -791,7 +918,7 @@ func (x *studyServiceApiGetResponsesWideFormatCSVClient) Recv() (*Chunk, error)
 }
 
 func (c *studyServiceApiClient) GetResponsesLongFormatCSV(ctx context.Context, in *ResponseExportQuery, opts ...grpc.CallOption) (StudyServiceApi_GetResponsesLongFormatCSVClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[7], "/influenzanet.study_service.StudyServiceApi/GetResponsesLongFormatCSV", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[9], "/influenzanet.study_service.StudyServiceApi/GetResponsesLongFormatCSV", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -823,7 +950,7 @@ func (x *studyServiceApiGetResponsesLongFormatCSVClient) Recv() (*Chunk, error)
 }
 
 func (c *studyServiceApiClient) GetResponsesFlatJSON(ctx context.Context, in *ResponseExportQuery, opts ...grpc.CallOption) (StudyServiceApi_GetResponsesFlatJSONClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[8], "/influenzanet.study_service.StudyServiceApi/GetResponsesFlatJSON", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[10], "/influenzanet.study_service.StudyServiceApi/GetResponsesFlatJSON", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -855,7 +982,7 @@ func (x *studyServiceApiGetResponsesFlatJSONClient) Recv() (*Chunk, error) {
 }
 
 func (c *studyServiceApiClient) GetResponsesFlatJSONWithPagination(ctx context.Context, in *ResponseExportQuery, opts ...grpc.CallOption) (StudyServiceApi_GetResponsesFlatJSONWithPaginationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[9], "/influenzanet.study_service.StudyServiceApi/GetResponsesFlatJSONWithPagination", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[11], "/influenzanet.study_service.StudyServiceApi/GetResponsesFlatJSONWithPagination", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -887,7 +1014,7 @@ func (x *studyServiceApiGetResponsesFlatJSONWithPaginationClient) Recv() (*Pagin
 }
 
 func (c *studyServiceApiClient) GetSurveyInfoPreviewCSV(ctx context.Context, in *SurveyInfoExportQuery, opts ...grpc.CallOption) (StudyServiceApi_GetSurveyInfoPreviewCSVClient, error) {
-	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[10], "/influenzanet.study_service.StudyServiceApi/GetSurveyInfoPreviewCSV", opts...)
+	stream, err := c.cc.NewStream(ctx, &StudyServiceApi_ServiceDesc.Streams[12], "/influenzanet.study_service.StudyServiceApi/GetSurveyInfoPreviewCSV", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -973,8 +1100,11 @@ type StudyServiceApiServer interface {
 	GetStudiesWithPendingParticipantMessages(context.Context, *GetStudiesWithPendingParticipantMessagesReq) (*Studies, error)
 	SaveStudyStatus(context.Context, *StudyStatusReq) (*Study, error)
 	SaveStudyProps(context.Context, *StudyPropsReq) (*Study, error)
+	UpdateParticipantStateHistoryConfig(context.Context, *ParticipantStateHistoryConfigReq) (*Study, error)
+	UpdateRuleErrorPolicy(context.Context, *RuleErrorPolicyReq) (*Study, error)
 	SaveStudyRules(context.Context, *StudyRulesReq) (*Study, error)
 	GetCurrentStudyRules(context.Context, *StudyReferenceReq) (*StudyRules, error)
+	GetCurrentStudyRulesText(context.Context, *StudyReferenceReq) (*StudyRules, error)
 	GetStudyRulesHistory(context.Context, *StudyRulesHistoryReq) (*StudyRulesHistory, error)
 	RemoveStudyRulesVersion(context.Context, *StudyRulesVersionReferenceReq) (*ServiceStatus, error)
 	SaveSurveyToStudy(context.Context, *AddSurveyReq) (*Survey, error)
@@ -987,10 +1117,16 @@ type StudyServiceApiServer interface {
 	RunRules(context.Context, *StudyRulesReq) (*RuleRunSummary, error)
 	RunRulesForSingleParticipant(context.Context, *RunRulesForSingleParticipantReq) (*RuleRunSummary, error)
 	RunRulesForPreviousResponses(context.Context, *RunRulesForPreviousResponsesReq) (*RuleRunSummary, error)
+	// evaluates the rules like RunRules without persisting anything:
+	DryRunRules(*StudyRulesReq, StudyServiceApi_DryRunRulesServer) error
+	GetRandomizationAllocations(context.Context, *GetRandomizationAllocationsReq) (*RandomizationAllocations, error)
+	GetExternalEventOutbox(context.Context, *ExternalEventOutboxQuery) (*ExternalEventOutbox, error)
+	RedriveExternalEvents(context.Context, *RedriveExternalEventsReq) (*RedriveExternalEventsResp, error)
 	// Data access:
 	GetStudyResponseStatistics(context.Context, *SurveyResponseQuery) (*StudyResponseStatistics, error)
 	StreamStudyResponses(*SurveyResponseQuery, StudyServiceApi_StreamStudyResponsesServer) error
 	StreamParticipantStates(*ParticipantStateQuery, StudyServiceApi_StreamParticipantStatesServer) error
+	StreamParticipantStateHistory(*ParticipantStateHistoryQuery, StudyServiceApi_StreamParticipantStateHistoryServer) error
 	GetParticipantStatesWithPagination(context.Context, *GetPStatesWithPaginationQuery) (*ParticipantStatesWithPagination, error)
 	GetParticipantStateByID(context.Context, *ParticipantStateByIDQuery) (*ParticipantState, error)
 	StreamReportHistory(*ReportHistoryQuery, StudyServiceApi_StreamReportHistoryServer) error
@@ -1114,12 +1250,21 @@ func (UnimplementedStudyServiceApiServer) SaveStudyStatus(context.Context, *Stud
 func (UnimplementedStudyServiceApiServer) SaveStudyProps(context.Context, *StudyPropsReq) (*Study, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveStudyProps not implemented")
 }
+func (UnimplementedStudyServiceApiServer) UpdateParticipantStateHistoryConfig(context.Context, *ParticipantStateHistoryConfigReq) (*Study, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParticipantStateHistoryConfig not implemented")
+}
+func (UnimplementedStudyServiceApiServer) UpdateRuleErrorPolicy(context.Context, *RuleErrorPolicyReq) (*Study, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuleErrorPolicy not implemented")
+}
 func (UnimplementedStudyServiceApiServer) SaveStudyRules(context.Context, *StudyRulesReq) (*Study, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveStudyRules not implemented")
 }
 func (UnimplementedStudyServiceApiServer) GetCurrentStudyRules(context.Context, *StudyReferenceReq) (*StudyRules, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentStudyRules not implemented")
 }
+func (UnimplementedStudyServiceApiServer) GetCurrentStudyRulesText(context.Context, *StudyReferenceReq) (*StudyRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentStudyRulesText not implemented")
+}
 func (UnimplementedStudyServiceApiServer) GetStudyRulesHistory(context.Context, *StudyRulesHistoryReq) (*StudyRulesHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudyRulesHistory not implemented")
 }
@@ -1156,6 +1301,18 @@ func (UnimplementedStudyServiceApiServer) RunRulesForSingleParticipant(context.C
 func (UnimplementedStudyServiceApiServer) RunRulesForPreviousResponses(context.Context, *RunRulesForPreviousResponsesReq) (*RuleRunSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunRulesForPreviousResponses not implemented")
 }
+func (UnimplementedStudyServiceApiServer) DryRunRules(*StudyRulesReq, StudyServiceApi_DryRunRulesServer) error {
+	return status.Errorf(codes.Unimplemented, "method DryRunRules not implemented")
+}
+func (UnimplementedStudyServiceApiServer) GetRandomizationAllocations(context.Context, *GetRandomizationAllocationsReq) (*RandomizationAllocations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandomizationAllocations not implemented")
+}
+func (UnimplementedStudyServiceApiServer) GetExternalEventOutbox(context.Context, *ExternalEventOutboxQuery) (*ExternalEventOutbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalEventOutbox not implemented")
+}
+func (UnimplementedStudyServiceApiServer) RedriveExternalEvents(context.Context, *RedriveExternalEventsReq) (*RedriveExternalEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedriveExternalEvents not implemented")
+}
 func (UnimplementedStudyServiceApiServer) GetStudyResponseStatistics(context.Context, *SurveyResponseQuery) (*StudyResponseStatistics, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudyResponseStatistics not implemented")
 }
@@ -1165,6 +1322,9 @@ func (UnimplementedStudyServiceApiServer) StreamStudyResponses(*SurveyResponseQu
 func (UnimplementedStudyServiceApiServer) StreamParticipantStates(*ParticipantStateQuery, StudyServiceApi_StreamParticipantStatesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamParticipantStates not implemented")
 }
+func (UnimplementedStudyServiceApiServer) StreamParticipantStateHistory(*ParticipantStateHistoryQuery, StudyServiceApi_StreamParticipantStateHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamParticipantStateHistory not implemented")
+}
 func (UnimplementedStudyServiceApiServer) GetParticipantStatesWithPagination(context.Context, *GetPStatesWithPaginationQuery) (*ParticipantStatesWithPagination, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetParticipantStatesWithPagination not implemented")
 }
@@ -1852,6 +2012,42 @@ func _StudyServiceApi_SaveStudyProps_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudyServiceApi_UpdateParticipantStateHistoryConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipantStateHistoryConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudyServiceApiServer).UpdateParticipantStateHistoryConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_service.StudyServiceApi/UpdateParticipantStateHistoryConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudyServiceApiServer).UpdateParticipantStateHistoryConfig(ctx, req.(*ParticipantStateHistoryConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudyServiceApi_UpdateRuleErrorPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleErrorPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudyServiceApiServer).UpdateRuleErrorPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_service.StudyServiceApi/UpdateRuleErrorPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudyServiceApiServer).UpdateRuleErrorPolicy(ctx, req.(*RuleErrorPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StudyServiceApi_SaveStudyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StudyRulesReq)
 	if err := dec(in); err != nil {
@@ -1888,6 +2084,24 @@ func _StudyServiceApi_GetCurrentStudyRules_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudyServiceApi_GetCurrentStudyRulesText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudyReferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudyServiceApiServer).GetCurrentStudyRulesText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_service.StudyServiceApi/GetCurrentStudyRulesText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudyServiceApiServer).GetCurrentStudyRulesText(ctx, req.(*StudyReferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StudyServiceApi_GetStudyRulesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StudyRulesHistoryReq)
 	if err := dec(in); err != nil {
@@ -2104,6 +2318,81 @@ func _StudyServiceApi_RunRulesForPreviousResponses_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudyServiceApi_DryRunRules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StudyRulesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudyServiceApiServer).DryRunRules(m, &studyServiceApiDryRunRulesServer{stream})
+}
+
+type StudyServiceApi_DryRunRulesServer interface {
+	Send(*DryRunRulesResp) error
+	grpc.ServerStream
+}
+
+type studyServiceApiDryRunRulesServer struct {
+	grpc.ServerStream
+}
+
+func (x *studyServiceApiDryRunRulesServer) Send(m *DryRunRulesResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StudyServiceApi_GetRandomizationAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRandomizationAllocationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudyServiceApiServer).GetRandomizationAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_service.StudyServiceApi/GetRandomizationAllocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudyServiceApiServer).GetRandomizationAllocations(ctx, req.(*GetRandomizationAllocationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudyServiceApi_GetExternalEventOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalEventOutboxQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudyServiceApiServer).GetExternalEventOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_service.StudyServiceApi/GetExternalEventOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudyServiceApiServer).GetExternalEventOutbox(ctx, req.(*ExternalEventOutboxQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudyServiceApi_RedriveExternalEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveExternalEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudyServiceApiServer).RedriveExternalEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_service.StudyServiceApi/RedriveExternalEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudyServiceApiServer).RedriveExternalEvents(ctx, req.(*RedriveExternalEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StudyServiceApi_GetStudyResponseStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SurveyResponseQuery)
 	if err := dec(in); err != nil {
@@ -2164,6 +2453,27 @@ func (x *studyServiceApiStreamParticipantStatesServer) Send(m *ParticipantState)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _StudyServiceApi_StreamParticipantStateHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ParticipantStateHistoryQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudyServiceApiServer).StreamParticipantStateHistory(m, &studyServiceApiStreamParticipantStateHistoryServer{stream})
+}
+
+type StudyServiceApi_StreamParticipantStateHistoryServer interface {
+	Send(*ParticipantStateHistoryEntry) error
+	grpc.ServerStream
+}
+
+type studyServiceApiStreamParticipantStateHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *studyServiceApiStreamParticipantStateHistoryServer) Send(m *ParticipantStateHistoryEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _StudyServiceApi_GetParticipantStatesWithPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPStatesWithPaginationQuery)
 	if err := dec(in); err != nil {
@@ -2522,6 +2832,14 @@ var StudyServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveStudyProps",
 			Handler:    _StudyServiceApi_SaveStudyProps_Handler,
 		},
+		{
+			MethodName: "UpdateParticipantStateHistoryConfig",
+			Handler:    _StudyServiceApi_UpdateParticipantStateHistoryConfig_Handler,
+		},
+		{
+			MethodName: "UpdateRuleErrorPolicy",
+			Handler:    _StudyServiceApi_UpdateRuleErrorPolicy_Handler,
+		},
 		{
 			MethodName: "SaveStudyRules",
 			Handler:    _StudyServiceApi_SaveStudyRules_Handler,
@@ -2530,6 +2848,10 @@ var StudyServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrentStudyRules",
 			Handler:    _StudyServiceApi_GetCurrentStudyRules_Handler,
 		},
+		{
+			MethodName: "GetCurrentStudyRulesText",
+			Handler:    _StudyServiceApi_GetCurrentStudyRulesText_Handler,
+		},
 		{
 			MethodName: "GetStudyRulesHistory",
 			Handler:    _StudyServiceApi_GetStudyRulesHistory_Handler,
@@ -2578,6 +2900,18 @@ var StudyServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunRulesForPreviousResponses",
 			Handler:    _StudyServiceApi_RunRulesForPreviousResponses_Handler,
 		},
+		{
+			MethodName: "GetRandomizationAllocations",
+			Handler:    _StudyServiceApi_GetRandomizationAllocations_Handler,
+		},
+		{
+			MethodName: "GetExternalEventOutbox",
+			Handler:    _StudyServiceApi_GetExternalEventOutbox_Handler,
+		},
+		{
+			MethodName: "RedriveExternalEvents",
+			Handler:    _StudyServiceApi_RedriveExternalEvents_Handler,
+		},
 		{
 			MethodName: "GetStudyResponseStatistics",
 			Handler:    _StudyServiceApi_GetStudyResponseStatistics_Handler,
@@ -2610,6 +2944,11 @@ var StudyServiceApi_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StudyServiceApi_GetParticipantFile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DryRunRules",
+			Handler:       _StudyServiceApi_DryRunRules_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamStudyResponses",
 			Handler:       _StudyServiceApi_StreamStudyResponses_Handler,
@@ -2620,6 +2959,11 @@ var StudyServiceApi_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StudyServiceApi_StreamParticipantStates_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamParticipantStateHistory",
+			Handler:       _StudyServiceApi_StreamParticipantStateHistory_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamReportHistory",
 			Handler:       _StudyServiceApi_StreamReportHistory_Handler,
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.28.1
-// 	protoc        v4.25.0
+// 	protoc        (unknown)
 // source: study_service/study.proto
 
 package api
@@ -131,6 +131,61 @@ func (x *Study) GetConfigs() *Study_Configs {
 	return nil
 }
 
+type ParticipantStateHistoryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled       bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RetentionDays int64 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // entries older than this are removed by the study timer, 0 keeps them
+}
+
+func (x *ParticipantStateHistoryConfig) Reset() {
+	*x = ParticipantStateHistoryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_study_service_study_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantStateHistoryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantStateHistoryConfig) ProtoMessage() {}
+
+func (x *ParticipantStateHistoryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_study_service_study_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantStateHistoryConfig.ProtoReflect.Descriptor instead.
+func (*ParticipantStateHistoryConfig) Descriptor() ([]byte, []int) {
+	return file_study_service_study_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantStateHistoryConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ParticipantStateHistoryConfig) GetRetentionDays() int64 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
 type StudyForUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -146,7 +201,7 @@ type StudyForUser struct {
 func (x *StudyForUser) Reset() {
 	*x = StudyForUser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[1]
+		mi := &file_study_service_study_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -159,7 +214,7 @@ func (x *StudyForUser) String() string {
 func (*StudyForUser) ProtoMessage() {}
 
 func (x *StudyForUser) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[1]
+	mi := &file_study_service_study_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -172,7 +227,7 @@ func (x *StudyForUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyForUser.ProtoReflect.Descriptor instead.
 func (*StudyForUser) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{1}
+	return file_study_service_study_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *StudyForUser) GetKey() string {
@@ -221,7 +276,7 @@ type Tag struct {
 func (x *Tag) Reset() {
 	*x = Tag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[2]
+		mi := &file_study_service_study_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -234,7 +289,7 @@ func (x *Tag) String() string {
 func (*Tag) ProtoMessage() {}
 
 func (x *Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[2]
+	mi := &file_study_service_study_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +302,7 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tag.ProtoReflect.Descriptor instead.
 func (*Tag) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{2}
+	return file_study_service_study_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Tag) GetLabel() []*LocalisedObject {
@@ -273,7 +328,7 @@ type AssignedSurvey struct {
 func (x *AssignedSurvey) Reset() {
 	*x = AssignedSurvey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[3]
+		mi := &file_study_service_study_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -286,7 +341,7 @@ func (x *AssignedSurvey) String() string {
 func (*AssignedSurvey) ProtoMessage() {}
 
 func (x *AssignedSurvey) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[3]
+	mi := &file_study_service_study_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +354,7 @@ func (x *AssignedSurvey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignedSurvey.ProtoReflect.Descriptor instead.
 func (*AssignedSurvey) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{3}
+	return file_study_service_study_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AssignedSurvey) GetSurveyKey() string {
@@ -359,7 +414,7 @@ type SurveyInfo struct {
 func (x *SurveyInfo) Reset() {
 	*x = SurveyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[4]
+		mi := &file_study_service_study_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +427,7 @@ func (x *SurveyInfo) String() string {
 func (*SurveyInfo) ProtoMessage() {}
 
 func (x *SurveyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[4]
+	mi := &file_study_service_study_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +440,7 @@ func (x *SurveyInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurveyInfo.ProtoReflect.Descriptor instead.
 func (*SurveyInfo) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{4}
+	return file_study_service_study_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SurveyInfo) GetStudyKey() string {
@@ -435,7 +490,7 @@ type AssignedSurveys struct {
 func (x *AssignedSurveys) Reset() {
 	*x = AssignedSurveys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[5]
+		mi := &file_study_service_study_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -448,7 +503,7 @@ func (x *AssignedSurveys) String() string {
 func (*AssignedSurveys) ProtoMessage() {}
 
 func (x *AssignedSurveys) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[5]
+	mi := &file_study_service_study_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +516,7 @@ func (x *AssignedSurveys) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignedSurveys.ProtoReflect.Descriptor instead.
 func (*AssignedSurveys) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{5}
+	return file_study_service_study_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AssignedSurveys) GetSurveys() []*AssignedSurvey {
@@ -488,12 +543,13 @@ type StudyRules struct {
 	Rules      []*Expression `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
 	UploadedAt int64         `protobuf:"varint,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
 	UploadedBy string        `protobuf:"bytes,5,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	RulesText  string        `protobuf:"bytes,6,opt,name=rules_text,json=rulesText,proto3" json:"rules_text,omitempty"` // rules in text syntax, returned by GetCurrentStudyRulesText
 }
 
 func (x *StudyRules) Reset() {
 	*x = StudyRules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[6]
+		mi := &file_study_service_study_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +562,7 @@ func (x *StudyRules) String() string {
 func (*StudyRules) ProtoMessage() {}
 
 func (x *StudyRules) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[6]
+	mi := &file_study_service_study_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +575,7 @@ func (x *StudyRules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyRules.ProtoReflect.Descriptor instead.
 func (*StudyRules) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{6}
+	return file_study_service_study_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StudyRules) GetId() string {
@@ -557,6 +613,13 @@ func (x *StudyRules) GetUploadedBy() string {
 	return ""
 }
 
+func (x *StudyRules) GetRulesText() string {
+	if x != nil {
+		return x.RulesText
+	}
+	return ""
+}
+
 type StudyRulesHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -572,7 +635,7 @@ type StudyRulesHistory struct {
 func (x *StudyRulesHistory) Reset() {
 	*x = StudyRulesHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[7]
+		mi := &file_study_service_study_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -585,7 +648,7 @@ func (x *StudyRulesHistory) String() string {
 func (*StudyRulesHistory) ProtoMessage() {}
 
 func (x *StudyRulesHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[7]
+	mi := &file_study_service_study_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +661,7 @@ func (x *StudyRulesHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudyRulesHistory.ProtoReflect.Descriptor instead.
 func (*StudyRulesHistory) Descriptor() ([]byte, []int) {
-	return file_study_service_study_proto_rawDescGZIP(), []int{7}
+	return file_study_service_study_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StudyRulesHistory) GetRules() []*StudyRules {
@@ -652,7 +715,7 @@ type Study_Props struct {
 func (x *Study_Props) Reset() {
 	*x = Study_Props{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[8]
+		mi := &file_study_service_study_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +728,7 @@ func (x *Study_Props) String() string {
 func (*Study_Props) ProtoMessage() {}
 
 func (x *Study_Props) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[8]
+	mi := &file_study_service_study_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +799,7 @@ type Study_Member struct {
 func (x *Study_Member) Reset() {
 	*x = Study_Member{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[9]
+		mi := &file_study_service_study_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -749,7 +812,7 @@ func (x *Study_Member) String() string {
 func (*Study_Member) ProtoMessage() {}
 
 func (x *Study_Member) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[9]
+	mi := &file_study_service_study_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +862,7 @@ type Study_Stats struct {
 func (x *Study_Stats) Reset() {
 	*x = Study_Stats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[10]
+		mi := &file_study_service_study_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -812,7 +875,7 @@ func (x *Study_Stats) String() string {
 func (*Study_Stats) ProtoMessage() {}
 
 func (x *Study_Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[10]
+	mi := &file_study_service_study_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,14 +917,16 @@ type Study_Configs struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IdMappingMethod           string      `protobuf:"bytes,1,opt,name=id_mapping_method,json=idMappingMethod,proto3" json:"id_mapping_method,omitempty"`
-	ParticipantFileUploadRule *Expression `protobuf:"bytes,2,opt,name=participant_file_upload_rule,json=participantFileUploadRule,proto3" json:"participant_file_upload_rule,omitempty"`
+	IdMappingMethod           string                         `protobuf:"bytes,1,opt,name=id_mapping_method,json=idMappingMethod,proto3" json:"id_mapping_method,omitempty"`
+	ParticipantFileUploadRule *Expression                    `protobuf:"bytes,2,opt,name=participant_file_upload_rule,json=participantFileUploadRule,proto3" json:"participant_file_upload_rule,omitempty"`
+	ParticipantStateHistory   *ParticipantStateHistoryConfig `protobuf:"bytes,3,opt,name=participant_state_history,json=participantStateHistory,proto3" json:"participant_state_history,omitempty"`
+	RuleErrorPolicy           string                         `protobuf:"bytes,4,opt,name=rule_error_policy,json=ruleErrorPolicy,proto3" json:"rule_error_policy,omitempty"` // skipFailingRule, allOrNothing or empty for the default (skipFailingRule)
 }
 
 func (x *Study_Configs) Reset() {
 	*x = Study_Configs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_study_service_study_proto_msgTypes[11]
+		mi := &file_study_service_study_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -874,7 +939,7 @@ func (x *Study_Configs) String() string {
 func (*Study_Configs) ProtoMessage() {}
 
 func (x *Study_Configs) ProtoReflect() protoreflect.Message {
-	mi := &file_study_service_study_proto_msgTypes[11]
+	mi := &file_study_service_study_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,6 +969,20 @@ func (x *Study_Configs) GetParticipantFileUploadRule() *Expression {
 	return nil
 }
 
+func (x *Study_Configs) GetParticipantStateHistory() *ParticipantStateHistoryConfig {
+	if x != nil {
+		return x.ParticipantStateHistory
+	}
+	return nil
+}
+
+func (x *Study_Configs) GetRuleErrorPolicy() string {
+	if x != nil {
+		return x.RuleErrorPolicy
+	}
+	return ""
+}
+
 var File_study_service_study_proto protoreflect.FileDescriptor
 
 var file_study_service_study_proto_rawDesc = []byte{
@@ -914,7 +993,7 @@ var file_study_service_study_proto_rawDesc = []byte{
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x08, 0x0a, 0x05, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x0e, 0x0a,
+	0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x0a, 0x0a, 0x05, 0x53, 0x74, 0x75, 0x64, 0x79, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
@@ -974,7 +1053,7 @@ var file_study_service_study_proto_rawDesc = []byte{
 	0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x5f,
 	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9e, 0x01,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xc1, 0x02,
 	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x64, 0x5f,
 	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d,
@@ -984,95 +1063,113 @@ var file_study_service_study_proto_rawDesc = []byte{
 	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79,
 	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x52, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x22, 0xd7,
-	0x01, 0x0a, 0x0c, 0x53, 0x74, 0x75, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x70, 0x72, 0x6f,
-	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x73, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x48, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12,
-	0x41, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74,
-	0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x22, 0xc7, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53,
-	0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x72, 0x76, 0x65,
-	0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46,
-	0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74,
-	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55,
-	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xb0, 0x02, 0x0a,
-	0x0a, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75,
-	0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x75,
+	0x0a, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x17, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x75, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0x60, 0x0a, 0x1d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x79, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x75, 0x64, 0x79, 0x46, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x69, 0x63,
-	0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0f,
-	0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0xa2, 0x01, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76,
-	0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x3d, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x48, 0x0a,
+	0x03, 0x54, 0x61, 0x67, 0x12, 0x41, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xc7, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0xb0, 0x02, 0x0a, 0x0a, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x73, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x10,
+	0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x0f, 0x74, 0x79, 0x70, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x76,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53,
+	0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x07, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x49,
+	0x0a, 0x0c, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
 	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
-	0x52, 0x07, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x73, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x72,
-	0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79,
-	0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79,
-	0x22, 0xc0, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x73,
-	0x74, 0x75, 0x64, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x53, 0x74,
+	0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x54, 0x65, 0x78, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x74,
+	0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1087,48 +1184,50 @@ func file_study_service_study_proto_rawDescGZIP() []byte {
 	return file_study_service_study_proto_rawDescData
 }
 
-var file_study_service_study_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_study_service_study_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_study_service_study_proto_goTypes = []interface{}{
-	(*Study)(nil),             // 0: influenzanet.study_service.Study
-	(*StudyForUser)(nil),      // 1: influenzanet.study_service.StudyForUser
-	(*Tag)(nil),               // 2: influenzanet.study_service.Tag
-	(*AssignedSurvey)(nil),    // 3: influenzanet.study_service.AssignedSurvey
-	(*SurveyInfo)(nil),        // 4: influenzanet.study_service.SurveyInfo
-	(*AssignedSurveys)(nil),   // 5: influenzanet.study_service.AssignedSurveys
-	(*StudyRules)(nil),        // 6: influenzanet.study_service.StudyRules
-	(*StudyRulesHistory)(nil), // 7: influenzanet.study_service.StudyRulesHistory
-	(*Study_Props)(nil),       // 8: influenzanet.study_service.Study.Props
-	(*Study_Member)(nil),      // 9: influenzanet.study_service.Study.Member
-	(*Study_Stats)(nil),       // 10: influenzanet.study_service.Study.Stats
-	(*Study_Configs)(nil),     // 11: influenzanet.study_service.Study.Configs
-	(*Expression)(nil),        // 12: influenzanet.study_service.Expression
-	(*LocalisedObject)(nil),   // 13: influenzanet.study_service.LocalisedObject
+	(*Study)(nil),                         // 0: influenzanet.study_service.Study
+	(*ParticipantStateHistoryConfig)(nil), // 1: influenzanet.study_service.ParticipantStateHistoryConfig
+	(*StudyForUser)(nil),                  // 2: influenzanet.study_service.StudyForUser
+	(*Tag)(nil),                           // 3: influenzanet.study_service.Tag
+	(*AssignedSurvey)(nil),                // 4: influenzanet.study_service.AssignedSurvey
+	(*SurveyInfo)(nil),                    // 5: influenzanet.study_service.SurveyInfo
+	(*AssignedSurveys)(nil),               // 6: influenzanet.study_service.AssignedSurveys
+	(*StudyRules)(nil),                    // 7: influenzanet.study_service.StudyRules
+	(*StudyRulesHistory)(nil),             // 8: influenzanet.study_service.StudyRulesHistory
+	(*Study_Props)(nil),                   // 9: influenzanet.study_service.Study.Props
+	(*Study_Member)(nil),                  // 10: influenzanet.study_service.Study.Member
+	(*Study_Stats)(nil),                   // 11: influenzanet.study_service.Study.Stats
+	(*Study_Configs)(nil),                 // 12: influenzanet.study_service.Study.Configs
+	(*Expression)(nil),                    // 13: influenzanet.study_service.Expression
+	(*LocalisedObject)(nil),               // 14: influenzanet.study_service.LocalisedObject
 }
 var file_study_service_study_proto_depIdxs = []int32{
-	8,  // 0: influenzanet.study_service.Study.props:type_name -> influenzanet.study_service.Study.Props
-	12, // 1: influenzanet.study_service.Study.rules:type_name -> influenzanet.study_service.Expression
-	9,  // 2: influenzanet.study_service.Study.members:type_name -> influenzanet.study_service.Study.Member
-	10, // 3: influenzanet.study_service.Study.stats:type_name -> influenzanet.study_service.Study.Stats
-	11, // 4: influenzanet.study_service.Study.configs:type_name -> influenzanet.study_service.Study.Configs
-	8,  // 5: influenzanet.study_service.StudyForUser.props:type_name -> influenzanet.study_service.Study.Props
-	10, // 6: influenzanet.study_service.StudyForUser.stats:type_name -> influenzanet.study_service.Study.Stats
-	13, // 7: influenzanet.study_service.Tag.label:type_name -> influenzanet.study_service.LocalisedObject
-	13, // 8: influenzanet.study_service.SurveyInfo.name:type_name -> influenzanet.study_service.LocalisedObject
-	13, // 9: influenzanet.study_service.SurveyInfo.description:type_name -> influenzanet.study_service.LocalisedObject
-	13, // 10: influenzanet.study_service.SurveyInfo.typical_duration:type_name -> influenzanet.study_service.LocalisedObject
-	3,  // 11: influenzanet.study_service.AssignedSurveys.surveys:type_name -> influenzanet.study_service.AssignedSurvey
-	4,  // 12: influenzanet.study_service.AssignedSurveys.survey_infos:type_name -> influenzanet.study_service.SurveyInfo
-	12, // 13: influenzanet.study_service.StudyRules.rules:type_name -> influenzanet.study_service.Expression
-	6,  // 14: influenzanet.study_service.StudyRulesHistory.rules:type_name -> influenzanet.study_service.StudyRules
-	13, // 15: influenzanet.study_service.Study.Props.name:type_name -> influenzanet.study_service.LocalisedObject
-	13, // 16: influenzanet.study_service.Study.Props.description:type_name -> influenzanet.study_service.LocalisedObject
-	2,  // 17: influenzanet.study_service.Study.Props.tags:type_name -> influenzanet.study_service.Tag
-	12, // 18: influenzanet.study_service.Study.Configs.participant_file_upload_rule:type_name -> influenzanet.study_service.Expression
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	9,  // 0: influenzanet.study_service.Study.props:type_name -> influenzanet.study_service.Study.Props
+	13, // 1: influenzanet.study_service.Study.rules:type_name -> influenzanet.study_service.Expression
+	10, // 2: influenzanet.study_service.Study.members:type_name -> influenzanet.study_service.Study.Member
+	11, // 3: influenzanet.study_service.Study.stats:type_name -> influenzanet.study_service.Study.Stats
+	12, // 4: influenzanet.study_service.Study.configs:type_name -> influenzanet.study_service.Study.Configs
+	9,  // 5: influenzanet.study_service.StudyForUser.props:type_name -> influenzanet.study_service.Study.Props
+	11, // 6: influenzanet.study_service.StudyForUser.stats:type_name -> influenzanet.study_service.Study.Stats
+	14, // 7: influenzanet.study_service.Tag.label:type_name -> influenzanet.study_service.LocalisedObject
+	14, // 8: influenzanet.study_service.SurveyInfo.name:type_name -> influenzanet.study_service.LocalisedObject
+	14, // 9: influenzanet.study_service.SurveyInfo.description:type_name -> influenzanet.study_service.LocalisedObject
+	14, // 10: influenzanet.study_service.SurveyInfo.typical_duration:type_name -> influenzanet.study_service.LocalisedObject
+	4,  // 11: influenzanet.study_service.AssignedSurveys.surveys:type_name -> influenzanet.study_service.AssignedSurvey
+	5,  // 12: influenzanet.study_service.AssignedSurveys.survey_infos:type_name -> influenzanet.study_service.SurveyInfo
+	13, // 13: influenzanet.study_service.StudyRules.rules:type_name -> influenzanet.study_service.Expression
+	7,  // 14: influenzanet.study_service.StudyRulesHistory.rules:type_name -> influenzanet.study_service.StudyRules
+	14, // 15: influenzanet.study_service.Study.Props.name:type_name -> influenzanet.study_service.LocalisedObject
+	14, // 16: influenzanet.study_service.Study.Props.description:type_name -> influenzanet.study_service.LocalisedObject
+	3,  // 17: influenzanet.study_service.Study.Props.tags:type_name -> influenzanet.study_service.Tag
+	13, // 18: influenzanet.study_service.Study.Configs.participant_file_upload_rule:type_name -> influenzanet.study_service.Expression
+	1,  // 19: influenzanet.study_service.Study.Configs.participant_state_history:type_name -> influenzanet.study_service.ParticipantStateHistoryConfig
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_study_service_study_proto_init() }
@@ -1152,7 +1251,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyForUser); i {
+			switch v := v.(*ParticipantStateHistoryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1164,7 +1263,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tag); i {
+			switch v := v.(*StudyForUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1176,7 +1275,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignedSurvey); i {
+			switch v := v.(*Tag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1188,7 +1287,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SurveyInfo); i {
+			switch v := v.(*AssignedSurvey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1200,7 +1299,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignedSurveys); i {
+			switch v := v.(*SurveyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1212,7 +1311,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyRules); i {
+			switch v := v.(*AssignedSurveys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1224,7 +1323,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StudyRulesHistory); i {
+			switch v := v.(*StudyRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1236,7 +1335,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Study_Props); i {
+			switch v := v.(*StudyRulesHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1248,7 +1347,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Study_Member); i {
+			switch v := v.(*Study_Props); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1260,7 +1359,7 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Study_Stats); i {
+			switch v := v.(*Study_Member); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1272,6 +1371,18 @@ func file_study_service_study_proto_init() {
 			}
 		}
 		file_study_service_study_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Study_Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_study_service_study_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Study_Configs); i {
 			case 0:
 				return &v.state
@@ -1290,7 +1401,7 @@ func file_study_service_study_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_study_service_study_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
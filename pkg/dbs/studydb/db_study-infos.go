@@ -40,6 +40,8 @@ func (dbService *StudyDBService) GetStudiesByStatus(instanceID string, status st
 			primitive.E{Key: "key", Value: 1},                     // {"secretKey", 1},
 			primitive.E{Key: "secretKey", Value: 1},               // {"secretKey", 1},
 			primitive.E{Key: "configs.idMappingMethod", Value: 1}, // {"secretKey", 1},
+			primitive.E{Key: "configs.ruleErrorPolicy", Value: 1},
+			primitive.E{Key: "configs.participantStateHistory", Value: 1},
 		}
 		opts = options.Find().SetProjection(projection)
 	}
@@ -91,6 +93,24 @@ func (dbService *StudyDBService) GetStudySecretKey(instanceID string, studyKey s
 	return study.Configs.IdMappingMethod, study.SecretKey, nil
 }
 
+func (dbService *StudyDBService) GetStudyConfigs(instanceID string, studyKey string) (configs types.StudyConfigs, err error) {
+	projection := bson.D{
+		primitive.E{Key: "configs", Value: 1},
+	}
+
+	var study types.Study
+	if err = dbService.collectionRefStudyInfos(instanceID).FindOne(
+		context.Background(),
+		bson.D{
+			primitive.E{Key: "key", Value: studyKey},
+		},
+		options.FindOne().SetProjection(projection),
+	).Decode(&study); err != nil {
+		return types.StudyConfigs{}, err
+	}
+	return study.Configs, nil
+}
+
 func (dbService *StudyDBService) GetStudyMembers(instanceID string, studyKey string) (members []types.StudyMember, err error) {
 	projection := bson.D{
 		primitive.E{Key: "members", Value: 1}, // {"members", 1},
@@ -202,6 +222,42 @@ func (dbService *StudyDBService) UpdateStudyStatus(instanceID string, studyKey s
 	return err
 }
 
+func (dbService *StudyDBService) UpdateStudyParticipantStateHistoryConfig(instanceID string, studyKey string, config types.ParticipantStateHistoryConfig) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"key": studyKey,
+	}
+	update := bson.M{"$set": bson.M{"configs.participantStateHistory": config}}
+	res, err := dbService.collectionRefStudyInfos(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("study not found")
+	}
+	return nil
+}
+
+func (dbService *StudyDBService) UpdateStudyRuleErrorPolicy(instanceID string, studyKey string, policy string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"key": studyKey,
+	}
+	update := bson.M{"$set": bson.M{"configs.ruleErrorPolicy": policy}}
+	res, err := dbService.collectionRefStudyInfos(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("study not found")
+	}
+	return nil
+}
+
 func (dbService *StudyDBService) UpdateStudyInfo(instanceID string, study types.Study) (types.Study, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -202,7 +202,33 @@ func (dbService *StudyDBService) FindAndExecuteOnParticipantsStates(
 	if len(filterByStatus) > 0 {
 		filter["studyStatus"] = filterByStatus
 	}
+	return dbService.findAndExecuteOnParticipantsStatesWithFilter(ctx, instanceID, studyKey, filter, cbk, args...)
+}
+
+// FindAndExecuteOnParticipantsWithDueScheduledActions calls cbk for each active participant with at least one scheduled action due before the given time
+func (dbService *StudyDBService) FindAndExecuteOnParticipantsWithDueScheduledActions(
+	ctx context.Context,
+	instanceID string,
+	studyKey string,
+	dueBefore int64,
+	cbk func(dbService *StudyDBService, p types.ParticipantState, instanceID string, studyKey string, args ...interface{}) error,
+	args ...interface{},
+) error {
+	filter := bson.M{
+		"studyStatus":                types.PARTICIPANT_STUDY_STATUS_ACTIVE,
+		"scheduledActions.executeAt": bson.M{"$lte": dueBefore},
+	}
+	return dbService.findAndExecuteOnParticipantsStatesWithFilter(ctx, instanceID, studyKey, filter, cbk, args...)
+}
 
+func (dbService *StudyDBService) findAndExecuteOnParticipantsStatesWithFilter(
+	ctx context.Context,
+	instanceID string,
+	studyKey string,
+	filter bson.M,
+	cbk func(dbService *StudyDBService, p types.ParticipantState, instanceID string, studyKey string, args ...interface{}) error,
+	args ...interface{},
+) error {
 	batchSize := int32(32)
 	options := options.FindOptions{
 		BatchSize: &batchSize,
@@ -263,6 +289,21 @@ func (dbService *StudyDBService) CreateMessageScheduledForIndex(instanceID strin
 	return err
 }
 
+func (dbService *StudyDBService) CreateScheduledActionsIndex(instanceID string, studyKey string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefStudyParticipant(instanceID, studyKey).Indexes().CreateOne(
+		ctx, mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "scheduledActions.executeAt", Value: 1},
+				{Key: "studyStatus", Value: 1},
+			},
+		},
+	)
+	return err
+}
+
 func (dbService *StudyDBService) CreateParticipantIDIndex(instanceID string, studyKey string) error {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -292,6 +333,21 @@ func (dbService *StudyDBService) CreateMessageScheduledForIndexForAllStudies(ins
 	}
 }
 
+func (dbService *StudyDBService) CreateScheduledActionsIndexForAllStudies(instanceID string) {
+	studies, err := dbService.GetStudiesByStatus(instanceID, "", true)
+	if err != nil {
+		logger.Error.Printf("unexpected error when fetching studies in '%s': %v", instanceID, err)
+		return
+	}
+
+	for _, study := range studies {
+		err = dbService.CreateScheduledActionsIndex(instanceID, study.Key)
+		if err != nil {
+			logger.Error.Printf("unexpected error when creating scheduled actions indexes: %v", err)
+		}
+	}
+}
+
 func (dbService *StudyDBService) CreateParticipantIDIndexForAllStudies(instanceID string) {
 	studies, err := dbService.GetStudiesByStatus(instanceID, "", true)
 	if err != nil {
@@ -75,6 +75,27 @@ func (dbService *StudyDBService) GetCurrentStudyRules(instanceID string, studyKe
 	return elem, err
 }
 
+// GetCurrentStudyRulesVersionID returns the ID of the latest uploaded study rules, without loading the rules
+func (dbService *StudyDBService) GetCurrentStudyRulesVersionID(instanceID string, studyKey string) (string, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"studyKey": studyKey,
+	}
+
+	elem := &types.StudyRules{}
+	opts := options.FindOne().
+		SetSort(bson.D{primitive.E{Key: "uploadedAt", Value: -1}}).
+		SetProjection(bson.D{primitive.E{Key: "_id", Value: 1}})
+
+	err := dbService.collectionRefStudyRules(instanceID).FindOne(ctx, filter, opts).Decode(&elem)
+	if err != nil {
+		return "", err
+	}
+	return elem.ID.Hex(), nil
+}
+
 func (dbService *StudyDBService) GetStudyKeyByStudyRulesID(instanceID string, versionID string) (studyKey string, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
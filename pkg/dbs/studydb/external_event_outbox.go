@@ -0,0 +1,184 @@
+package studydb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *StudyDBService) collectionRefExternalEventOutbox(instanceID string, studyKey string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_studyDB").Collection(studyKey + "_externalEventOutbox")
+}
+
+// AddExternalEventToOutbox stores the event as pending, to be delivered by the background worker
+func (dbService *StudyDBService) AddExternalEventToOutbox(instanceID string, studyKey string, entry types.ExternalEventOutboxEntry) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	entry.ID = primitive.NilObjectID
+	entry.Status = types.EXTERNAL_EVENT_STATUS_PENDING
+	if entry.CreatedAt == 0 {
+		entry.CreatedAt = time.Now().Unix()
+	}
+	if entry.NextAttemptAt == 0 {
+		entry.NextAttemptAt = entry.CreatedAt
+	}
+	_, err := dbService.collectionRefExternalEventOutbox(instanceID, studyKey).InsertOne(ctx, entry)
+	return err
+}
+
+// ClaimDueExternalEvent returns the next pending event due at now and postpones its next attempt by lockDuration (seconds),
+// so other workers don't pick it up while it is being delivered. Returns mongo.ErrNoDocuments if no event is due.
+func (dbService *StudyDBService) ClaimDueExternalEvent(instanceID string, studyKey string, now int64, lockDuration int64) (entry types.ExternalEventOutboxEntry, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"status":        types.EXTERNAL_EVENT_STATUS_PENDING,
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"nextAttemptAt": now + lockDuration}}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).SetReturnDocument(options.After)
+
+	err = dbService.collectionRefExternalEventOutbox(instanceID, studyKey).FindOneAndUpdate(ctx, filter, update, opts).Decode(&entry)
+	return entry, err
+}
+
+// UpdateExternalEventDeliveryState saves the result of a delivery attempt
+func (dbService *StudyDBService) UpdateExternalEventDeliveryState(instanceID string, studyKey string, entry types.ExternalEventOutboxEntry) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"_id": entry.ID}
+	update := bson.M{"$set": bson.M{
+		"status":        entry.Status,
+		"attempts":      entry.Attempts,
+		"nextAttemptAt": entry.NextAttemptAt,
+		"lastError":     entry.LastError,
+		"deliveredAt":   entry.DeliveredAt,
+	}}
+	res, err := dbService.collectionRefExternalEventOutbox(instanceID, studyKey).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("external event not found")
+	}
+	return nil
+}
+
+// FindExternalEvents returns the events of the outbox with the given status (all if empty), oldest first
+func (dbService *StudyDBService) FindExternalEvents(instanceID string, studyKey string, status string) (entries []types.ExternalEventOutboxEntry, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+
+	cur, err := dbService.collectionRefExternalEventOutbox(instanceID, studyKey).Find(ctx, filter, opts)
+	if err != nil {
+		return entries, err
+	}
+	defer cur.Close(ctx)
+
+	entries = []types.ExternalEventOutboxEntry{}
+	for cur.Next(ctx) {
+		var result types.ExternalEventOutboxEntry
+		err := cur.Decode(&result)
+		if err != nil {
+			return entries, err
+		}
+
+		entries = append(entries, result)
+	}
+	if err := cur.Err(); err != nil {
+		return entries, err
+	}
+
+	return entries, nil
+}
+
+// RedriveExternalEvents resets dead letter events to pending with no attempts, so they are delivered again.
+// If ids is empty, all dead letter events of the study are re-driven.
+func (dbService *StudyDBService) RedriveExternalEvents(instanceID string, studyKey string, ids []string) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"status": types.EXTERNAL_EVENT_STATUS_DEAD_LETTER}
+	if len(ids) > 0 {
+		objIDs := make([]primitive.ObjectID, len(ids))
+		for i, id := range ids {
+			objID, err := primitive.ObjectIDFromHex(id)
+			if err != nil {
+				return 0, err
+			}
+			objIDs[i] = objID
+		}
+		filter["_id"] = bson.M{"$in": objIDs}
+	}
+	update := bson.M{"$set": bson.M{
+		"status":        types.EXTERNAL_EVENT_STATUS_PENDING,
+		"attempts":      0,
+		"nextAttemptAt": time.Now().Unix(),
+	}}
+	res, err := dbService.collectionRefExternalEventOutbox(instanceID, studyKey).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// DeleteDeliveredExternalEvents removes the events of the outbox delivered before deliveredBefore (unix seconds)
+func (dbService *StudyDBService) DeleteDeliveredExternalEvents(instanceID string, studyKey string, deliveredBefore int64) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"status":      types.EXTERNAL_EVENT_STATUS_DELIVERED,
+		"deliveredAt": bson.M{"$lt": deliveredBefore},
+	}
+	res, err := dbService.collectionRefExternalEventOutbox(instanceID, studyKey).DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+func (dbService *StudyDBService) CreateExternalEventOutboxIndex(instanceID string, studyKey string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefExternalEventOutbox(instanceID, studyKey).Indexes().CreateOne(
+		ctx, mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "nextAttemptAt", Value: 1},
+			},
+		},
+	)
+	return err
+}
+
+func (dbService *StudyDBService) CreateExternalEventOutboxIndexForAllStudies(instanceID string) {
+	studies, err := dbService.GetStudiesByStatus(instanceID, "", true)
+	if err != nil {
+		logger.Error.Printf("unexpected error when fetching studies in '%s': %v", instanceID, err)
+		return
+	}
+
+	for _, study := range studies {
+		err = dbService.CreateExternalEventOutboxIndex(instanceID, study.Key)
+		if err != nil {
+			logger.Error.Printf("unexpected error when creating external event outbox indexes: %v", err)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package studydb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbExternalEventOutbox(t *testing.T) {
+	testStudyKey := "teststudy_outbox"
+
+	if err := testDBService.CreateExternalEventOutboxIndex(testInstanceID, testStudyKey); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+
+	t.Run("add events", func(t *testing.T) {
+		for _, serviceName := range []string{"s1", "s2"} {
+			err := testDBService.AddExternalEventToOutbox(testInstanceID, testStudyKey, types.ExternalEventOutboxEntry{
+				ServiceName: serviceName,
+				CreatedAt:   100,
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		}
+	})
+
+	t.Run("claim and fail event", func(t *testing.T) {
+		entry, err := testDBService.ClaimDueExternalEvent(testInstanceID, testStudyKey, 200, 60)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if entry.NextAttemptAt != 260 {
+			t.Errorf("unexpected entry: %v", entry)
+		}
+		entry.Status = types.EXTERNAL_EVENT_STATUS_DEAD_LETTER
+		entry.Attempts = 1
+		entry.LastError = "unavailable"
+		if err := testDBService.UpdateExternalEventDeliveryState(testInstanceID, testStudyKey, entry); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+
+		entry, err = testDBService.ClaimDueExternalEvent(testInstanceID, testStudyKey, 200, 60)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err = testDBService.ClaimDueExternalEvent(testInstanceID, testStudyKey, 200, 60); err != mongo.ErrNoDocuments {
+			t.Errorf("claimed event should not be due: %v", err)
+		}
+	})
+
+	t.Run("find and re-drive dead letters", func(t *testing.T) {
+		entries, err := testDBService.FindExternalEvents(testInstanceID, testStudyKey, types.EXTERNAL_EVENT_STATUS_DEAD_LETTER)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(entries) != 1 || entries[0].LastError != "unavailable" {
+			t.Errorf("unexpected entries: %v", entries)
+			return
+		}
+		count, err := testDBService.RedriveExternalEvents(testInstanceID, testStudyKey, []string{entries[0].ID.Hex()})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if count != 1 {
+			t.Errorf("unexpected count: %d", count)
+		}
+	})
+	t.Run("delete delivered events", func(t *testing.T) {
+		entry, err := testDBService.ClaimDueExternalEvent(testInstanceID, testStudyKey, 300, 60)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		entry.Status = types.EXTERNAL_EVENT_STATUS_DELIVERED
+		entry.DeliveredAt = 300
+		if err := testDBService.UpdateExternalEventDeliveryState(testInstanceID, testStudyKey, entry); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+
+		count, err := testDBService.DeleteDeliveredExternalEvents(testInstanceID, testStudyKey, 300)
+		if err != nil || count != 0 {
+			t.Errorf("unexpected result: %d, %v", count, err)
+		}
+		count, err = testDBService.DeleteDeliveredExternalEvents(testInstanceID, testStudyKey, 301)
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d, %v", count, err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package studydb
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ParticipantStateHistoryQuery struct {
+	ParticipantID string
+	Since         int64
+	Until         int64
+}
+
+func (dbService *StudyDBService) collectionRefParticipantStateHistory(instanceID string, studyKey string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_studyDB").Collection(studyKey + "_participantStateHistory")
+}
+
+func (dbService *StudyDBService) AddParticipantStateHistoryEntry(instanceID string, studyKey string, entry types.ParticipantStateHistoryEntry) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	_, err := dbService.collectionRefParticipantStateHistory(instanceID, studyKey).InsertOne(ctx, entry)
+	return err
+}
+
+// FindAndExecuteOnParticipantStateHistory calls cbk for each history entry matching the query, in the order of their timestamp
+func (dbService *StudyDBService) FindAndExecuteOnParticipantStateHistory(
+	ctx context.Context,
+	instanceID string,
+	studyKey string,
+	query ParticipantStateHistoryQuery,
+	cbk func(entry types.ParticipantStateHistoryEntry) error,
+) error {
+	filter := bson.M{}
+	if query.ParticipantID != "" {
+		filter["participantID"] = query.ParticipantID
+	}
+	if query.Since > 0 && query.Until > 0 {
+		filter["$and"] = bson.A{
+			bson.M{"timestamp": bson.M{"$gt": query.Since}},
+			bson.M{"timestamp": bson.M{"$lt": query.Until}},
+		}
+	} else if query.Since > 0 {
+		filter["timestamp"] = bson.M{"$gt": query.Since}
+	} else if query.Until > 0 {
+		filter["timestamp"] = bson.M{"$lt": query.Until}
+	}
+
+	batchSize := int32(32)
+	opts := options.Find().SetBatchSize(batchSize).SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	cur, err := dbService.collectionRefParticipantStateHistory(instanceID, studyKey).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var entry types.ParticipantStateHistoryEntry
+		if err := cur.Decode(&entry); err != nil {
+			logger.Error.Printf("wrong data model: %v, %v", entry, err)
+			continue
+		}
+		if err := cbk(entry); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// DeleteParticipantStateHistoryOlderThan removes the history entries with a timestamp before the given one
+func (dbService *StudyDBService) DeleteParticipantStateHistoryOlderThan(instanceID string, studyKey string, timestamp int64) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"timestamp": bson.M{"$lt": timestamp}}
+	res, err := dbService.collectionRefParticipantStateHistory(instanceID, studyKey).DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+func (dbService *StudyDBService) CreateParticipantStateHistoryIndex(instanceID string, studyKey string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefParticipantStateHistory(instanceID, studyKey).Indexes().CreateMany(
+		ctx, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "participantID", Value: 1},
+					{Key: "timestamp", Value: 1},
+				},
+			},
+			{
+				Keys: bson.D{
+					{Key: "timestamp", Value: 1},
+				},
+			},
+		},
+	)
+	return err
+}
+
+func (dbService *StudyDBService) CreateParticipantStateHistoryIndexForAllStudies(instanceID string) {
+	studies, err := dbService.GetStudiesByStatus(instanceID, "", true)
+	if err != nil {
+		logger.Error.Printf("unexpected error when fetching studies in '%s': %v", instanceID, err)
+		return
+	}
+
+	for _, study := range studies {
+		err = dbService.CreateParticipantStateHistoryIndex(instanceID, study.Key)
+		if err != nil {
+			logger.Error.Printf("unexpected error when creating participant state history indexes: %v", err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package studydb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestDbParticipantStateHistory(t *testing.T) {
+	testStudyKey := "teststudy_statehistory"
+
+	if err := testDBService.CreateParticipantStateHistoryIndex(testInstanceID, testStudyKey); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+
+	t.Run("add entries", func(t *testing.T) {
+		oldState := types.ParticipantState{ParticipantID: "p1", Flags: map[string]string{}}
+		for i := 1; i <= 3; i++ {
+			newState := types.ParticipantState{ParticipantID: "p1", Flags: map[string]string{"visits": string(rune('0' + i))}}
+			entry := types.NewParticipantStateHistoryEntry(oldState, newState, "SUBMIT", "weekly", "", int64(i*100))
+			if err := testDBService.AddParticipantStateHistoryEntry(testInstanceID, testStudyKey, entry); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+			oldState = newState
+		}
+		entry := types.NewParticipantStateHistoryEntry(types.ParticipantState{}, types.ParticipantState{ParticipantID: "p2", StudyStatus: types.PARTICIPANT_STUDY_STATUS_ACTIVE}, "ENTER", "", "", 150)
+		if err := testDBService.AddParticipantStateHistoryEntry(testInstanceID, testStudyKey, entry); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+	})
+
+	t.Run("find entries of participant", func(t *testing.T) {
+		entries := []types.ParticipantStateHistoryEntry{}
+		err := testDBService.FindAndExecuteOnParticipantStateHistory(context.Background(), testInstanceID, testStudyKey, ParticipantStateHistoryQuery{ParticipantID: "p1", Since: 100}, func(entry types.ParticipantStateHistoryEntry) error {
+			entries = append(entries, entry)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(entries) != 2 || entries[0].Timestamp != 200 || entries[1].Timestamp != 300 {
+			t.Errorf("unexpected entries: %v", entries)
+			return
+		}
+		if change := entries[0].Diff.Flags["visits"]; change.Before == nil || *change.Before != "1" || change.After == nil || *change.After != "2" {
+			t.Errorf("unexpected diff: %v", entries[0].Diff)
+		}
+	})
+
+	t.Run("delete old entries", func(t *testing.T) {
+		count, err := testDBService.DeleteParticipantStateHistoryOlderThan(testInstanceID, testStudyKey, 200)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if count != 2 {
+			t.Errorf("unexpected number of deleted entries: %d", count)
+		}
+	})
+}
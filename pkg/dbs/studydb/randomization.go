@@ -0,0 +1,183 @@
+package studydb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *StudyDBService) collectionRefRandomizationAllocations(instanceID string, studyKey string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_studyDB").Collection(studyKey + "_randomizationAllocations")
+}
+
+// ReserveRandomizationSlot takes the next slot of the allocation sequence in one atomic operation, so concurrent participants
+// and service replicas never get the same slot. Released slots are taken first, to keep the blocks balanced. If the allocation
+// does not exist yet, it is created with seed, otherwise the slot carries the stored seed.
+func (dbService *StudyDBService) ReserveRandomizationSlot(instanceID string, studyKey string, randomizationKey string, stratum string, seed int64) (slot types.RandomizationSlot, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	slot = types.RandomizationSlot{RandomizationKey: randomizationKey, Stratum: stratum}
+	collection := dbService.collectionRefRandomizationAllocations(instanceID, studyKey)
+
+	var allocation types.RandomizationAllocation
+	filter := bson.M{"randomizationKey": randomizationKey, "stratum": stratum, "released.0": bson.M{"$exists": true}}
+	update := bson.M{
+		"$pop": bson.M{"released": -1},
+		"$set": bson.M{"updatedAt": time.Now().Unix()},
+	}
+	err = collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.Before)).Decode(&allocation)
+	if err == nil {
+		slot.Slot = allocation.Released[0]
+		slot.Seed = allocation.Seed
+		return slot, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return slot, err
+	}
+
+	filter = bson.M{"randomizationKey": randomizationKey, "stratum": stratum}
+	update = bson.M{
+		"$inc":         bson.M{"total": 1},
+		"$set":         bson.M{"updatedAt": time.Now().Unix()},
+		"$setOnInsert": bson.M{"seed": seed},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err = collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&allocation)
+	if mongo.IsDuplicateKeyError(err) {
+		// the allocation was created concurrently, the update matches it now
+		err = collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&allocation)
+	}
+	if err != nil {
+		return slot, err
+	}
+	slot.Slot = allocation.Total - 1
+	slot.Seed = allocation.Seed
+	return slot, nil
+}
+
+// CommitRandomizationSlot counts the participant for the arm of the reserved slot, once the participant state is saved
+func (dbService *StudyDBService) CommitRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"randomizationKey": slot.RandomizationKey, "stratum": slot.Stratum}
+	update := bson.M{
+		"$inc": bson.M{"counts." + slot.Arm: 1},
+		"$set": bson.M{"updatedAt": time.Now().Unix()},
+	}
+	res, err := dbService.collectionRefRandomizationAllocations(instanceID, studyKey).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("randomization allocation not found")
+	}
+	return nil
+}
+
+// ReleaseRandomizationSlot returns a reserved slot, if the participant state was not saved. The slot is reserved for the next participant.
+func (dbService *StudyDBService) ReleaseRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"randomizationKey": slot.RandomizationKey, "stratum": slot.Stratum}
+	update := bson.M{
+		"$push": bson.M{"released": slot.Slot},
+		"$set":  bson.M{"updatedAt": time.Now().Unix()},
+	}
+	res, err := dbService.collectionRefRandomizationAllocations(instanceID, studyKey).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("randomization allocation not found")
+	}
+	return nil
+}
+
+// GetRandomizationAllocation returns the current allocation of the randomization key and stratum, with a total of 0 if nobody is allocated yet
+func (dbService *StudyDBService) GetRandomizationAllocation(instanceID string, studyKey string, randomizationKey string, stratum string) (allocation types.RandomizationAllocation, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"randomizationKey": randomizationKey, "stratum": stratum}
+	err = dbService.collectionRefRandomizationAllocations(instanceID, studyKey).FindOne(ctx, filter).Decode(&allocation)
+	if err == mongo.ErrNoDocuments {
+		return types.RandomizationAllocation{RandomizationKey: randomizationKey, Stratum: stratum}, nil
+	}
+	return allocation, err
+}
+
+// FindRandomizationAllocations returns the allocation table of the study, for all randomization keys if randomizationKey is empty
+func (dbService *StudyDBService) FindRandomizationAllocations(instanceID string, studyKey string, randomizationKey string) (allocations []types.RandomizationAllocation, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if randomizationKey != "" {
+		filter["randomizationKey"] = randomizationKey
+	}
+	opts := options.Find().SetSort(bson.D{
+		{Key: "randomizationKey", Value: 1},
+		{Key: "stratum", Value: 1},
+	})
+
+	cur, err := dbService.collectionRefRandomizationAllocations(instanceID, studyKey).Find(ctx, filter, opts)
+	if err != nil {
+		return allocations, err
+	}
+	defer cur.Close(ctx)
+
+	allocations = []types.RandomizationAllocation{}
+	for cur.Next(ctx) {
+		var result types.RandomizationAllocation
+		err := cur.Decode(&result)
+		if err != nil {
+			return allocations, err
+		}
+
+		allocations = append(allocations, result)
+	}
+	if err := cur.Err(); err != nil {
+		return allocations, err
+	}
+
+	return allocations, nil
+}
+
+func (dbService *StudyDBService) CreateRandomizationAllocationsIndex(instanceID string, studyKey string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefRandomizationAllocations(instanceID, studyKey).Indexes().CreateOne(
+		ctx, mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "randomizationKey", Value: 1},
+				{Key: "stratum", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	return err
+}
+
+func (dbService *StudyDBService) CreateRandomizationAllocationsIndexForAllStudies(instanceID string) {
+	studies, err := dbService.GetStudiesByStatus(instanceID, "", true)
+	if err != nil {
+		logger.Error.Printf("unexpected error when fetching studies in '%s': %v", instanceID, err)
+		return
+	}
+
+	for _, study := range studies {
+		err = dbService.CreateRandomizationAllocationsIndex(instanceID, study.Key)
+		if err != nil {
+			logger.Error.Printf("unexpected error when creating randomization allocation indexes: %v", err)
+		}
+	}
+}
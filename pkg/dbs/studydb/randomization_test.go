@@ -0,0 +1,82 @@
+package studydb
+
+import (
+	"testing"
+)
+
+func TestDbRandomizationAllocations(t *testing.T) {
+	testStudyKey := "teststudy_randomization"
+
+	if err := testDBService.CreateRandomizationAllocationsIndex(testInstanceID, testStudyKey); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+
+	t.Run("reserve and commit slots", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			slot, err := testDBService.ReserveRandomizationSlot(testInstanceID, testStudyKey, "main", "female", int64(i+1))
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+				return
+			}
+			// the seed of the first reservation is kept
+			if slot.Slot != int64(i) || slot.Seed != 1 {
+				t.Errorf("unexpected slot: %v", slot)
+			}
+			slot.Arm = "control"
+			if err := testDBService.CommitRandomizationSlot(testInstanceID, testStudyKey, slot); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		}
+		slot, err := testDBService.ReserveRandomizationSlot(testInstanceID, testStudyKey, "main", "male", 5)
+		if err != nil || slot.Slot != 0 || slot.Seed != 5 {
+			t.Errorf("unexpected result: %v, %v", slot, err)
+		}
+	})
+
+	t.Run("release slot", func(t *testing.T) {
+		slot, err := testDBService.ReserveRandomizationSlot(testInstanceID, testStudyKey, "main", "female", 9)
+		if err != nil || slot.Slot != 3 {
+			t.Errorf("unexpected result: %v, %v", slot, err)
+			return
+		}
+		if err := testDBService.ReleaseRandomizationSlot(testInstanceID, testStudyKey, slot); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		// the released slot is reserved again first
+		slot, err = testDBService.ReserveRandomizationSlot(testInstanceID, testStudyKey, "main", "female", 9)
+		if err != nil || slot.Slot != 3 || slot.Seed != 1 {
+			t.Errorf("unexpected result: %v, %v", slot, err)
+			return
+		}
+		slot.Arm = "treatment"
+		if err := testDBService.CommitRandomizationSlot(testInstanceID, testStudyKey, slot); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+	})
+
+	t.Run("get allocation", func(t *testing.T) {
+		allocation, err := testDBService.GetRandomizationAllocation(testInstanceID, testStudyKey, "main", "female")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if allocation.Total != 4 || len(allocation.Released) != 0 || allocation.Seed != 1 || allocation.Counts["control"] != 3 || allocation.Counts["treatment"] != 1 {
+			t.Errorf("unexpected allocation: %v", allocation)
+		}
+		allocation, err = testDBService.GetRandomizationAllocation(testInstanceID, testStudyKey, "other", "")
+		if err != nil || allocation.Total != 0 || allocation.RandomizationKey != "other" {
+			t.Errorf("unexpected result: %v, %v", allocation, err)
+		}
+	})
+
+	t.Run("find allocations", func(t *testing.T) {
+		allocations, err := testDBService.FindRandomizationAllocations(testInstanceID, testStudyKey, "main")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(allocations) != 2 || allocations[0].Stratum != "female" || allocations[1].Stratum != "male" {
+			t.Errorf("unexpected allocations: %v", allocations)
+		}
+	})
+}
@@ -5,12 +5,29 @@ import (
 	"github.com/influenzanet/study-service/pkg/types"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// SaveResearcherMessage inserts the message. Messages with a DedupKey are only inserted if there is no message with the same key yet,
+// otherwise the count of the existing message is increased.
 func (dbService *StudyDBService) SaveResearcherMessage(instanceID string, studyKey string, message types.StudyMessage) error {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
-	_, err := dbService.collectionRefResearcherMessages(instanceID, studyKey).InsertOne(ctx, message)
+	if message.DedupKey == "" {
+		_, err := dbService.collectionRefResearcherMessages(instanceID, studyKey).InsertOne(ctx, message)
+		return err
+	}
+
+	filter := bson.M{"dedupKey": message.DedupKey}
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"type":          message.Type,
+			"payload":       message.Payload,
+			"participantID": message.ParticipantID,
+		},
+		"$inc": bson.M{"count": 1},
+	}
+	_, err := dbService.collectionRefResearcherMessages(instanceID, studyKey).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
 	return err
 }
 
@@ -238,6 +238,46 @@ func (s *studyServiceServer) StreamReportHistory(req *api.ReportHistoryQuery, st
 	return nil
 }
 
+// StreamParticipantStateHistory sends the recorded participant state changes matching the query, in the order of their timestamp
+func (s *studyServiceServer) StreamParticipantStateHistory(req *api.ParticipantStateHistoryQuery, stream api.StudyServiceApi_StreamParticipantStateHistoryServer) error {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return s.missingArgumentError()
+	}
+
+	if !(token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) &&
+		token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_RESEARCHER)) {
+		err := s.HasRoleInStudy(req.Token.InstanceId, req.StudyKey, req.Token.Id, []string{
+			types.STUDY_ROLE_OWNER,
+			types.STUDY_ROLE_MAINTAINER,
+		})
+		if err != nil {
+			logger.Warning.Printf("unauthorizd access attempt to participant state history in (%s-%s): %v", req.Token.InstanceId, req.StudyKey, err)
+			s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_GET_PARTICIPANT_STATES, "permission denied for state history of "+req.StudyKey)
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	query := studydb.ParticipantStateHistoryQuery{
+		ParticipantID: req.ParticipantId,
+		Since:         req.Since,
+		Until:         req.Until,
+	}
+	err := s.studyDBservice.FindAndExecuteOnParticipantStateHistory(
+		stream.Context(),
+		req.Token.InstanceId,
+		req.StudyKey,
+		query,
+		func(entry types.ParticipantStateHistoryEntry) error {
+			return stream.Send(entry.ToAPI())
+		},
+	)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_GET_PARTICIPANT_STATES, "state history of "+req.StudyKey)
+	return nil
+}
+
 func (s *studyServiceServer) StreamParticipantFileInfos(req *api.FileInfoQuery, stream api.StudyServiceApi_StreamParticipantFileInfosServer) error {
 	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
 		return s.missingArgumentError()
@@ -5,13 +5,33 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/influenzanet/study-service/pkg/api"
+	"github.com/influenzanet/study-service/pkg/studyengine"
 )
 
-// Status endpoint should return internal status of the system if running correctly
+// Status endpoint should return internal status of the system if running correctly.
+// The circuit breaker states of the external services are reported, but do not change the status.
 func (s *studyServiceServer) Status(ctx context.Context, _ *empty.Empty) (*api.ServiceStatus, error) {
 	return &api.ServiceStatus{
-		Status:  api.ServiceStatus_NORMAL,
-		Msg:     "service running",
-		Version: apiVersion,
+		Status:           api.ServiceStatus_NORMAL,
+		Msg:              "service running",
+		Version:          apiVersion,
+		ExternalServices: externalServicesHealthToAPI(studyengine.ExternalServicesHealth(s.studyEngineExternalServices)),
 	}, nil
 }
+
+func externalServicesHealthToAPI(health []studyengine.ExternalServiceHealth) []*api.ExternalServiceHealth {
+	services := make([]*api.ExternalServiceHealth, len(health))
+	for i, h := range health {
+		services[i] = &api.ExternalServiceHealth{
+			Name:                h.Name,
+			State:               h.State,
+			ConsecutiveFailures: int32(h.ConsecutiveFailures),
+			Calls:               h.Calls,
+			Failures:            h.Failures,
+			Rejected:            h.Rejected,
+			LastError:           h.LastError,
+			LastFailureAt:       h.LastFailureAt,
+		}
+	}
+	return services
+}
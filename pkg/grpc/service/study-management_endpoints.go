@@ -31,6 +31,14 @@ func (s *studyServiceServer) CreateNewStudy(ctx context.Context, req *api.NewStu
 	}
 
 	study := types.StudyFromAPI(req.Study)
+	validationErrors := studyengine.ValidateStudyRules(study.Rules)
+	if study.Configs.ParticipantFileUploadRule != nil {
+		validationErrors = append(validationErrors, studyengine.ValidateExpression(*study.Configs.ParticipantFileUploadRule, "configs.participantFileUploadRule")...)
+	}
+	if len(validationErrors) > 0 {
+		return nil, status.Error(codes.InvalidArgument, validationErrors.Error())
+	}
+
 	study.Members = []types.StudyMember{
 		{
 			Role:     types.STUDY_ROLE_OWNER,
@@ -50,6 +58,18 @@ func (s *studyServiceServer) CreateNewStudy(ctx context.Context, req *api.NewStu
 	if err != nil {
 		logger.Error.Printf("unexpected error when creating survey definition indexes: %v", err)
 	}
+	err = s.studyDBservice.CreateRandomizationAllocationsIndex(req.Token.InstanceId, study.Key)
+	if err != nil {
+		logger.Error.Printf("unexpected error when creating randomization allocation indexes: %v", err)
+	}
+	err = s.studyDBservice.CreateParticipantStateHistoryIndex(req.Token.InstanceId, study.Key)
+	if err != nil {
+		logger.Error.Printf("unexpected error when creating participant state history indexes: %v", err)
+	}
+	err = s.studyDBservice.CreateExternalEventOutboxIndex(req.Token.InstanceId, study.Key)
+	if err != nil {
+		logger.Error.Printf("unexpected error when creating external event outbox indexes: %v", err)
+	}
 
 	return cStudy.ToAPI(), nil
 }
@@ -136,6 +156,12 @@ func (s *studyServiceServer) SaveSurveyToStudy(ctx context.Context, req *api.Add
 	}
 
 	newSurvey := types.SurveyFromAPI(req.Survey)
+	validationErrors := studyengine.ValidatePrefillRules(newSurvey.PrefillRules)
+	validationErrors = append(validationErrors, studyengine.ValidateContextRules(newSurvey.ContextRules)...)
+	if len(validationErrors) > 0 {
+		return nil, status.Error(codes.InvalidArgument, validationErrors.Error())
+	}
+
 	if newSurvey.VersionID == "" {
 		surveyHistory, err := s.studyDBservice.FindSurveyDefHistory(req.Token.InstanceId, req.StudyKey, req.Survey.SurveyDefinition.Key, true)
 		if err != nil {
@@ -399,6 +425,28 @@ func (s *studyServiceServer) RemoveStudyMember(ctx context.Context, req *api.Stu
 }
 
 func (s *studyServiceServer) GetCurrentStudyRules(ctx context.Context, req *api.StudyReferenceReq) (*api.StudyRules, error) {
+	studyRules, err := s.getCurrentStudyRules(req)
+	if err != nil {
+		return nil, err
+	}
+	return studyRules.ToAPI(), nil
+}
+
+// GetCurrentStudyRulesText returns the current study rules also in text syntax
+func (s *studyServiceServer) GetCurrentStudyRulesText(ctx context.Context, req *api.StudyReferenceReq) (*api.StudyRules, error) {
+	studyRules, err := s.getCurrentStudyRules(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := studyRules.ToAPI()
+	resp.RulesText, err = studyengine.FormatRulesText(studyRules.Rules)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("rules cannot be written as text: %v", err))
+	}
+	return resp, nil
+}
+
+func (s *studyServiceServer) getCurrentStudyRules(req *api.StudyReferenceReq) (*types.StudyRules, error) {
 	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
@@ -418,8 +466,7 @@ func (s *studyServiceServer) GetCurrentStudyRules(ctx context.Context, req *api.
 		logger.Warning.Printf("study rules for study %s not found", req.StudyKey)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-
-	return studyRules.ToAPI(), nil
+	return studyRules, nil
 }
 
 func (s *studyServiceServer) GetStudyRulesHistory(ctx context.Context, req *api.StudyRulesHistoryReq) (*api.StudyRulesHistory, error) {
@@ -489,6 +536,7 @@ func (s *studyServiceServer) RemoveStudyRulesVersion(ctx context.Context, req *a
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	studyengine.StudyRulesCache.Invalidate(req.Token.InstanceId, studyKey)
 
 	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_STUDY_DELETION, studyKey)
 	return &api.ServiceStatus{
@@ -512,15 +560,17 @@ func (s *studyServiceServer) SaveStudyRules(ctx context.Context, req *api.StudyR
 		}
 	}
 
+	rules, err := getRulesFromRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if validationErrors := studyengine.ValidateStudyRules(rules); len(validationErrors) > 0 {
+		return nil, status.Error(codes.InvalidArgument, validationErrors.Error())
+	}
 	study, err := s.studyDBservice.GetStudyByStudyKey(req.Token.InstanceId, req.StudyKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-
-	rules := []types.Expression{}
-	for _, exp := range req.Rules {
-		rules = append(rules, *types.ExpressionFromAPI(exp))
-	}
 	study.Rules = rules
 	uStudy, err := s.studyDBservice.UpdateStudyInfo(req.Token.InstanceId, study)
 	if err != nil {
@@ -537,6 +587,8 @@ func (s *studyServiceServer) SaveStudyRules(ctx context.Context, req *api.StudyR
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// other replicas notice the new version when they read the rules
+	studyengine.StudyRulesCache.Invalidate(req.Token.InstanceId, req.StudyKey)
 
 	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_STUDY_UPDATE, fmt.Sprintf("rules updated for %s", req.StudyKey))
 	return uStudy.ToAPI(), nil
@@ -694,6 +746,7 @@ func (s *studyServiceServer) RunRules(ctx context.Context, req *api.StudyRulesRe
 	type Counters struct {
 		Participants                  int32
 		ParticipantStateChangePerRule []int32
+		DBCalls                       studyengine.DBCallStats
 	}
 	counters := &Counters{
 		Participants:                  0,
@@ -725,11 +778,18 @@ func (s *studyServiceServer) RunRules(ctx context.Context, req *api.StudyRulesRe
 				return status.Error(codes.Internal, err.Error())
 			}
 
+			oldState := studyengine.CopyParticipantState(p)
 			actionData := studyengine.ActionData{
 				PState:          p,
 				ReportsToCreate: map[string]types.Report{},
 			}
 			anyChange := false
+			actionConfigs, eventDB := studyengine.WithEventDBCache(studyengine.ActionConfigs{
+				DBService:              s.studyDBservice,
+				ExternalServiceConfigs: s.studyEngineExternalServices,
+			})
+			actionConfigs, cancel := studyengine.WithEvalBudget(ctx, actionConfigs)
+			defer cancel()
 			for index, rule := range rules {
 				if rule == nil {
 					continue
@@ -740,11 +800,12 @@ func (s *studyServiceServer) RunRules(ctx context.Context, req *api.StudyRulesRe
 					StudyKey:                              studyKey,
 					ParticipantIDForConfidentialResponses: participantID2,
 				}
-				newState, err := studyengine.ActionEval(*rule, actionData, event, studyengine.ActionConfigs{
-					DBService:              s.studyDBservice,
-					ExternalServiceConfigs: s.studyEngineExternalServices,
-				})
+				newState, err := studyengine.ActionEval(*rule, actionData, event, actionConfigs)
+				if err == nil {
+					err = actionConfigs.Budget.Err()
+				}
 				if err != nil {
+					studyengine.CommitRandomizationSlots(s.studyDBservice, instanceID, studyKey, eventDB.ReservedRandomizationSlots(), false)
 					return err
 				}
 
@@ -754,10 +815,12 @@ func (s *studyServiceServer) RunRules(ctx context.Context, req *api.StudyRulesRe
 				}
 				actionData = newState
 			}
+			counters.DBCalls = counters.DBCalls.Add(eventDB.Stats())
 
 			if anyChange {
 				// save state back to DB
-				_, err := s.studyDBservice.SaveParticipantState(instanceID, studyKey, actionData.PState)
+				_, err := s.saveParticipantState(instanceID, studyKey, oldState, actionData.PState, types.PARTICIPANT_STATE_HISTORY_EVENT_CUSTOM_RULES, "", nil)
+				studyengine.CommitRandomizationSlots(s.studyDBservice, instanceID, studyKey, actionData.RandomizationSlots, err == nil)
 				if err != nil {
 					logger.Error.Printf("RunRules: %v", err)
 					return status.Error(codes.Internal, err.Error())
@@ -771,6 +834,7 @@ func (s *studyServiceServer) RunRules(ctx context.Context, req *api.StudyRulesRe
 	if err != nil {
 		logger.Error.Println(err)
 	}
+	logger.Info.Printf("RunRules (%s): %d DB lookups for %d participants, %d from cache", req.StudyKey, counters.DBCalls.DBCalls(), counters.Participants, counters.DBCalls.CachedLookups)
 
 	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_RUN_CUSTOM_RULES, fmt.Sprintf("rules run for study %s: %v", req.StudyKey, req.Rules))
 	resp := api.RuleRunSummary{
@@ -781,6 +845,88 @@ func (s *studyServiceServer) RunRules(ctx context.Context, req *api.StudyRulesRe
 	return &resp, nil
 }
 
+// DryRunRules evaluates the rules for all participants of the study like RunRules, but does not persist anything.
+// The result is streamed for every participant the rules would change (or where the evaluation failed), followed by the summary.
+// External services are not called during a dry run, rules using them report an error instead.
+func (s *studyServiceServer) DryRunRules(req *api.StudyRulesReq, stream api.StudyServiceApi_DryRunRulesServer) error {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return s.missingArgumentError()
+	}
+
+	if !token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		err := s.HasRoleInStudy(req.Token.InstanceId, req.StudyKey, req.Token.Id,
+			[]string{types.STUDY_ROLE_MAINTAINER, types.STUDY_ROLE_OWNER},
+		)
+		if err != nil {
+			s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_RUN_CUSTOM_RULES, fmt.Sprintf("permission denied for running custom rules in study %s  ", req.StudyKey))
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	start := time.Now().Unix()
+
+	summary := &api.RuleRunSummary{
+		ParticipantStateChangePerRule: make([]int32, len(req.Rules)),
+	}
+	dbCalls := studyengine.DBCallStats{}
+
+	// Convert rules from API type:
+	rules := make([]*types.Expression, len(req.Rules))
+	for index, rule := range req.Rules {
+		rules[index] = types.ExpressionFromAPI(rule)
+	}
+
+	err := s.studyDBservice.FindAndExecuteOnParticipantsStates(
+		stream.Context(),
+		req.Token.InstanceId,
+		req.StudyKey,
+		"",
+		func(dbService *studydb.StudyDBService, p types.ParticipantState, instanceID, studyKey string, args ...interface{}) error {
+			if p.StudyStatus == types.PARTICIPANT_STUDY_STATUS_TEMPORARY {
+				// ignore temporary participants
+				return nil
+			}
+
+			summary.ParticipantCount += 1
+
+			participantID2, _, err := s.profileIDToParticipantID(instanceID, studyKey, p.ParticipantID, true)
+			if err != nil {
+				logger.Error.Printf("DryRunRules: %v", err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			event := types.StudyEvent{
+				InstanceID:                            instanceID,
+				StudyKey:                              studyKey,
+				ParticipantIDForConfidentialResponses: participantID2,
+			}
+			result := studyengine.DryRunRules(rules, p, event, studyengine.ActionConfigs{
+				DBService: s.studyDBservice,
+			})
+			dbCalls = dbCalls.Add(result.DBCalls)
+			for index, changed := range result.StateChangedByRule {
+				if changed {
+					summary.ParticipantStateChangePerRule[index] += 1
+				}
+			}
+
+			if !result.HasChanges() && result.Error == "" {
+				return nil
+			}
+			return stream.Send(&api.DryRunRulesResp{Data: &api.DryRunRulesResp_ParticipantResult{ParticipantResult: dryRunResultToAPI(result)}})
+		},
+	)
+	if err != nil {
+		logger.Error.Printf("DryRunRules (%s): %v", req.StudyKey, err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	logger.Info.Printf("DryRunRules (%s): %d DB lookups for %d participants, %d from cache", req.StudyKey, dbCalls.DBCalls(), summary.ParticipantCount, dbCalls.CachedLookups)
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_RUN_CUSTOM_RULES, fmt.Sprintf("rules dry run for study %s: %v", req.StudyKey, req.Rules))
+	summary.Duration = time.Now().Unix() - start
+	return stream.Send(&api.DryRunRulesResp{Data: &api.DryRunRulesResp_Summary{Summary: summary}})
+}
+
 func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, req *api.RunRulesForSingleParticipantReq) (*api.RuleRunSummary, error) {
 	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
@@ -801,6 +947,7 @@ func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, r
 	type Counters struct {
 		Participants                  int32
 		ParticipantStateChangePerRule []int32
+		DBCalls                       studyengine.DBCallStats
 	}
 	counters := &Counters{
 		Participants:                  0,
@@ -813,6 +960,12 @@ func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, r
 		rules[index] = types.ExpressionFromAPI(rule)
 	}
 
+	var tracer *studyengine.EvalTracer
+	if req.TraceRules {
+		tracer = studyengine.NewEvalTracer()
+		tracer.MaxNodes = maxRuleTraceNodes
+	}
+
 	p, err := s.studyDBservice.FindParticipantState(req.Token.InstanceId, req.StudyKey, req.ParticipantId)
 	if err != nil {
 		logger.Debug.Printf("participant not found: %v", err)
@@ -836,11 +989,19 @@ func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, r
 	}
 
 	counters.Participants += 1
+	oldState := studyengine.CopyParticipantState(p)
 	actionData := studyengine.ActionData{
 		PState:          p,
 		ReportsToCreate: map[string]types.Report{},
 	}
 	anyChange := false
+	actionConfigs, eventDB := studyengine.WithEventDBCache(studyengine.ActionConfigs{
+		DBService:              s.studyDBservice,
+		ExternalServiceConfigs: s.studyEngineExternalServices,
+		Tracer:                 tracer,
+	})
+	actionConfigs, cancel := studyengine.WithEvalBudget(ctx, actionConfigs)
+	defer cancel()
 	for index, rule := range rules {
 		if rule == nil {
 			continue
@@ -851,11 +1012,12 @@ func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, r
 			StudyKey:                              req.StudyKey,
 			ParticipantIDForConfidentialResponses: participantID2,
 		}
-		newState, err := studyengine.ActionEval(*rule, actionData, event, studyengine.ActionConfigs{
-			DBService:              s.studyDBservice,
-			ExternalServiceConfigs: s.studyEngineExternalServices,
-		})
+		newState, err := studyengine.ActionEval(*rule, actionData, event, actionConfigs)
+		if err == nil {
+			err = actionConfigs.Budget.Err()
+		}
 		if err != nil {
+			studyengine.CommitRandomizationSlots(s.studyDBservice, req.Token.InstanceId, req.StudyKey, eventDB.ReservedRandomizationSlots(), false)
 			logger.Debug.Printf("unexpected error: %v", err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -866,10 +1028,13 @@ func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, r
 		}
 		actionData = newState
 	}
+	counters.DBCalls = eventDB.Stats()
+	logger.Debug.Printf("RunRulesForSingleParticipant (%s, %s): %d DB lookups, %d from cache", req.StudyKey, req.ParticipantId, counters.DBCalls.DBCalls(), counters.DBCalls.CachedLookups)
 
 	if anyChange {
 		// save state back to DB
-		_, err := s.studyDBservice.SaveParticipantState(req.Token.InstanceId, req.StudyKey, actionData.PState)
+		_, err := s.saveParticipantState(req.Token.InstanceId, req.StudyKey, oldState, actionData.PState, types.PARTICIPANT_STATE_HISTORY_EVENT_CUSTOM_RULES, "", nil)
+		studyengine.CommitRandomizationSlots(s.studyDBservice, req.Token.InstanceId, req.StudyKey, actionData.RandomizationSlots, err == nil)
 		if err != nil {
 			logger.Debug.Printf("unexpected error: %v", err)
 			return nil, status.Error(codes.Internal, err.Error())
@@ -879,10 +1044,13 @@ func (s *studyServiceServer) RunRulesForSingleParticipant(ctx context.Context, r
 	s.saveReports(req.Token.InstanceId, req.StudyKey, actionData.ReportsToCreate, "")
 
 	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_RUN_CUSTOM_RULES, fmt.Sprintf("rules run for study %s: %v", req.StudyKey, req.Rules))
+	rulesTrace, rulesTraceTruncated := ruleTraceToAPI(tracer)
 	resp := api.RuleRunSummary{
 		ParticipantCount:              counters.Participants,
 		ParticipantStateChangePerRule: counters.ParticipantStateChangePerRule,
 		Duration:                      time.Now().Unix() - start,
+		RulesTrace:                    rulesTrace,
+		RulesTraceTruncated:           rulesTraceTruncated,
 	}
 	return &resp, nil
 }
@@ -907,6 +1075,7 @@ func (s *studyServiceServer) DeleteStudy(ctx context.Context, req *api.StudyRefe
 	if err != nil {
 		logger.Warning.Printf("error while deleting study rules for study %s", req.StudyKey)
 	}
+	studyengine.StudyRulesCache.Invalidate(req.Token.InstanceId, req.StudyKey)
 	err = s.studyDBservice.DeleteStudy(req.Token.InstanceId, req.StudyKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -986,3 +1155,139 @@ func (s *studyServiceServer) GetParticipantStatesWithPagination(ctx context.Cont
 	}
 	return resp, nil
 }
+
+// GetRandomizationAllocations returns the allocation table of RANDOMIZE_TO_ARM (participants per randomization key, stratum and arm),
+// for all randomization keys of the study if no randomization key is given.
+func (s *studyServiceServer) GetRandomizationAllocations(ctx context.Context, req *api.GetRandomizationAllocationsReq) (*api.RandomizationAllocations, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	if !token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		err := s.HasRoleInStudy(req.Token.InstanceId, req.StudyKey, req.Token.Id,
+			[]string{types.STUDY_ROLE_MAINTAINER, types.STUDY_ROLE_OWNER},
+		)
+		if err != nil {
+			s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_GET_STUDY, "permission denied for randomization allocations: "+req.StudyKey)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	allocations, err := s.studyDBservice.FindRandomizationAllocations(req.Token.InstanceId, req.StudyKey, req.RandomizationKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.RandomizationAllocations{
+		Allocations: make([]*api.RandomizationAllocation, len(allocations)),
+	}
+	for i, allocation := range allocations {
+		resp.Allocations[i] = allocation.ToAPI()
+	}
+	return resp, nil
+}
+
+// UpdateParticipantStateHistoryConfig enables or disables recording the participant state history of the study and sets its retention period
+func (s *studyServiceServer) UpdateParticipantStateHistoryConfig(ctx context.Context, req *api.ParticipantStateHistoryConfigReq) (*api.Study, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" || req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	config := types.ParticipantStateHistoryConfigFromAPI(req.Config)
+	if config.RetentionDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "retention days must not be negative")
+	}
+
+	if !token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		err := s.HasRoleInStudy(req.Token.InstanceId, req.StudyKey, req.Token.Id,
+			[]string{types.STUDY_ROLE_MAINTAINER, types.STUDY_ROLE_OWNER},
+		)
+		if err != nil {
+			s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_STUDY_UPDATE, "permission denied for updating participant state history config for "+req.StudyKey)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if err := s.studyDBservice.UpdateStudyParticipantStateHistoryConfig(req.Token.InstanceId, req.StudyKey, config); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_STUDY_UPDATE, fmt.Sprintf("participant state history config updated for %s: %+v", req.StudyKey, config))
+
+	study, err := s.studyDBservice.GetStudyByStudyKey(req.Token.InstanceId, req.StudyKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return study.ToAPI(), nil
+}
+
+// UpdateRuleErrorPolicy sets how the study rules continue when a rule fails
+func (s *studyServiceServer) UpdateRuleErrorPolicy(ctx context.Context, req *api.RuleErrorPolicyReq) (*api.Study, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !studyengine.IsValidRuleErrorPolicy(req.RuleErrorPolicy) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown rule error policy: %s", req.RuleErrorPolicy))
+	}
+
+	if !token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		err := s.HasRoleInStudy(req.Token.InstanceId, req.StudyKey, req.Token.Id,
+			[]string{types.STUDY_ROLE_MAINTAINER, types.STUDY_ROLE_OWNER},
+		)
+		if err != nil {
+			s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_STUDY_UPDATE, "permission denied for updating rule error policy for "+req.StudyKey)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if err := s.studyDBservice.UpdateStudyRuleErrorPolicy(req.Token.InstanceId, req.StudyKey, req.RuleErrorPolicy); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_STUDY_UPDATE, fmt.Sprintf("rule error policy updated for %s: %s", req.StudyKey, req.RuleErrorPolicy))
+
+	study, err := s.studyDBservice.GetStudyByStudyKey(req.Token.InstanceId, req.StudyKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return study.ToAPI(), nil
+}
+
+// GetExternalEventOutbox returns the asynchronous external events of the study with the given status (all if empty), for admins
+func (s *studyServiceServer) GetExternalEventOutbox(ctx context.Context, req *api.ExternalEventOutboxQuery) (*api.ExternalEventOutbox, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	if !token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_GET_STUDY, "permission denied for external event outbox: "+req.StudyKey)
+		return nil, status.Error(codes.PermissionDenied, "no permission to access external event outbox")
+	}
+
+	entries, err := s.studyDBservice.FindExternalEvents(req.Token.InstanceId, req.StudyKey, req.Status)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.ExternalEventOutbox{
+		Entries: make([]*api.ExternalEventOutboxEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		resp.Entries[i] = entry.ToAPI()
+	}
+	return resp, nil
+}
+
+// RedriveExternalEvents schedules dead letter events of the study (all if no event ids are given) for delivery again, for admins
+func (s *studyServiceServer) RedriveExternalEvents(ctx context.Context, req *api.RedriveExternalEventsReq) (*api.RedriveExternalEventsResp, error) {
+	if req == nil || token_checks.IsTokenEmpty(req.Token) || req.StudyKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	if !token_checks.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_STUDY_MISC, "permission denied for re-driving external events: "+req.StudyKey)
+		return nil, status.Error(codes.PermissionDenied, "no permission to re-drive external events")
+	}
+
+	count, err := s.studyDBservice.RedriveExternalEvents(req.Token.InstanceId, req.StudyKey, req.EventIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_STUDY_MISC, fmt.Sprintf("%d external events re-driven for %s", count, req.StudyKey))
+	return &api.RedriveExternalEventsResp{Count: count}, nil
+}
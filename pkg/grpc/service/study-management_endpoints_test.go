@@ -1028,6 +1028,50 @@ func TestSaveStudyRulesEndpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("with invalid rules", func(t *testing.T) {
+		_, err := s.SaveStudyRules(context.Background(), &api.StudyRulesReq{
+			Token: &api_types.TokenInfos{
+				Id:         testUserID,
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles":    "PARTICIPANT,RESEARCHER,ADMIN",
+					"username": "testuser",
+				},
+			},
+			StudyKey: testStudyKey,
+			Rules: []*api.Expression{
+				{Name: "test"},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "rules[0]: unknown action 'test'")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with rules as text and expressions", func(t *testing.T) {
+		_, err := s.SaveStudyRules(context.Background(), &api.StudyRulesReq{
+			Token: &api_types.TokenInfos{
+				Id:         testUserID,
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles":    "PARTICIPANT,RESEARCHER,ADMIN",
+					"username": "testuser",
+				},
+			},
+			StudyKey:  testStudyKey,
+			Format:    RULES_FORMAT_TEXT,
+			RulesText: `UPDATE_FLAG("a", "1")`,
+			Rules: []*api.Expression{
+				{Name: "UPDATE_FLAG"},
+			},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "rules must be sent either as text or as expressions")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
 	t.Run("with study member", func(t *testing.T) {
 		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
@@ -1044,7 +1088,7 @@ func TestSaveStudyRulesEndpoint(t *testing.T) {
 			},
 			StudyKey: testStudyKey,
 			Rules: []*api.Expression{
-				{Name: "test"},
+				{Name: "START_NEW_STUDY_SESSION"},
 			},
 		})
 		if err != nil {
@@ -1057,6 +1101,84 @@ func TestSaveStudyRulesEndpoint(t *testing.T) {
 	})
 }
 
+func TestGetCurrentStudyRulesTextEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := studyServiceServer{
+		globalDBService:   testGlobalDBService,
+		studyDBservice:    testStudyDBService,
+		StudyGlobalSecret: "globsecretfortest1234",
+		clients: &types.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testStudyKey := "testStudyfor_getrulestext"
+	testUserID := "testuserid"
+	testStudy := types.Study{
+		Key: testStudyKey,
+		Members: []types.StudyMember{
+			{
+				UserID: testUserID,
+				Role:   "maintainer",
+			},
+		},
+		Rules: []types.Expression{},
+	}
+
+	_, err := testStudyDBService.CreateStudy(testInstanceID, testStudy)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	token := &api_types.TokenInfos{
+		Id:         testUserID,
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles":    "PARTICIPANT,RESEARCHER",
+			"username": "testuser",
+		},
+	}
+	mockLoggingClient.EXPECT().SaveLogEvent(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+	_, err = s.SaveStudyRules(context.Background(), &api.StudyRulesReq{
+		Token:     token,
+		StudyKey:  testStudyKey,
+		Format:    RULES_FORMAT_TEXT,
+		RulesText: `UPDATE_FLAG("a", "1")`,
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	t.Run("with missing request", func(t *testing.T) {
+		_, err := s.GetCurrentStudyRulesText(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with study member", func(t *testing.T) {
+		resp, err := s.GetCurrentStudyRulesText(context.Background(), &api.StudyReferenceReq{
+			Token:    token,
+			StudyKey: testStudyKey,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Rules) != 1 || resp.RulesText != "UPDATE_FLAG(\"a\", \"1\")\n" {
+			t.Errorf("unexpected rules: %v", resp)
+		}
+	})
+}
+
 func TestSaveStudyStatusEndpoint(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1,9 +1,25 @@
 package service
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/api"
+	"github.com/influenzanet/study-service/pkg/studyengine"
+	"github.com/influenzanet/study-service/pkg/types"
 	"github.com/influenzanet/study-service/pkg/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// nodes of a requested evaluation trace, later nodes are not recorded
+	maxRuleTraceNodes = 5000
+	// size of the returned trace, to stay below the message size limit of the clients
+	maxRuleTraceSize = 2 * 1024 * 1024
+	// format of StudyRulesReq for rules in text syntax
+	RULES_FORMAT_TEXT = "text"
 )
 
 func (s *studyServiceServer) HasRoleInStudy(instanceID string, studyKey string, userID string, hasAnyOfRoles []string) error {
@@ -16,3 +32,127 @@ func (s *studyServiceServer) HasRoleInStudy(instanceID string, studyKey string,
 	}
 	return nil
 }
+
+// ruleTraceToAPI converts the recorded evaluation trace. Trailing nodes are dropped if the trace is too large for the response,
+// which is reported with the truncated flag like the node limit of the tracer.
+func ruleTraceToAPI(tracer *studyengine.EvalTracer) (nodes []*api.RuleTraceNode, truncated bool) {
+	if tracer == nil {
+		return nil, false
+	}
+	nodes = make([]*api.RuleTraceNode, len(tracer.Roots))
+	for i, root := range tracer.Roots {
+		nodes[i] = ruleTraceNodeToAPI(root)
+	}
+	truncated = tracer.Truncated
+	size := 0
+	for i, node := range nodes {
+		size += proto.Size(node)
+		if size > maxRuleTraceSize {
+			logger.Warning.Printf("rule trace truncated after %d of %d rules, exceeds %d bytes", i, len(nodes), maxRuleTraceSize)
+			return nodes[:i], true
+		}
+	}
+	return nodes, truncated
+}
+
+func ruleTraceNodeToAPI(node *studyengine.EvalTraceNode) *api.RuleTraceNode {
+	apiNode := &api.RuleTraceNode{
+		Kind:     node.Kind,
+		Name:     node.Name,
+		Args:     make([]*api.RuleTraceValue, len(node.Args)),
+		Error:    node.Error,
+		Children: make([]*api.RuleTraceNode, len(node.Children)),
+	}
+	for i, arg := range node.Args {
+		apiNode.Args[i] = ruleTraceValueToAPI(arg)
+	}
+	if diff, ok := node.Result.(types.ParticipantStateDiff); ok {
+		apiNode.StateDiff = diff.ToAPI()
+	} else if node.Result != nil {
+		apiNode.Result = ruleTraceValueToAPI(node.Result)
+	}
+	for i, child := range node.Children {
+		apiNode.Children[i] = ruleTraceNodeToAPI(child)
+	}
+	return apiNode
+}
+
+func ruleTraceValueToAPI(value interface{}) *api.RuleTraceValue {
+	switch v := value.(type) {
+	case nil:
+		return &api.RuleTraceValue{}
+	case string:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Str{Str: v}}
+	case bool:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Bool{Bool: v}}
+	case float64:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Num{Num: v}}
+	case float32:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Num{Num: float64(v)}}
+	case int:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Num{Num: float64(v)}}
+	case int32:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Num{Num: float64(v)}}
+	case int64:
+		return &api.RuleTraceValue{Value: &api.RuleTraceValue_Num{Num: float64(v)}}
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		logger.Error.Printf("unexpected error when encoding rule trace value: %v", err)
+		return &api.RuleTraceValue{}
+	}
+	return &api.RuleTraceValue{Value: &api.RuleTraceValue_Json{Json: string(encoded)}}
+}
+
+// dryRunResultToAPI converts the changes a rule set would make for one participant
+func dryRunResultToAPI(r studyengine.DryRunResult) *api.DryRunParticipantResult {
+	reports := make([]*api.Report, len(r.ReportsToCreate))
+	for i, report := range r.ReportsToCreate {
+		reports[i] = report.ToAPI()
+	}
+	messages := make([]*api.StudyMessage, len(r.ResearcherMessages))
+	for i, message := range r.ResearcherMessages {
+		messages[i] = message.ToAPI()
+	}
+	externalEvents := make([]*api.ExternalEventOutboxEntry, len(r.ExternalEvents))
+	for i, event := range r.ExternalEvents {
+		externalEvents[i] = event.ToAPI()
+	}
+	return &api.DryRunParticipantResult{
+		ParticipantId:                r.ParticipantID,
+		Diff:                         r.Diff.ToAPI(),
+		ReportsToCreate:              reports,
+		ResearcherMessages:           messages,
+		ConfidentialResponsesRemoved: r.ConfidentialResponsesRemoved,
+		ExternalEvents:               externalEvents,
+		StateChangedByRule:           r.StateChangedByRule,
+		DbCalls: &api.DryRunDBCalls{
+			FindSurveyResponses: int32(r.DBCalls.FindSurveyResponses),
+			FindReports:         int32(r.DBCalls.FindReports),
+			CachedLookups:       int32(r.DBCalls.CachedLookups),
+		},
+		Error: r.Error,
+	}
+}
+
+// getRulesFromRequest returns the rules of the request, parsed from rules_text for the "text" format
+func getRulesFromRequest(req *api.StudyRulesReq) ([]types.Expression, error) {
+	rules := []types.Expression{}
+	if req.Format == RULES_FORMAT_TEXT {
+		if len(req.Rules) > 0 {
+			return nil, errors.New("rules must be sent either as text or as expressions")
+		}
+		parsedRules, err := studyengine.ParseRulesText(req.RulesText)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rules text: %v", err)
+		}
+		return parsedRules, nil
+	}
+	if req.RulesText != "" {
+		return nil, errors.New("rules_text requires the format \"text\"")
+	}
+	for _, exp := range req.Rules {
+		rules = append(rules, *types.ExpressionFromAPI(exp))
+	}
+	return rules, nil
+}
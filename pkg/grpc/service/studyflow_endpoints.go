@@ -64,13 +64,14 @@ func (s *studyServiceServer) EnterStudy(ctx context.Context, req *api.EnterStudy
 		StudyKey:                              req.StudyKey,
 		ParticipantIDForConfidentialResponses: participantID2,
 	}
-	actionResult, err := s.getAndPerformStudyRules(req.Token.InstanceId, req.StudyKey, pState, currentEvent)
+	actionResult, rulesRun, err := s.getAndPerformStudyRules(req.Token.InstanceId, req.StudyKey, pState, currentEvent)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	// save state back to DB
-	pState, err = s.studyDBservice.SaveParticipantState(req.Token.InstanceId, req.StudyKey, actionResult.PState)
+	pState, err = s.saveParticipantState(req.Token.InstanceId, req.StudyKey, pState, actionResult.PState, currentEvent.Type, "", rulesRun)
+	studyengine.CommitRandomizationSlots(s.studyDBservice, req.Token.InstanceId, req.StudyKey, actionResult.RandomizationSlots, err == nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -122,13 +123,14 @@ func (s *studyServiceServer) RegisterTemporaryParticipant(ctx context.Context, r
 		InstanceID: req.InstanceId,
 		StudyKey:   req.StudyKey,
 	}
-	actionResult, err := s.getAndPerformStudyRules(req.InstanceId, req.StudyKey, pState, currentEvent)
+	actionResult, rulesRun, err := s.getAndPerformStudyRules(req.InstanceId, req.StudyKey, pState, currentEvent)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	// save state back to DB
-	_, err = s.studyDBservice.SaveParticipantState(req.InstanceId, req.StudyKey, actionResult.PState)
+	_, err = s.saveParticipantState(req.InstanceId, req.StudyKey, pState, actionResult.PState, currentEvent.Type, "", rulesRun)
+	studyengine.CommitRandomizationSlots(s.studyDBservice, req.InstanceId, req.StudyKey, actionResult.RandomizationSlots, err == nil)
 	if err != nil {
 		logger.Error.Println(err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -185,13 +187,14 @@ func (s *studyServiceServer) ConvertTemporaryToParticipant(ctx context.Context,
 			MergeWithParticipant:                  pState,
 			ParticipantIDForConfidentialResponses: realParticipantID2,
 		}
-		mergeResult, err := s.getAndPerformStudyRules(req.Token.InstanceId, req.StudyKey, existingPState, event)
+		mergeResult, rulesRun, err := s.getAndPerformStudyRules(req.Token.InstanceId, req.StudyKey, existingPState, event)
 		if err != nil {
 			logger.Error.Println(err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		_, err = s.studyDBservice.SaveParticipantState(req.Token.InstanceId, req.StudyKey, mergeResult.PState)
+		_, err = s.saveParticipantState(req.Token.InstanceId, req.StudyKey, existingPState, mergeResult.PState, event.Type, "", rulesRun)
+		studyengine.CommitRandomizationSlots(s.studyDBservice, req.Token.InstanceId, req.StudyKey, mergeResult.RandomizationSlots, err == nil)
 		if err != nil {
 			logger.Error.Println(err)
 			return nil, status.Error(codes.Internal, err.Error())
@@ -202,7 +205,7 @@ func (s *studyServiceServer) ConvertTemporaryToParticipant(ctx context.Context,
 		pState.ParticipantID = realParticipantID
 		pState.StudyStatus = types.PARTICIPANT_STUDY_STATUS_ACTIVE
 
-		_, err = s.studyDBservice.SaveParticipantState(req.Token.InstanceId, req.StudyKey, pState)
+		_, err = s.saveParticipantState(req.Token.InstanceId, req.StudyKey, types.ParticipantState{}, pState, types.PARTICIPANT_STATE_HISTORY_EVENT_CONVERT_TEMPORARY, "", nil)
 		if err != nil {
 			logger.Error.Println(err)
 			return nil, status.Error(codes.Internal, err.Error())
@@ -458,13 +461,14 @@ func (s *studyServiceServer) SubmitResponse(ctx context.Context, req *api.Submit
 		StudyKey:                              req.StudyKey,
 		ParticipantIDForConfidentialResponses: participantID2,
 	}
-	actionResult, err := s.getAndPerformStudyRules(instanceID, req.StudyKey, pState, currentEvent)
+	actionResult, rulesRun, err := s.getAndPerformStudyRules(instanceID, req.StudyKey, pState, currentEvent)
 	if err != nil {
 		logger.Error.Printf("unexpected error_ %v", err)
 	}
 
 	// save state back to DB
-	pState, err = s.studyDBservice.SaveParticipantState(instanceID, req.StudyKey, actionResult.PState)
+	pState, err = s.saveParticipantState(instanceID, req.StudyKey, pState, actionResult.PState, currentEvent.Type, response.Key, rulesRun)
+	studyengine.CommitRandomizationSlots(s.studyDBservice, instanceID, req.StudyKey, actionResult.RandomizationSlots, err == nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -580,7 +584,7 @@ func (s *studyServiceServer) ProfileDeleted(ctx context.Context, req *api_types.
 			StudyKey:                              study.Key,
 			ParticipantIDForConfidentialResponses: participantID2,
 		}
-		actionResult, err := s.getAndPerformStudyRules(instanceID, study.Key, pState, currentEvent)
+		actionResult, rulesRun, err := s.getAndPerformStudyRules(instanceID, study.Key, pState, currentEvent)
 		if err != nil {
 			logger.Error.Printf("unexpected error_ %v", err)
 			continue
@@ -588,7 +592,8 @@ func (s *studyServiceServer) ProfileDeleted(ctx context.Context, req *api_types.
 
 		actionResult.PState.StudyStatus = types.PARTICIPANT_STUDY_STATUS_ACCOUNT_DELETED
 
-		_, err = s.studyDBservice.SaveParticipantState(instanceID, study.Key, actionResult.PState)
+		_, err = s.saveParticipantState(instanceID, study.Key, pState, actionResult.PState, currentEvent.Type, "", rulesRun)
+		studyengine.CommitRandomizationSlots(s.studyDBservice, instanceID, study.Key, actionResult.RandomizationSlots, err == nil)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -643,12 +648,13 @@ func (s *studyServiceServer) LeaveStudy(ctx context.Context, req *api.LeaveStudy
 		StudyKey:                              req.StudyKey,
 		ParticipantIDForConfidentialResponses: participantID2,
 	}
-	actionResult, err := s.getAndPerformStudyRules(req.Token.InstanceId, req.StudyKey, pState, currentEvent)
+	actionResult, rulesRun, err := s.getAndPerformStudyRules(req.Token.InstanceId, req.StudyKey, pState, currentEvent)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	_, err = s.studyDBservice.SaveParticipantState(req.Token.InstanceId, req.StudyKey, actionResult.PState)
+	_, err = s.saveParticipantState(req.Token.InstanceId, req.StudyKey, pState, actionResult.PState, currentEvent.Type, "", rulesRun)
+	studyengine.CommitRandomizationSlots(s.studyDBservice, req.Token.InstanceId, req.StudyKey, actionResult.RandomizationSlots, err == nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
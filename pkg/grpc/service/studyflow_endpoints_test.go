@@ -138,7 +138,7 @@ func TestGetAndPerformStudyRules(t *testing.T) {
 			Type: "ENTER",
 		}
 
-		actionResult, err := s.getAndPerformStudyRules(testInstanceID, testStudy.Key, pState, testEvent)
+		actionResult, _, err := s.getAndPerformStudyRules(testInstanceID, testStudy.Key, pState, testEvent)
 		if err != nil {
 			t.Errorf("unexpected error: %s", err.Error())
 			return
@@ -160,7 +160,7 @@ func TestGetAndPerformStudyRules(t *testing.T) {
 			},
 		}
 
-		actionResult, err := s.getAndPerformStudyRules(testInstanceID, testStudy.Key, pState, testEvent)
+		actionResult, _, err := s.getAndPerformStudyRules(testInstanceID, testStudy.Key, pState, testEvent)
 		if err != nil {
 			t.Errorf("unexpected error: %s", err.Error())
 			return
@@ -48,27 +48,81 @@ func (s *studyServiceServer) checkIfParticipantExists(instanceID string, studyKe
 	return err == nil
 }
 
-func (s *studyServiceServer) getAndPerformStudyRules(instanceID string, studyKey string, pState types.ParticipantState, event types.StudyEvent) (newState studyengine.ActionData, err error) {
+// studyRulesRun is what was loaded to perform the study rules of an event, reused when the resulting state is saved
+type studyRulesRun struct {
+	studyConfigs   types.StudyConfigs
+	rulesVersionID string
+}
+
+func (s *studyServiceServer) getAndPerformStudyRules(instanceID string, studyKey string, pState types.ParticipantState, event types.StudyEvent) (newState studyengine.ActionData, run *studyRulesRun, err error) {
 	newState = studyengine.ActionData{
 		PState:          pState,
 		ReportsToCreate: map[string]types.Report{},
 	}
 
-	rules, err := s.studyDBservice.GetStudyRules(instanceID, studyKey)
+	rules, err := studyengine.StudyRulesCache.GetStudyRules(s.studyDBservice, instanceID, studyKey)
 	if err != nil {
 		return
 	}
-	for _, rule := range rules {
-		newState, err = studyengine.ActionEval(rule, newState, event, studyengine.ActionConfigs{
-			DBService:              s.studyDBservice,
-			ExternalServiceConfigs: s.studyEngineExternalServices,
-		})
+	studyConfigs, err := s.studyDBservice.GetStudyConfigs(instanceID, studyKey)
+	if err != nil {
+		return
+	}
+	run = &studyRulesRun{
+		studyConfigs:   studyConfigs,
+		rulesVersionID: rules.VersionID,
+	}
+	policy := studyConfigs.RuleErrorPolicy
+
+	actionConfigs := studyengine.ActionConfigs{
+		DBService:              s.studyDBservice,
+		ExternalServiceConfigs: s.studyEngineExternalServices,
+	}
+	newState, ruleErrors := studyengine.PerformRules(rules.Rules, newState, event, actionConfigs, policy)
+	if len(ruleErrors) > 0 {
+		studyengine.ReportRuleErrors(ruleErrors, pState.ParticipantID, event, actionConfigs, policy, rules.VersionID)
+		if policy == types.RULE_ERROR_POLICY_ALL_OR_NOTHING {
+			return newState, run, studyengine.RuleErrorsToError(ruleErrors)
+		}
+	}
+	return newState, run, nil
+}
+
+// saveParticipantState saves the new state and records the changes in the participant state history, if enabled for the study.
+// run is the result of getAndPerformStudyRules, if the state was changed by the study rules, otherwise nil.
+func (s *studyServiceServer) saveParticipantState(instanceID string, studyKey string, oldState types.ParticipantState, newState types.ParticipantState, eventType string, surveyKey string, run *studyRulesRun) (types.ParticipantState, error) {
+	savedState, err := s.studyDBservice.SaveParticipantState(instanceID, studyKey, newState)
+	if err != nil {
+		return savedState, err
+	}
+
+	var studyConfigs types.StudyConfigs
+	if run != nil {
+		studyConfigs = run.studyConfigs
+	} else {
+		studyConfigs, err = s.studyDBservice.GetStudyConfigs(instanceID, studyKey)
 		if err != nil {
-			return
+			logger.Error.Printf("unexpected error when reading study configs: %v", err)
+			return savedState, nil
 		}
 	}
+	if !studyConfigs.ParticipantStateHistory.Enabled {
+		return savedState, nil
+	}
 
-	return newState, nil
+	rulesVersionID := ""
+	if run != nil {
+		// no version, if the rules are stored in the study (old model)
+		rulesVersionID = run.rulesVersionID
+	}
+	entry := types.NewParticipantStateHistoryEntry(oldState, newState, eventType, surveyKey, rulesVersionID, time.Now().Unix())
+	if entry.Diff.IsEmpty() {
+		return savedState, nil
+	}
+	if err := s.studyDBservice.AddParticipantStateHistoryEntry(instanceID, studyKey, entry); err != nil {
+		logger.Error.Printf("unexpected error when saving participant state history: %v", err)
+	}
+	return savedState, nil
 }
 
 func (s *studyServiceServer) resolveContextRules(instanceID string, studyKey string, pState types.ParticipantState, rules *types.SurveyContextDef) (sCtx types.SurveyContext, err error) {
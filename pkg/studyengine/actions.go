@@ -17,21 +17,40 @@ import (
 
 type StudyDBService interface {
 	FindSurveyResponses(instanceID string, studyKey string, query studydb.ResponseQuery) (responses []types.SurveyResponse, err error)
+	FindReports(instanceID string, studyKey string, query studydb.ReportQuery) (reports []types.Report, err error)
 	DeleteConfidentialResponses(instanceID string, studyKey string, participantID string, key string) (count int64, err error)
 	SaveResearcherMessage(instanceID string, studyKey string, message types.StudyMessage) error
 }
 
+// ExternalEventOutboxDBService is implemented by DB services supporting asynchronous delivery of external events
+type ExternalEventOutboxDBService interface {
+	AddExternalEventToOutbox(instanceID string, studyKey string, entry types.ExternalEventOutboxEntry) error
+}
+
 type ActionData struct {
-	PState          types.ParticipantState
-	ReportsToCreate map[string]types.Report
+	PState             types.ParticipantState
+	ReportsToCreate    map[string]types.Report
+	RandomizationSlots []types.RandomizationSlot // allocations by RANDOMIZE_TO_ARM, committed after the state is saved
 }
 
 type ActionConfigs struct {
 	DBService              StudyDBService
 	ExternalServiceConfigs []types.ExternalService
+	Tracer                 *EvalTracer            // optional, records the evaluation tree if set
+	Variables              map[string]interface{} // values bound in the current scope (by LET, let or FOREACH), read with getVar
+	Budget                 *EvalBudget            // optional, limits depth, evaluated nodes and time of the evaluation if set
 }
 
 func ActionEval(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	traceNode := configs.Tracer.begin(TRACE_NODE_KIND_ACTION, action.Name)
+	defer func() {
+		configs.Tracer.end(traceNode, actionTraceResult(oldState, newState), err)
+	}()
+	if err = configs.Budget.enter(action.Name); err != nil {
+		return oldState, err
+	}
+	defer configs.Budget.exit()
+
 	if event.Type == "SUBMIT" {
 		oldState, err = updateLastSubmissionForSurvey(oldState, event)
 		if err != nil {
@@ -39,52 +58,10 @@ func ActionEval(action types.Expression, oldState ActionData, event types.StudyE
 		}
 	}
 
-	switch action.Name {
-	case "IF":
-		newState, err = ifAction(action, oldState, event, configs)
-	case "DO":
-		newState, err = doAction(action, oldState, event, configs)
-	case "IFTHEN":
-		newState, err = ifThenAction(action, oldState, event, configs)
-	case "UPDATE_STUDY_STATUS":
-		newState, err = updateStudyStatusAction(action, oldState, event, configs)
-	case "START_NEW_STUDY_SESSION":
-		newState, err = startNewStudySession(action, oldState, event, configs)
-	case "UPDATE_FLAG":
-		newState, err = updateFlagAction(action, oldState, event, configs)
-	case "REMOVE_FLAG":
-		newState, err = removeFlagAction(action, oldState, event, configs)
-	case "ADD_NEW_SURVEY":
-		newState, err = addNewSurveyAction(action, oldState, event, configs)
-	case "REMOVE_ALL_SURVEYS":
-		newState, err = removeAllSurveys(action, oldState, event, configs)
-	case "REMOVE_SURVEY_BY_KEY":
-		newState, err = removeSurveyByKey(action, oldState, event, configs)
-	case "REMOVE_SURVEYS_BY_KEY":
-		newState, err = removeSurveysByKey(action, oldState, event, configs)
-	case "ADD_MESSAGE":
-		newState, err = addMessage(action, oldState, event, configs)
-	case "REMOVE_ALL_MESSAGES":
-		newState, err = removeAllMessages(action, oldState, event, configs)
-	case "REMOVE_MESSAGES_BY_TYPE":
-		newState, err = removeMessagesByType(action, oldState, event, configs)
-	case "NOTIFY_RESEARCHER":
-		newState, err = notifyResearcher(action, oldState, event, configs)
-	case "INIT_REPORT":
-		newState, err = initReport(action, oldState, event, configs)
-	case "UPDATE_REPORT_DATA":
-		newState, err = updateReportData(action, oldState, event, configs)
-	case "REMOVE_REPORT_DATA":
-		newState, err = removeReportData(action, oldState, event, configs)
-	case "CANCEL_REPORT":
-		newState, err = cancelReport(action, oldState, event, configs)
-	case "REMOVE_CONFIDENTIAL_RESPONSE_BY_KEY":
-		newState, err = removeConfidentialResponseByKey(action, oldState, event, configs)
-	case "REMOVE_ALL_CONFIDENTIAL_RESPONSES":
-		newState, err = removeAllConfidentialResponses(action, oldState, event, configs)
-	case "EXTERNAL_EVENT_HANDLER":
-		newState, err = externalEventHandler(action, oldState, event, configs)
-	default:
+	handler, ok := compiledActionHandler(action)
+	if ok {
+		newState, err = handler(action, oldState, event, configs)
+	} else {
 		newState = oldState
 		err = errors.New("action name not known")
 	}
@@ -869,15 +846,19 @@ func externalEventHandler(action types.Expression, oldState ActionData, event ty
 		return newState, err
 	}
 
+	route := ""
 	if len(action.Data) > 1 {
 		arg1, err := EvalContext.expressionArgResolver(action.Data[1])
 		if err != nil {
 			return newState, err
 		}
 
-		route := arg1.(string)
+		route = arg1.(string)
 		route = strings.TrimPrefix(route, "/")
-		serviceConfig.URL = fmt.Sprintf("%s/%s", serviceConfig.URL, route)
+	}
+	fallback, err := EvalContext.externalServiceFallbackArg(action.Data, EXTERNAL_SERVICE_FALLBACK_SKIP)
+	if err != nil {
+		return newState, err
 	}
 
 	payload := ExternalEventPayload{
@@ -887,33 +868,64 @@ func externalEventHandler(action types.Expression, oldState ActionData, event ty
 		InstanceID:       event.InstanceID,
 		Response:         event.Response,
 	}
-	clientConf := ClientConfig{
-		APIKey:     serviceConfig.APIKey,
-		Timeout:    time.Duration(serviceConfig.Timeout) * time.Second,
-		mTLSConfig: serviceConfig.MutualTLSConfig,
+
+	if serviceConfig.Async {
+		// fire-and-forget: delivered by the background worker, the reply cannot update the state
+		dbService, ok := configs.DBService.(ExternalEventOutboxDBService)
+		if !ok {
+			return newState, errors.New("external event outbox is not supported by the DB service")
+		}
+		err = dbService.AddExternalEventToOutbox(event.InstanceID, event.StudyKey, types.ExternalEventOutboxEntry{
+			ServiceName: serviceName,
+			Route:       route,
+			Payload:     payload,
+			CreatedAt:   Now().Unix(),
+		})
+		if err != nil {
+			logger.Error.Printf("error when adding event for '%s' to the outbox: %v", serviceName, err)
+		}
+		return newState, err
 	}
-	response, err := runHTTPcall(serviceConfig.URL, payload, clientConf)
+
+	response, err := callExternalService(configs.Budget.Context(), serviceConfig, route, payload, externalServiceHandleEvent)
 	if err != nil {
 		logger.Error.Printf("error when handling response for '%s': %v", serviceName, err)
+		if budgetErr := configs.Budget.Err(); budgetErr != nil {
+			// the fallback does not apply when the evaluation is aborted
+			return newState, budgetErr
+		}
+		if fallback == EXTERNAL_SERVICE_FALLBACK_SKIP {
+			return newState, nil
+		}
 		return newState, err
 	}
 
 	logger.Debug.Printf("%s replied: %v", serviceName, response)
 
 	// if relevant, update participant state:
-	pState, hasKey := response["pState"]
-	if hasKey {
-		newState.PState = pState.(types.ParticipantState)
+	if response.PState != nil {
+		pState := *response.PState
+		if pState.ID.IsZero() {
+			pState.ID = newState.PState.ID
+		}
+		newState.PState = pState
 		logger.Debug.Printf("updated participant state from external service")
 	}
 
 	// collect reports if any:
-	reportsToCreate, hasKey := response["reportsToCreate"]
-	if hasKey {
-		reportsToCreate := reportsToCreate.(map[string]types.Report)
-		for key, value := range reportsToCreate {
-			newState.ReportsToCreate[key] = value
+	if len(response.ReportsToCreate) > 0 {
+		reports := make(map[string]types.Report, len(oldState.ReportsToCreate)+len(response.ReportsToCreate))
+		for key, value := range oldState.ReportsToCreate {
+			reports[key] = value
+		}
+		for key, value := range response.ReportsToCreate {
+			value.ParticipantID = oldState.PState.ParticipantID
+			if value.Timestamp == 0 {
+				value.Timestamp = Now().Unix()
+			}
+			reports[key] = value
 		}
+		newState.ReportsToCreate = reports
 		logger.Debug.Printf("updated reports list from external service")
 	}
 	return
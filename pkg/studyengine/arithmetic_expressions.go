@@ -0,0 +1,166 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	// upper limit for the decimals of round, beyond float64 precision
+	maxRoundDecimals = 15
+)
+
+// numericValue accepts numbers and strings containing a number (like parseValueAsNum), other values return an error
+func numericValue(v interface{}) (float64, error) {
+	switch value := v.(type) {
+	case float64:
+		return value, nil
+	case string:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("'%s' is not a number", value)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("value of type %T is not a number", v)
+	}
+}
+
+// numericArgs resolves all arguments as numbers, the error names the first argument that is not numeric
+func (ctx EvalContext) numericArgs(args []types.ExpressionArg) ([]float64, error) {
+	values := make([]float64, len(args))
+	for i, arg := range args {
+		v, err := ctx.expressionArgResolver(arg)
+		if err != nil {
+			return nil, err
+		}
+		values[i], err = numericValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %v", i+1, err)
+		}
+	}
+	return values, nil
+}
+
+func (ctx EvalContext) mul(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) < 2 {
+		return val, errors.New("should have at least two arguments")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	val = 1
+	for _, v := range values {
+		val *= v
+	}
+	return val, nil
+}
+
+// div returns an error for a division by zero
+func (ctx EvalContext) div(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 2 {
+		return val, errors.New("should have two arguments")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	if values[1] == 0 {
+		return val, errors.New("division by zero")
+	}
+	return values[0] / values[1], nil
+}
+
+// mod returns the remainder of the division, with the sign of the dividend. Returns an error for a division by zero.
+func (ctx EvalContext) mod(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 2 {
+		return val, errors.New("should have two arguments")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	if values[1] == 0 {
+		return val, errors.New("division by zero")
+	}
+	return math.Mod(values[0], values[1]), nil
+}
+
+func (ctx EvalContext) min(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) < 1 {
+		return val, errors.New("should have at least one argument")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	val = values[0]
+	for _, v := range values[1:] {
+		val = math.Min(val, v)
+	}
+	return val, nil
+}
+
+func (ctx EvalContext) max(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) < 1 {
+		return val, errors.New("should have at least one argument")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	val = values[0]
+	for _, v := range values[1:] {
+		val = math.Max(val, v)
+	}
+	return val, nil
+}
+
+// round rounds half away from zero, to the optional number of decimals (default 0)
+func (ctx EvalContext) round(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 1 && len(exp.Data) != 2 {
+		return val, errors.New("should have one or two arguments")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	if len(values) == 1 {
+		return math.Round(values[0]), nil
+	}
+	decimals := values[1]
+	if decimals < 0 || decimals > maxRoundDecimals || decimals != math.Trunc(decimals) {
+		return val, fmt.Errorf("decimals should be an integer between 0 and %d", maxRoundDecimals)
+	}
+	factor := math.Pow(10, decimals)
+	return math.Round(values[0]*factor) / factor, nil
+}
+
+// unaryMathFunction returns the result of fn for the single numeric argument
+func (ctx EvalContext) unaryMathFunction(exp types.Expression, fn func(float64) float64) (val float64, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("should have one argument")
+	}
+	values, err := ctx.numericArgs(exp.Data)
+	if err != nil {
+		return val, err
+	}
+	return fn(values[0]), nil
+}
+
+func (ctx EvalContext) floor(exp types.Expression) (val float64, err error) {
+	return ctx.unaryMathFunction(exp, math.Floor)
+}
+
+func (ctx EvalContext) ceil(exp types.Expression) (val float64, err error) {
+	return ctx.unaryMathFunction(exp, math.Ceil)
+}
+
+func (ctx EvalContext) abs(exp types.Expression) (val float64, err error) {
+	return ctx.unaryMathFunction(exp, math.Abs)
+}
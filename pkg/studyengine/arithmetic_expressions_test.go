@@ -0,0 +1,76 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestArithmeticExpressions(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: name, Data: args}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected float64
+	}{
+		{name: "mul", exp: exp("mul", num(2), num(3), num(-1.5)), expected: -9},
+		{name: "mul with numeric string", exp: exp("mul", str("2.5"), num(4)), expected: 10},
+		{name: "div", exp: exp("div", num(7), num(2)), expected: 3.5},
+		{name: "mod", exp: exp("mod", num(7), num(3)), expected: 1},
+		{name: "mod negative dividend", exp: exp("mod", num(-7), num(3)), expected: -1},
+		{name: "min", exp: exp("min", num(3), str("-1"), num(2)), expected: -1},
+		{name: "max", exp: exp("max", num(3), num(-1), num(2)), expected: 3},
+		{name: "max single", exp: exp("max", num(3)), expected: 3},
+		{name: "round", exp: exp("round", num(2.5)), expected: 3},
+		{name: "round negative half", exp: exp("round", num(-2.5)), expected: -3},
+		{name: "round decimals", exp: exp("round", num(2.345), num(2)), expected: 2.35},
+		{name: "floor", exp: exp("floor", num(-1.5)), expected: -2},
+		{name: "ceil", exp: exp("ceil", num(1.2)), expected: 2},
+		{name: "abs", exp: exp("abs", num(-4)), expected: 4},
+		{name: "averaged Likert scale", exp: exp("round", types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "div", Data: []types.ExpressionArg{
+			{DType: "exp", Exp: &types.Expression{Name: "sum", Data: []types.ExpressionArg{num(4), num(2), num(5)}}},
+			num(3),
+		}}}, num(1)), expected: 3.7},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if errs := ValidateExpression(tc.exp, "exp"); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+			val, err := ExpressionEval(tc.exp, EvalContext{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val.(float64) != tc.expected {
+				t.Errorf("unexpected value: %v, expected %v", val, tc.expected)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		exp  types.Expression
+	}{
+		{name: "division by zero", exp: exp("div", num(1), num(0))},
+		{name: "mod by zero", exp: exp("mod", num(1), str("0"))},
+		{name: "non-numeric string", exp: exp("mul", num(2), str("two"))},
+		{name: "boolean", exp: exp("abs", types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "not", Data: []types.ExpressionArg{num(0)}}})},
+		{name: "negative decimals", exp: exp("round", num(1.5), num(-1))},
+		{name: "fractional decimals", exp: exp("round", num(1.5), num(0.5))},
+		{name: "min without arguments", exp: exp("min")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ExpressionEval(tc.exp, EvalContext{}); err == nil {
+				t.Error("should return an error")
+			}
+		})
+	}
+}
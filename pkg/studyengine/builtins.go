@@ -0,0 +1,871 @@
+package studyengine
+
+import (
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	CATEGORY_CONTROL_FLOW      = "Control flow"
+	CATEGORY_PARTICIPANT_STATE = "Participant state"
+	CATEGORY_SURVEYS           = "Surveys"
+	CATEGORY_MESSAGES          = "Messages"
+	CATEGORY_REPORTS           = "Reports"
+	CATEGORY_RESPONSES         = "Responses"
+	CATEGORY_EXTERNAL_SERVICES = "External services"
+	CATEGORY_SCHEDULED_ACTIONS = "Scheduled actions"
+	CATEGORY_RANDOMIZATION     = "Randomization"
+	CATEGORY_EVENT             = "Event"
+	CATEGORY_OLD_RESPONSES     = "Old responses"
+	CATEGORY_INCOMING_STATE    = "Incoming participant state"
+	CATEGORY_LOGICAL           = "Logical and comparisons"
+	CATEGORY_ARITHMETICS       = "Arithmetics"
+	CATEGORY_TIME              = "Time"
+	CATEGORY_VARIABLES         = "Variables"
+	CATEGORY_STRINGS           = "Strings"
+	CATEGORY_LISTS             = "Lists"
+	CATEGORY_OTHER             = "Other"
+)
+
+func init() {
+	registerBuiltinActions()
+	registerBuiltinExpressions()
+}
+
+func registerBuiltinActions() {
+	// Control flow:
+	mustRegisterAction("IF", ifAction, Metadata{
+		Category:    CATEGORY_CONTROL_FLOW,
+		Description: "Performs the first action if the condition is true, otherwise the optional second action.",
+		ArgNames:    []string{"condition", "action", "actionElse"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 3, Args: []string{ARG_TYPE_CONDITION, ARG_TYPE_ACTION, ARG_TYPE_ACTION}},
+	})
+	mustRegisterAction("DO", doAction, Metadata{
+		Category:    CATEGORY_CONTROL_FLOW,
+		Description: "Performs a list of actions in order.",
+		ArgNames:    []string{"actions"},
+		Signature:   &Signature{MinArgs: 0, MaxArgs: -1, VarArgs: ARG_TYPE_ACTION_ANY},
+	})
+	mustRegisterAction("IFTHEN", ifThenAction, Metadata{
+		Category:    CATEGORY_CONTROL_FLOW,
+		Description: "Performs a list of actions in order if the condition is true.",
+		ArgNames:    []string{"condition", "actions"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: -1, Args: []string{ARG_TYPE_CONDITION}, VarArgs: ARG_TYPE_ACTION_ANY},
+	})
+	mustRegisterAction("FOREACH", forEachAction, Metadata{
+		Category:    CATEGORY_CONTROL_FLOW,
+		Description: "Performs the actions for every item of a semicolon separated list (e.g. from `getSelectedKeys`), the current item can be read with `getVar(varName)`.",
+		ArgNames:    []string{"varName", "list", "actions"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: -1, Args: []string{ARG_TYPE_STR_LITERAL, ARG_TYPE_STR}, VarArgs: ARG_TYPE_ACTION},
+	})
+	mustRegisterAction("LET", letAction, Metadata{
+		Category:    CATEGORY_CONTROL_FLOW,
+		Description: "Evaluates the value once and performs the actions with the value bound to the variable, readable with `getVar(varName)`.",
+		ArgNames:    []string{"varName", "value", "actions"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: -1, Args: []string{ARG_TYPE_STR_LITERAL, ARG_TYPE_ANY}, VarArgs: ARG_TYPE_ACTION},
+	})
+
+	// Participant state:
+	mustRegisterAction("UPDATE_STUDY_STATUS", updateStudyStatusAction, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Sets the study status of the participant.",
+		ArgNames:    []string{"status"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterAction("START_NEW_STUDY_SESSION", startNewStudySession, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Generates a new study session ID for the participant.",
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 0},
+	})
+	mustRegisterAction("UPDATE_FLAG", updateFlagAction, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Sets the participant flag with the given key. Numbers are stored as string.",
+		ArgNames:    []string{"key", "value"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_ANY}},
+	})
+	mustRegisterAction("REMOVE_FLAG", removeFlagAction, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Removes the participant flag with the given key.",
+		ArgNames:    []string{"key"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+
+	// Randomization:
+	mustRegisterAction("RANDOMIZE_TO_ARM", randomizeToArm, Metadata{
+		Category:    CATEGORY_RANDOMIZATION,
+		Description: "Allocates the participant to an arm using block randomization (stratified by the optional strata values) and stores the arm in the participant flag. Allocation counters are kept per study in the DB. Participants with the flag already set are not randomized again.",
+		ArgNames:    []string{"randomizationKey", "flagKey", "arms", "blockSize", "strata"},
+		Signature:   &Signature{MinArgs: 4, MaxArgs: -1, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_NUM}, VarArgs: ARG_TYPE_ANY},
+	})
+
+	// Surveys:
+	mustRegisterAction("ADD_NEW_SURVEY", addNewSurveyAction, Metadata{
+		Category:    CATEGORY_SURVEYS,
+		Description: "Assigns a survey to the participant. Use 0 for no start or end limit.",
+		ArgNames:    []string{"surveyKey", "validFrom", "validUntil", "category"},
+		Signature:   &Signature{MinArgs: 4, MaxArgs: 4, Args: []string{ARG_TYPE_STR, ARG_TYPE_NUM, ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterAction("REMOVE_ALL_SURVEYS", removeAllSurveys, Metadata{
+		Category:    CATEGORY_SURVEYS,
+		Description: "Removes all assigned surveys of the participant.",
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 0},
+	})
+	mustRegisterAction("REMOVE_SURVEY_BY_KEY", removeSurveyByKey, Metadata{
+		Category:    CATEGORY_SURVEYS,
+		Description: "Removes the first or last assigned survey with the given key.",
+		ArgNames:    []string{"surveyKey", "firstOrLast"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterAction("REMOVE_SURVEYS_BY_KEY", removeSurveysByKey, Metadata{
+		Category:    CATEGORY_SURVEYS,
+		Description: "Removes all assigned surveys with the given key.",
+		ArgNames:    []string{"surveyKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+
+	// Messages:
+	mustRegisterAction("ADD_MESSAGE", addMessage, Metadata{
+		Category:    CATEGORY_MESSAGES,
+		Description: "Schedules a message of the given type for the participant.",
+		ArgNames:    []string{"messageType", "timestamp"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_NUM}},
+	})
+	mustRegisterAction("REMOVE_ALL_MESSAGES", removeAllMessages, Metadata{
+		Category:    CATEGORY_MESSAGES,
+		Description: "Removes all scheduled messages of the participant.",
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 0},
+	})
+	mustRegisterAction("REMOVE_MESSAGES_BY_TYPE", removeMessagesByType, Metadata{
+		Category:    CATEGORY_MESSAGES,
+		Description: "Removes all scheduled messages with the given type.",
+		ArgNames:    []string{"messageType"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterAction("NOTIFY_RESEARCHER", notifyResearcher, Metadata{
+		Category:    CATEGORY_MESSAGES,
+		Description: "Saves a message for the researchers of the study. Additional arguments are key-value pairs for the payload.",
+		ArgNames:    []string{"messageType", "payload"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: -1, Args: []string{ARG_TYPE_STR}, VarArgs: ARG_TYPE_STR},
+	})
+
+	// Reports:
+	mustRegisterAction("INIT_REPORT", initReport, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Creates an empty report with the given key for the current event.",
+		ArgNames:    []string{"reportKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterAction("UPDATE_REPORT_DATA", updateReportData, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Sets a data entry of the report, the report is initialised if needed.",
+		ArgNames:    []string{"reportKey", "attributeKey", "value", "dtype"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: 4, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_ANY, ARG_TYPE_STR}},
+	})
+	mustRegisterAction("REMOVE_REPORT_DATA", removeReportData, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Removes a data entry of the report.",
+		ArgNames:    []string{"reportKey", "attributeKey"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterAction("CANCEL_REPORT", cancelReport, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Removes the report with the given key from the reports to be created.",
+		ArgNames:    []string{"reportKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+
+	// Responses:
+	mustRegisterAction("REMOVE_CONFIDENTIAL_RESPONSE_BY_KEY", removeConfidentialResponseByKey, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Deletes the confidential responses of the participant with the given key.",
+		ArgNames:    []string{"responseKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterAction("REMOVE_ALL_CONFIDENTIAL_RESPONSES", removeAllConfidentialResponses, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Deletes all confidential responses of the participant.",
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 0},
+	})
+
+	// Scheduled actions:
+	mustRegisterAction("SCHEDULE_ACTIONS", scheduleActions, Metadata{
+		Category:    CATEGORY_SCHEDULED_ACTIONS,
+		Description: "Stores the actions on the participant state. They are performed by the timer once executeAt is reached. Use an empty label if the actions should not be cancellable.",
+		ArgNames:    []string{"executeAt", "label", "actions"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: -1, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}, VarArgs: ARG_TYPE_ACTION},
+	})
+	mustRegisterAction("CANCEL_SCHEDULED_ACTIONS", cancelScheduledActions, Metadata{
+		Category:    CATEGORY_SCHEDULED_ACTIONS,
+		Description: "Removes all pending scheduled actions with the given label.",
+		ArgNames:    []string{"label"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+
+	// External services:
+	mustRegisterAction("EXTERNAL_EVENT_HANDLER", externalEventHandler, Metadata{
+		Category:    CATEGORY_EXTERNAL_SERVICES,
+		Description: "Sends the event to a configured external service and applies the returned changes. For services configured as async, the event is queued in the outbox and delivered in the background. With fallback \"skip\", a failing call (or open circuit breaker) leaves the state unchanged instead of failing the rule.",
+		ArgNames:    []string{"serviceName", "route", "fallback"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 3, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_STR_LITERAL}},
+	})
+}
+
+// participantStateExpression is evaluated on the current or the incoming participant state
+type participantStateExpression func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error)
+
+// registerParticipantStateExpression registers the expression for the current state and with the "incomingState:" prefix
+func registerParticipantStateExpression(name string, handler participantStateExpression, meta Metadata) {
+	mustRegisterExpression(name, func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return handler(ctx, exp, false)
+	}, meta)
+
+	incomingMeta := meta
+	incomingMeta.Category = CATEGORY_INCOMING_STATE
+	incomingMeta.Description = "Same as `" + name + "`, but evaluated on the incoming participant state when merging participant states."
+	mustRegisterExpression("incomingState:"+name, func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return handler(ctx, exp, true)
+	}, incomingMeta)
+}
+
+func registerBuiltinExpressions() {
+	mustRegisterExpression("checkEventType", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.checkEventType(exp)
+	}, Metadata{
+		Category:    CATEGORY_EVENT,
+		Description: "Checks if the type of the current event equals the argument.",
+		ArgNames:    []string{"eventType"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+
+	// Response checkers:
+	mustRegisterExpression("checkSurveyResponseKey", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.checkSurveyResponseKey(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Checks if the submitted survey has the given key.",
+		ArgNames:    []string{"surveyKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("responseHasKeysAny", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.responseHasKeysAny(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Checks if any of the given keys is selected in the response group of the item.",
+		ArgNames:    []string{"itemKey", "responseGroupKey", "keys"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: -1, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}, VarArgs: ARG_TYPE_STR},
+	})
+	mustRegisterExpression("responseHasOnlyKeysOtherThan", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.responseHasOnlyKeysOtherThan(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Checks if the response group of the item has selections, but none of the given keys.",
+		ArgNames:    []string{"itemKey", "responseGroupKey", "keys"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: -1, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}, VarArgs: ARG_TYPE_STR},
+	})
+	mustRegisterExpression("getResponseValueAsNum", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getResponseValueAsNum(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Returns the value of the response slot as number.",
+		ArgNames:    []string{"itemKey", "responseSlotKey"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("getResponseValueAsStr", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getResponseValueAsStr(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Returns the value of the response slot as string.",
+		ArgNames:    []string{"itemKey", "responseSlotKey"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("getSelectedKeys", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getSelectedKeys(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Returns the selected keys of the response group as a semicolon separated string.",
+		ArgNames:    []string{"itemKey", "responseGroupKey"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("countResponseItems", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.countResponseItems(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Returns the number of selected items in the response group.",
+		ArgNames:    []string{"itemKey", "responseGroupKey"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("hasResponseKey", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.hasResponseKey(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Checks if the response slot exists in the response of the item.",
+		ArgNames:    []string{"itemKey", "responseSlotKey"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("hasResponseKeyWithValue", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.hasResponseKeyWithValue(exp)
+	}, Metadata{
+		Category:    CATEGORY_RESPONSES,
+		Description: "Checks if the response slot exists and has the given value.",
+		ArgNames:    []string{"itemKey", "responseSlotKey", "value"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: 3, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+
+	// Old responses:
+	mustRegisterExpression("checkConditionForOldResponses", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.checkConditionForOldResponses(exp)
+	}, Metadata{
+		Category:    CATEGORY_OLD_RESPONSES,
+		Description: "Evaluates the condition on previous responses of the participant. Checks if it is true for \"all\", \"any\" or at least the given number of responses.",
+		ArgNames:    []string{"condition", "checkType", "surveyKey", "since", "until"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 5, Args: []string{ARG_TYPE_EXP, ARG_TYPE_ANY, ARG_TYPE_STR, ARG_TYPE_NUM, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("aggregateOldResponses", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.aggregateOldResponses(exp)
+	}, Metadata{
+		Category:    CATEGORY_OLD_RESPONSES,
+		Description: "Evaluates the value expression (e.g. `getResponseValueAsNum`) on previous responses of the participant to the survey and returns their \"sum\", \"avg\", \"min\", \"max\", \"first\", \"last\" or \"count\". Responses without a numeric value are skipped. Optionally limited to a time window and to the most recent responses.",
+		ArgNames:    []string{"aggregation", "value", "surveyKey", "since", "until", "lastN"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: 6, Args: []string{ARG_TYPE_STR_LITERAL, ARG_TYPE_EXP, ARG_TYPE_STR, ARG_TYPE_NUM, ARG_TYPE_NUM, ARG_TYPE_NUM}},
+	})
+
+	// Participant state:
+	registerParticipantStateExpression("getStudyEntryTime", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.getStudyEntryTime(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the timestamp when the participant entered the study.",
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 0},
+	})
+	registerParticipantStateExpression("hasSurveyKeyAssigned", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.hasSurveyKeyAssigned(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Checks if a survey with the given key is assigned to the participant.",
+		ArgNames:    []string{"surveyKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR_LITERAL}},
+	})
+	registerParticipantStateExpression("getSurveyKeyAssignedFrom", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.getSurveyKeyAssignedFrom(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the start of the validity of the assigned survey, -1 if not assigned.",
+		ArgNames:    []string{"surveyKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR_LITERAL}},
+	})
+	registerParticipantStateExpression("getSurveyKeyAssignedUntil", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.getSurveyKeyAssignedUntil(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the end of the validity of the assigned survey, -1 if not assigned.",
+		ArgNames:    []string{"surveyKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR_LITERAL}},
+	})
+	registerParticipantStateExpression("hasStudyStatus", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.hasStudyStatus(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Checks if the participant has the given study status.",
+		ArgNames:    []string{"status"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("hasParticipantFlag", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.hasParticipantFlag(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Checks if the participant flag with the given key has the given value.",
+		ArgNames:    []string{"key", "value"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("hasParticipantFlagKey", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.hasParticipantFlagKey(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Checks if the participant has a flag with the given key.",
+		ArgNames:    []string{"key"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("getParticipantFlagValue", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.getParticipantFlagValue(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the value of the participant flag with the given key.",
+		ArgNames:    []string{"key"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("getParticipantFlagKeys", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.getParticipantFlagKeys(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the sorted keys of the participant flags starting with the optional prefix as a semicolon separated string.",
+		ArgNames:    []string{"prefix"},
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("getLastSubmissionDate", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getLastSubmissionDate(exp, false)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the timestamp of the last submission of the survey, or of any survey if no key is given.",
+		ArgNames:    []string{"surveyKey"},
+		Signature:   &Signature{MinArgs: 0, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("lastSubmissionDateOlderThan", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.lastSubmissionDateOlderThan(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Checks if the last submission of the survey (or of any survey) is older than the reference timestamp.",
+		ArgNames:    []string{"referenceTime", "surveyKey"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("hasMessageTypeAssigned", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.hasMessageTypeAssigned(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Checks if a message of the given type is scheduled for the participant.",
+		ArgNames:    []string{"messageType"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	registerParticipantStateExpression("getMessageNextTime", func(ctx EvalContext, exp types.Expression, withIncomingParticipantState bool) (interface{}, error) {
+		return ctx.getMessageNextTime(exp, withIncomingParticipantState)
+	}, Metadata{
+		Category:    CATEGORY_PARTICIPANT_STATE,
+		Description: "Returns the scheduled time of the message with the given type.",
+		ArgNames:    []string{"messageType"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+
+	// Logical and comparisions:
+	comparisons := map[string]ExpressionHandler{
+		"eq": func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return ctx.eq(exp)
+		},
+		"lt": func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return ctx.lt(exp)
+		},
+		"lte": func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return ctx.lte(exp)
+		},
+		"gt": func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return ctx.gt(exp)
+		},
+		"gte": func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return ctx.gte(exp)
+		},
+	}
+	comparisonDescriptions := map[string]string{
+		"eq":  "Checks if both arguments are equal. Both must resolve to the same type (string or number).",
+		"lt":  "Checks if the first argument is less than the second one.",
+		"lte": "Checks if the first argument is less than or equal to the second one.",
+		"gt":  "Checks if the first argument is greater than the second one.",
+		"gte": "Checks if the first argument is greater than or equal to the second one.",
+	}
+	for name, handler := range comparisons {
+		mustRegisterExpression(name, handler, Metadata{
+			Category:    CATEGORY_LOGICAL,
+			Description: comparisonDescriptions[name],
+			ArgNames:    []string{"a", "b"},
+			Signature:   &Signature{MinArgs: 2, MaxArgs: 2},
+		})
+	}
+	mustRegisterExpression("and", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.and(exp)
+	}, Metadata{
+		Category:    CATEGORY_LOGICAL,
+		Description: "True if all conditions are true.",
+		ArgNames:    []string{"conditions"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: -1, VarArgs: ARG_TYPE_CONDITION},
+	})
+	mustRegisterExpression("or", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.or(exp)
+	}, Metadata{
+		Category:    CATEGORY_LOGICAL,
+		Description: "True if any of the conditions is true.",
+		ArgNames:    []string{"conditions"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: -1, VarArgs: ARG_TYPE_CONDITION},
+	})
+	mustRegisterExpression("not", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.not(exp)
+	}, Metadata{
+		Category:    CATEGORY_LOGICAL,
+		Description: "Negates the condition.",
+		ArgNames:    []string{"condition"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_CONDITION}},
+	})
+
+	// Arithmetics operators
+	mustRegisterExpression("sum", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.sum(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the sum of the arguments. True counts as 1, other values are skipped.",
+		ArgNames:    []string{"values"},
+		Signature:   &Signature{MinArgs: 0, MaxArgs: -1},
+	})
+	mustRegisterExpression("neg", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.neg(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the negated value.",
+		ArgNames:    []string{"value"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("mul", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.mul(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the product of the arguments. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"values"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: -1, VarArgs: ARG_TYPE_ANY},
+	})
+	mustRegisterExpression("div", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.div(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the first argument divided by the second, division by zero returns an error. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"dividend", "divisor"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_ANY, ARG_TYPE_ANY}},
+	})
+	mustRegisterExpression("mod", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.mod(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the remainder of the division, with the sign of the dividend. Division by zero returns an error. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"dividend", "divisor"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_ANY, ARG_TYPE_ANY}},
+	})
+	mustRegisterExpression("min", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.min(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the smallest argument. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"values"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: -1, VarArgs: ARG_TYPE_ANY},
+	})
+	mustRegisterExpression("max", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.max(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the largest argument. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"values"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: -1, VarArgs: ARG_TYPE_ANY},
+	})
+	mustRegisterExpression("round", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.round(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Rounds half away from zero to the number of decimals (default 0). Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"value", "decimals"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_ANY, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("floor", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.floor(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the largest integer less than or equal to the value. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"value"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_ANY}},
+	})
+	mustRegisterExpression("ceil", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.ceil(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the smallest integer greater than or equal to the value. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"value"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_ANY}},
+	})
+	mustRegisterExpression("abs", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.abs(exp)
+	}, Metadata{
+		Category:    CATEGORY_ARITHMETICS,
+		Description: "Returns the absolute value. Strings containing a number are parsed like in `parseValueAsNum`, other values return an error.",
+		ArgNames:    []string{"value"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_ANY}},
+	})
+
+	// Strings
+	mustRegisterExpression("concat", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.concat(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Joins the arguments into one string. Numbers and booleans are converted to strings.",
+		ArgNames:    []string{"values"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: -1, VarArgs: ARG_TYPE_ANY},
+	})
+	mustRegisterExpression("substring", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.substring(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Returns the characters from start (0 based), up to the optional length. Indexes beyond the end of the string are clamped.",
+		ArgNames:    []string{"str", "start", "length"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 3, Args: []string{ARG_TYPE_STR, ARG_TYPE_NUM, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("toLower", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.toLower(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Returns the string in lower case.",
+		ArgNames:    []string{"str"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("toUpper", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.toUpper(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Returns the string in upper case.",
+		ArgNames:    []string{"str"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("trim", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.trim(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Returns the string without leading and trailing white space.",
+		ArgNames:    []string{"str"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("strLength", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.strLength(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Returns the number of characters of the string.",
+		ArgNames:    []string{"str"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("regexMatch", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.regexMatch(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Checks if the string contains a match of the regular expression (RE2 syntax, use `^` and `$` to match the whole string).",
+		ArgNames:    []string{"str", "pattern"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("splitAndGet", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.splitAndGet(exp)
+	}, Metadata{
+		Category:    CATEGORY_STRINGS,
+		Description: "Splits the string by the separator and returns the part at the index (0 based, negative counts from the end), or an empty string if the index is out of range.",
+		ArgNames:    []string{"str", "separator", "index"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: 3, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_NUM}},
+	})
+
+	// Reports
+	mustRegisterExpression("getLastReportValue", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getLastReportValue(exp)
+	}, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Returns the value of the data key from the most recent report of the participant with the key containing it (as number for the dtypes `int` and `float`), or an empty string. Only reports saved by previous events are read, optionally only those created after since.",
+		ArgNames:    []string{"reportKey", "dataKey", "since"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 3, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("countReports", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.countReports(exp)
+	}, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Returns the number of reports of the participant with the key. Only reports saved by previous events are read, optionally only those created after since.",
+		ArgNames:    []string{"reportKey", "since"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("hasReportWithValue", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.hasReportWithValue(exp)
+	}, Metadata{
+		Category:    CATEGORY_REPORTS,
+		Description: "Checks if a report of the participant with the key contains the data key with the value (numbers are compared numerically). Only reports saved by previous events are read, optionally only those created after since.",
+		ArgNames:    []string{"reportKey", "dataKey", "value", "since"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: 4, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_ANY, ARG_TYPE_NUM}},
+	})
+
+	// Lists
+	mustRegisterExpression("in", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.in(exp)
+	}, Metadata{
+		Category:    CATEGORY_LISTS,
+		Description: "Checks if the value is one of the following items, each item can be a single value or a list.",
+		ArgNames:    []string{"value", "items"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: -1, VarArgs: ARG_TYPE_ANY},
+	})
+	mustRegisterExpression("listContains", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.listContains(exp)
+	}, Metadata{
+		Category:    CATEGORY_LISTS,
+		Description: "Checks if the list contains the item. Lists are semicolon separated strings, e.g. from `getSelectedKeys` or a flag value.",
+		ArgNames:    []string{"list", "item"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_ANY}},
+	})
+	mustRegisterExpression("listLength", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.listLength(exp)
+	}, Metadata{
+		Category:    CATEGORY_LISTS,
+		Description: "Returns the number of items of the list, empty items are ignored. Lists are semicolon separated strings, e.g. from `getSelectedKeys` or a flag value.",
+		ArgNames:    []string{"list"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("listIntersectionCount", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.listIntersectionCount(exp)
+	}, Metadata{
+		Category:    CATEGORY_LISTS,
+		Description: "Returns the number of distinct items present in both lists, e.g. to check that at least two of a list of symptoms are selected. Lists are semicolon separated strings, e.g. from `getSelectedKeys` or a flag value.",
+		ArgNames:    []string{"list", "otherList"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("anyOf", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.anyOf(exp)
+	}, Metadata{
+		Category:    CATEGORY_LISTS,
+		Description: "Checks if the list contains at least one of the items (single values or lists). Lists are semicolon separated strings, e.g. from `getSelectedKeys` or a flag value.",
+		ArgNames:    []string{"list", "items"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: -1, Args: []string{ARG_TYPE_STR}, VarArgs: ARG_TYPE_ANY},
+	})
+	mustRegisterExpression("allOf", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.allOf(exp)
+	}, Metadata{
+		Category:    CATEGORY_LISTS,
+		Description: "Checks if the list contains all of the items (single values or lists). Lists are semicolon separated strings, e.g. from `getSelectedKeys` or a flag value.",
+		ArgNames:    []string{"list", "items"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: -1, Args: []string{ARG_TYPE_STR}, VarArgs: ARG_TYPE_ANY},
+	})
+
+	// Other
+	mustRegisterExpression("timestampWithOffset", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.timestampWithOffset(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the reference timestamp (default: now) shifted by the offset in seconds.",
+		ArgNames:    []string{"offset", "reference"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("getISOWeekForTs", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getISOWeekForTs(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the ISO week number of the timestamp.",
+		ArgNames:    []string{"timestamp"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("getTsForNextISOWeek", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getTsForNextISOWeek(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the start of the next occurrence of the ISO week after the reference (default: now).",
+		ArgNames:    []string{"isoWeek", "reference"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("getDayOfWeek", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getDayOfWeek(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the ISO day of the week of the timestamp (1 = Monday, 7 = Sunday) in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("getMonth", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getMonth(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the month of the timestamp (1 - 12) in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("getYear", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getYear(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the year of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("startOfDay", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.startOf(exp, CALENDAR_PERIOD_DAY)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the local midnight starting the day of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("endOfDay", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.endOf(exp, CALENDAR_PERIOD_DAY)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the last second of the day of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("startOfWeek", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.startOf(exp, CALENDAR_PERIOD_WEEK)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the local midnight starting the ISO week (starting on Monday) of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("endOfWeek", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.endOf(exp, CALENDAR_PERIOD_WEEK)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the last second of the ISO week (starting on Monday) of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("startOfMonth", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.startOf(exp, CALENDAR_PERIOD_MONTH)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the local midnight starting the month of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("endOfMonth", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.endOf(exp, CALENDAR_PERIOD_MONTH)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the last second of the month of the timestamp in the timezone (IANA name, e.g. `Europe/Paris`, default UTC).",
+		ArgNames:    []string{"timestamp", "timezone"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR}},
+	})
+	mustRegisterExpression("getTsForNextWeekday", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getTsForNextWeekday(exp)
+	}, Metadata{
+		Category:    CATEGORY_TIME,
+		Description: "Returns the timestamp of the next ISO weekday (1 = Monday, 7 = Sunday) at the local time of day (`HH:MM`) in the timezone (default UTC), after the reference (default: now).",
+		ArgNames:    []string{"weekday", "timeOfDay", "timezone", "reference"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 4, Args: []string{ARG_TYPE_NUM, ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("parseValueAsNum", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.parseValueAsNum(exp)
+	}, Metadata{
+		Category:    CATEGORY_OTHER,
+		Description: "Parses the argument as number.",
+		ArgNames:    []string{"value"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1},
+	})
+	mustRegisterExpression("generateRandomNumber", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.generateRandomNumber(exp)
+	}, Metadata{
+		Category:    CATEGORY_OTHER,
+		Description: "Returns a random integer between min and max (inclusive).",
+		ArgNames:    []string{"min", "max"},
+		Signature:   &Signature{MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_NUM, ARG_TYPE_NUM}},
+	})
+	mustRegisterExpression("externalEventEval", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.externalEventEval(exp)
+	}, Metadata{
+		Category:    CATEGORY_EXTERNAL_SERVICES,
+		Description: "Sends the event to a configured external service and returns the value of the reply. With fallback \"false\", a failing call (or open circuit breaker) returns false instead of an error.",
+		ArgNames:    []string{"serviceName", "route", "fallback"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 3, Args: []string{ARG_TYPE_STR, ARG_TYPE_STR, ARG_TYPE_STR_LITERAL}},
+	})
+	mustRegisterExpression("let", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.let(exp)
+	}, Metadata{
+		Category:    CATEGORY_VARIABLES,
+		Description: "Evaluates the value once and returns the result of the expression evaluated with the value bound to the variable.",
+		ArgNames:    []string{"varName", "value", "expression"},
+		Signature:   &Signature{MinArgs: 3, MaxArgs: 3, Args: []string{ARG_TYPE_STR_LITERAL, ARG_TYPE_ANY, ARG_TYPE_ANY}},
+	})
+	mustRegisterExpression("getVar", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+		return ctx.getVar(exp)
+	}, Metadata{
+		Category:    CATEGORY_VARIABLES,
+		Description: "Returns the value of a variable bound in the current scope (by `LET`, `let` or the current item of `FOREACH`).",
+		ArgNames:    []string{"varName"},
+		Signature:   &Signature{MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_STR_LITERAL}},
+	})
+}
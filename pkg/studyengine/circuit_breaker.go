@@ -0,0 +1,225 @@
+package studyengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	CIRCUIT_BREAKER_STATE_CLOSED    = "closed"
+	CIRCUIT_BREAKER_STATE_OPEN      = "open"     // calls are rejected without contacting the service
+	CIRCUIT_BREAKER_STATE_HALF_OPEN = "halfOpen" // one trial call decides if the breaker closes again
+
+	// fallbacks of rules, when the external service fails or the breaker is open
+	EXTERNAL_SERVICE_FALLBACK_FAIL  = "fail"  // default, the rule returns the error
+	EXTERNAL_SERVICE_FALLBACK_SKIP  = "skip"  // EXTERNAL_EVENT_HANDLER keeps the state unchanged
+	EXTERNAL_SERVICE_FALLBACK_FALSE = "false" // externalEventEval returns false
+
+	defaultCircuitBreakerFailureThreshold = 5
+	defaultCircuitBreakerOpenSeconds      = 30
+)
+
+var ErrCircuitBreakerOpen = errors.New("circuit breaker open")
+
+// ExternalServiceHealth is the circuit breaker state and the call counters of an external service since the start of the service
+type ExternalServiceHealth struct {
+	Name                string `json:"name"`
+	State               string `json:"state"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+	Calls               int64  `json:"calls"`
+	Failures            int64  `json:"failures"`
+	Rejected            int64  `json:"rejected"` // calls not performed, because the breaker was open
+	LastError           string `json:"lastError,omitempty"`
+	LastFailureAt       int64  `json:"lastFailureAt,omitempty"`
+}
+
+type circuitBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	openDuration     time.Duration
+	disabled         bool
+
+	health          ExternalServiceHealth
+	openedAt        time.Time
+	trialInProgress bool
+}
+
+var circuitBreakers = struct {
+	sync.Mutex
+	byName map[string]*circuitBreaker
+}{byName: map[string]*circuitBreaker{}}
+
+// getCircuitBreaker returns the breaker of the service, shared by all calls, with the thresholds of the current config
+func getCircuitBreaker(serviceConfig types.ExternalService) *circuitBreaker {
+	circuitBreakers.Lock()
+	b, ok := circuitBreakers.byName[serviceConfig.Name]
+	if !ok {
+		b = &circuitBreaker{health: ExternalServiceHealth{Name: serviceConfig.Name, State: CIRCUIT_BREAKER_STATE_CLOSED}}
+		circuitBreakers.byName[serviceConfig.Name] = b
+	}
+	circuitBreakers.Unlock()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failureThreshold = defaultCircuitBreakerFailureThreshold
+	b.openDuration = defaultCircuitBreakerOpenSeconds * time.Second
+	b.disabled = false
+	if c := serviceConfig.CircuitBreaker; c != nil {
+		b.disabled = c.Disabled
+		if c.FailureThreshold > 0 {
+			b.failureThreshold = c.FailureThreshold
+		}
+		if c.OpenSeconds > 0 {
+			b.openDuration = time.Duration(c.OpenSeconds) * time.Second
+		}
+	}
+	return b
+}
+
+// withCircuitBreaker performs the call, unless the breaker of the service is open.
+// Calls cancelled through ctx (e.g. by the budget of the evaluation) are not counted against the service.
+func withCircuitBreaker(ctx context.Context, serviceConfig types.ExternalService, call func() error) error {
+	b := getCircuitBreaker(serviceConfig)
+	if err := b.allow(time.Now()); err != nil {
+		return err
+	}
+	err := call()
+	if err != nil && ctx.Err() != nil {
+		b.release()
+		return err
+	}
+	b.record(err, time.Now())
+	return err
+}
+
+// externalServiceStatusError is returned for HTTP replies with a non 2xx status
+type externalServiceStatusError struct {
+	service    string
+	statusCode int
+}
+
+func (e *externalServiceStatusError) Error() string {
+	return fmt.Sprintf("%s replied with status %d", e.service, e.statusCode)
+}
+
+// isExternalServiceFailure tells if the error counts as failure of the service for the breaker: transport errors, 5xx replies
+// and the gRPC codes for unavailable or failing servers. Other errors (4xx, invalid replies, config errors) don't open the breaker.
+func isExternalServiceFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var statusErr *externalServiceStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode >= 500
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.DataLoss, codes.ResourceExhausted:
+			return true
+		}
+	}
+	return false
+}
+
+func (b *circuitBreaker) allow(now time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.disabled {
+		return nil
+	}
+	switch b.health.State {
+	case CIRCUIT_BREAKER_STATE_OPEN:
+		if now.Sub(b.openedAt) < b.openDuration {
+			b.health.Rejected += 1
+			return ErrCircuitBreakerOpen
+		}
+		b.health.State = CIRCUIT_BREAKER_STATE_HALF_OPEN
+		b.trialInProgress = true
+	case CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		if b.trialInProgress {
+			b.health.Rejected += 1
+			return ErrCircuitBreakerOpen
+		}
+		b.trialInProgress = true
+	}
+	return nil
+}
+
+func (b *circuitBreaker) record(err error, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.health.Calls += 1
+	b.trialInProgress = false
+	if !isExternalServiceFailure(err) {
+		b.health.ConsecutiveFailures = 0
+		b.health.State = CIRCUIT_BREAKER_STATE_CLOSED
+		return
+	}
+
+	b.health.Failures += 1
+	b.health.ConsecutiveFailures += 1
+	b.health.LastError = err.Error()
+	b.health.LastFailureAt = now.Unix()
+	if b.disabled {
+		return
+	}
+	if b.health.State == CIRCUIT_BREAKER_STATE_HALF_OPEN || b.health.ConsecutiveFailures >= b.failureThreshold {
+		b.health.State = CIRCUIT_BREAKER_STATE_OPEN
+		b.openedAt = now
+	}
+}
+
+// release ends a call without result, a half-open breaker lets the next call through as trial
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.health.Calls += 1
+	b.trialInProgress = false
+}
+
+// ExternalServicesHealth returns the circuit breaker state of each configured external service
+func ExternalServicesHealth(serviceConfigs []types.ExternalService) []ExternalServiceHealth {
+	health := make([]ExternalServiceHealth, 0, len(serviceConfigs))
+	for _, serviceConfig := range serviceConfigs {
+		b := getCircuitBreaker(serviceConfig)
+		b.mu.Lock()
+		h := b.health
+		if h.State == CIRCUIT_BREAKER_STATE_OPEN && time.Since(b.openedAt) >= b.openDuration {
+			// next call is let through as trial
+			h.State = CIRCUIT_BREAKER_STATE_HALF_OPEN
+		}
+		b.mu.Unlock()
+		health = append(health, h)
+	}
+	return health
+}
+
+// externalServiceFallbackArg reads the optional third argument of external service rules, allowed is "fail" or the given fallback
+func (ctx EvalContext) externalServiceFallbackArg(args []types.ExpressionArg, allowed string) (string, error) {
+	if len(args) < 3 {
+		return EXTERNAL_SERVICE_FALLBACK_FAIL, nil
+	}
+	fallback, err := ctx.mustGetStrValue(args[2])
+	if err != nil {
+		return "", err
+	}
+	if fallback != EXTERNAL_SERVICE_FALLBACK_FAIL && fallback != allowed {
+		return "", fmt.Errorf("unknown fallback '%s', use '%s' or '%s'", fallback, EXTERNAL_SERVICE_FALLBACK_FAIL, allowed)
+	}
+	return fallback, nil
+}
@@ -0,0 +1,190 @@
+package studyengine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// resetCircuitBreaker removes the shared breaker of the service, so repeated test runs start closed
+func resetCircuitBreaker(name string) {
+	circuitBreakers.Lock()
+	defer circuitBreakers.Unlock()
+	delete(circuitBreakers.byName, name)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	callErr := &externalServiceStatusError{service: "service", statusCode: http.StatusServiceUnavailable}
+
+	t.Run("opens after threshold and closes after successful trial", func(t *testing.T) {
+		b := &circuitBreaker{failureThreshold: 2, openDuration: time.Minute, health: ExternalServiceHealth{State: CIRCUIT_BREAKER_STATE_CLOSED}}
+		for i := 0; i < 2; i++ {
+			if err := b.allow(now); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			b.record(callErr, now)
+		}
+		if b.health.State != CIRCUIT_BREAKER_STATE_OPEN {
+			t.Fatalf("unexpected state: %s", b.health.State)
+		}
+		if err := b.allow(now.Add(30 * time.Second)); !errors.Is(err, ErrCircuitBreakerOpen) {
+			t.Errorf("should reject calls while open: %v", err)
+		}
+
+		trialTime := now.Add(2 * time.Minute)
+		if err := b.allow(trialTime); err != nil || b.health.State != CIRCUIT_BREAKER_STATE_HALF_OPEN {
+			t.Fatalf("should let a trial call through: %v, %s", err, b.health.State)
+		}
+		if err := b.allow(trialTime); !errors.Is(err, ErrCircuitBreakerOpen) {
+			t.Errorf("should reject calls during the trial: %v", err)
+		}
+		b.record(nil, trialTime)
+		if b.health.State != CIRCUIT_BREAKER_STATE_CLOSED || b.health.ConsecutiveFailures != 0 {
+			t.Errorf("unexpected health: %+v", b.health)
+		}
+		if b.health.Calls != 3 || b.health.Failures != 2 || b.health.Rejected != 2 {
+			t.Errorf("unexpected counters: %+v", b.health)
+		}
+	})
+
+	t.Run("failed trial opens again", func(t *testing.T) {
+		b := &circuitBreaker{failureThreshold: 1, openDuration: time.Minute, health: ExternalServiceHealth{State: CIRCUIT_BREAKER_STATE_CLOSED}}
+		b.record(callErr, now)
+		trialTime := now.Add(2 * time.Minute)
+		if err := b.allow(trialTime); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		b.record(callErr, trialTime)
+		if err := b.allow(trialTime.Add(time.Second)); !errors.Is(err, ErrCircuitBreakerOpen) {
+			t.Errorf("should be open again: %v", err)
+		}
+	})
+
+	t.Run("only transport errors and 5xx are failures", func(t *testing.T) {
+		b := &circuitBreaker{failureThreshold: 1, openDuration: time.Minute, health: ExternalServiceHealth{State: CIRCUIT_BREAKER_STATE_CLOSED}}
+		for _, err := range []error{
+			&externalServiceStatusError{service: "service", statusCode: http.StatusBadRequest},
+			errInvalidExternalServiceReply,
+			errors.New("unknown protocol"),
+		} {
+			b.record(err, now)
+		}
+		if b.health.State != CIRCUIT_BREAKER_STATE_CLOSED || b.health.Failures != 0 {
+			t.Errorf("unexpected health: %+v", b.health)
+		}
+		b.record(&url.Error{Op: "Post", URL: "http://service", Err: errors.New("connection refused")}, now)
+		if b.health.State != CIRCUIT_BREAKER_STATE_OPEN {
+			t.Errorf("unexpected health: %+v", b.health)
+		}
+	})
+
+	t.Run("cancelled calls are not failures", func(t *testing.T) {
+		resetCircuitBreaker("cb-cancelled")
+		serviceConfig := types.ExternalService{Name: "cb-cancelled", CircuitBreaker: &types.CircuitBreakerConfig{FailureThreshold: 1}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := withCircuitBreaker(ctx, serviceConfig, func() error {
+			return &url.Error{Op: "Post", URL: "http://service", Err: context.Canceled}
+		})
+		if err == nil {
+			t.Fatal("should return the error of the call")
+		}
+		health := ExternalServicesHealth([]types.ExternalService{serviceConfig})
+		if health[0].State != CIRCUIT_BREAKER_STATE_CLOSED || health[0].Failures != 0 || health[0].Calls != 1 {
+			t.Errorf("unexpected health: %+v", health[0])
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		resetCircuitBreaker("cb-disabled")
+		b := getCircuitBreaker(types.ExternalService{Name: "cb-disabled", CircuitBreaker: &types.CircuitBreakerConfig{Disabled: true, FailureThreshold: 1}})
+		for i := 0; i < 3; i++ {
+			if err := b.allow(now); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			b.record(callErr, now)
+		}
+		if b.health.State != CIRCUIT_BREAKER_STATE_CLOSED || b.health.Failures != 3 {
+			t.Errorf("unexpected health: %+v", b.health)
+		}
+	})
+}
+
+func TestExternalServiceFallback(t *testing.T) {
+	resetCircuitBreaker("cb-fallback")
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls += 1
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	serviceConfig := types.ExternalService{
+		Name:           "cb-fallback",
+		URL:            server.URL,
+		Timeout:        5,
+		CircuitBreaker: &types.CircuitBreakerConfig{FailureThreshold: 2, OpenSeconds: 60},
+	}
+	configs := ActionConfigs{ExternalServiceConfigs: []types.ExternalService{serviceConfig}}
+	args := func(fallback string) []types.ExpressionArg {
+		return []types.ExpressionArg{
+			{DType: "str", Str: serviceConfig.Name},
+			{DType: "str", Str: "route"},
+			{DType: "str", Str: fallback},
+		}
+	}
+	oldState := ActionData{PState: types.ParticipantState{ParticipantID: "p1", StudyStatus: "active"}}
+
+	t.Run("action fails without fallback", func(t *testing.T) {
+		action := types.Expression{Name: "EXTERNAL_EVENT_HANDLER", Data: args(EXTERNAL_SERVICE_FALLBACK_FAIL)}
+		if _, err := ActionEval(action, oldState, types.StudyEvent{Type: "ENTER"}, configs); err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("action skipped", func(t *testing.T) {
+		action := types.Expression{Name: "EXTERNAL_EVENT_HANDLER", Data: args(EXTERNAL_SERVICE_FALLBACK_SKIP)}
+		if errs := ValidateStudyRules([]types.Expression{action}); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+		newState, err := ActionEval(action, oldState, types.StudyEvent{Type: "ENTER"}, configs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newState.PState.StudyStatus != "active" {
+			t.Errorf("unexpected state: %v", newState.PState)
+		}
+	})
+
+	t.Run("expression false while breaker open", func(t *testing.T) {
+		exp := types.Expression{Name: "externalEventEval", Data: args(EXTERNAL_SERVICE_FALLBACK_FALSE)}
+		val, err := ExpressionEval(exp, EvalContext{ParticipantState: oldState.PState, Configs: configs})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if val != false {
+			t.Errorf("unexpected value: %v", val)
+		}
+		if calls != 2 {
+			t.Errorf("open breaker should not call the service: %d calls", calls)
+		}
+		health := ExternalServicesHealth([]types.ExternalService{serviceConfig})
+		if len(health) != 1 || health[0].State != CIRCUIT_BREAKER_STATE_OPEN || health[0].Rejected != 1 {
+			t.Errorf("unexpected health: %+v", health)
+		}
+	})
+
+	t.Run("unknown fallback", func(t *testing.T) {
+		exp := types.Expression{Name: "externalEventEval", Data: args(EXTERNAL_SERVICE_FALLBACK_SKIP)}
+		if _, err := ExpressionEval(exp, EvalContext{ParticipantState: oldState.PState, Configs: configs}); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package studyengine
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/influenzanet/study-service/pkg/dbs/studydb"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// DryRunDBService forwards read access to the wrapped DB service, but only collects write operations instead of executing them
+type DryRunDBService struct {
+	DBService                    StudyDBService
+	ResearcherMessages           []types.StudyMessage
+	ConfidentialResponsesRemoved []string // keys of the confidential responses that would be removed ("" for all)
+	ExternalEvents               []types.ExternalEventOutboxEntry
+	reservedSlots                map[string]int64 // slots reserved during the dry run, per randomization key and stratum
+}
+
+func (db *DryRunDBService) FindSurveyResponses(instanceID string, studyKey string, query studydb.ResponseQuery) (responses []types.SurveyResponse, err error) {
+	if db.DBService == nil {
+		return []types.SurveyResponse{}, nil
+	}
+	return db.DBService.FindSurveyResponses(instanceID, studyKey, query)
+}
+
+func (db *DryRunDBService) FindReports(instanceID string, studyKey string, query studydb.ReportQuery) (reports []types.Report, err error) {
+	if db.DBService == nil {
+		return []types.Report{}, nil
+	}
+	return db.DBService.FindReports(instanceID, studyKey, query)
+}
+
+func (db *DryRunDBService) DeleteConfidentialResponses(instanceID string, studyKey string, participantID string, key string) (count int64, err error) {
+	db.ConfidentialResponsesRemoved = append(db.ConfidentialResponsesRemoved, key)
+	return 0, nil
+}
+
+// SaveResearcherMessage collects the message, messages with the DedupKey of a collected message only increase its count
+func (db *DryRunDBService) SaveResearcherMessage(instanceID string, studyKey string, message types.StudyMessage) error {
+	if message.DedupKey != "" {
+		for i, m := range db.ResearcherMessages {
+			if m.DedupKey == message.DedupKey {
+				db.ResearcherMessages[i].Count += 1
+				return nil
+			}
+		}
+		message.Count = 1
+	}
+	db.ResearcherMessages = append(db.ResearcherMessages, message)
+	return nil
+}
+
+func (db *DryRunDBService) AddExternalEventToOutbox(instanceID string, studyKey string, entry types.ExternalEventOutboxEntry) error {
+	db.ExternalEvents = append(db.ExternalEvents, entry)
+	return nil
+}
+
+// ReserveRandomizationSlot returns the slot the next reservation would take, without updating the allocation
+func (db *DryRunDBService) ReserveRandomizationSlot(instanceID string, studyKey string, randomizationKey string, stratum string, seed int64) (types.RandomizationSlot, error) {
+	allocation, err := db.GetRandomizationAllocation(instanceID, studyKey, randomizationKey, stratum)
+	if err != nil {
+		return types.RandomizationSlot{}, err
+	}
+	if db.reservedSlots == nil {
+		db.reservedSlots = map[string]int64{}
+	}
+	key := randomizationKey + STRATA_SEPARATOR + stratum
+	reserved := db.reservedSlots[key]
+	db.reservedSlots[key] += 1
+
+	slot := types.RandomizationSlot{RandomizationKey: randomizationKey, Stratum: stratum, Seed: allocation.Seed}
+	if allocation.Total == 0 {
+		slot.Seed = seed
+	}
+	if reserved < int64(len(allocation.Released)) {
+		slot.Slot = allocation.Released[reserved]
+	} else {
+		slot.Slot = allocation.Total + reserved - int64(len(allocation.Released))
+	}
+	return slot, nil
+}
+
+func (db *DryRunDBService) CommitRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	return nil
+}
+
+func (db *DryRunDBService) ReleaseRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	return nil
+}
+
+func (db *DryRunDBService) GetRandomizationAllocation(instanceID string, studyKey string, randomizationKey string, stratum string) (types.RandomizationAllocation, error) {
+	if db.DBService == nil {
+		return types.RandomizationAllocation{RandomizationKey: randomizationKey, Stratum: stratum}, nil
+	}
+	rdb, ok := db.DBService.(RandomizationDBService)
+	if !ok {
+		return types.RandomizationAllocation{}, errors.New("randomization is not supported by the DB service")
+	}
+	return rdb.GetRandomizationAllocation(instanceID, studyKey, randomizationKey, stratum)
+}
+
+// DryRunResult contains everything a rule set would change for one participant
+type DryRunResult struct {
+	ParticipantID                string                           `json:"participantID"`
+	Diff                         types.ParticipantStateDiff       `json:"diff"`
+	ReportsToCreate              []types.Report                   `json:"reportsToCreate,omitempty"`
+	ResearcherMessages           []types.StudyMessage             `json:"researcherMessages,omitempty"`
+	ConfidentialResponsesRemoved []string                         `json:"confidentialResponsesRemoved,omitempty"`
+	ExternalEvents               []types.ExternalEventOutboxEntry `json:"externalEvents,omitempty"` // queued for asynchronous delivery
+	StateChangedByRule           []bool                           `json:"stateChangedByRule"`
+	DBCalls                      DBCallStats                      `json:"dbCalls"` // lookups of the rules for the participant
+	Error                        string                           `json:"error,omitempty"`
+}
+
+// HasChanges returns true if running the rules would modify anything for the participant
+func (r DryRunResult) HasChanges() bool {
+	return !r.Diff.IsEmpty() || len(r.ReportsToCreate) > 0 || len(r.ResearcherMessages) > 0 || len(r.ConfidentialResponsesRemoved) > 0 || len(r.ExternalEvents) > 0
+}
+
+// DryRunRules evaluates the rules for one participant without persisting anything.
+// Writes through configs.DBService are collected in the result instead of being executed, lookups are cached for the participant.
+// Evaluation stops at the first rule returning an error, the result contains the changes up to this point.
+func DryRunRules(rules []*types.Expression, pState types.ParticipantState, event types.StudyEvent, configs ActionConfigs) DryRunResult {
+	configs, eventDB := WithEventDBCache(configs)
+	configs, cancel := WithEvalBudget(context.Background(), configs)
+	defer cancel()
+	dbService := &DryRunDBService{DBService: configs.DBService}
+	configs.DBService = dbService
+
+	result := DryRunResult{
+		ParticipantID:      pState.ParticipantID,
+		StateChangedByRule: make([]bool, len(rules)),
+	}
+
+	// some actions modify slices in place, so the original state is kept separately for the diff
+	actionData := ActionData{
+		PState:          CopyParticipantState(pState),
+		ReportsToCreate: map[string]types.Report{},
+	}
+	for index, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		newState, err := ActionEval(*rule, actionData, event, configs)
+		if err == nil {
+			err = configs.Budget.Err()
+		}
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		if !reflect.DeepEqual(newState.PState, actionData.PState) {
+			result.StateChangedByRule[index] = true
+		}
+		actionData = newState
+	}
+
+	result.Diff = types.DiffParticipantStates(pState, actionData.PState)
+
+	reportKeys := make([]string, 0, len(actionData.ReportsToCreate))
+	for key := range actionData.ReportsToCreate {
+		reportKeys = append(reportKeys, key)
+	}
+	sort.Strings(reportKeys)
+	for _, key := range reportKeys {
+		result.ReportsToCreate = append(result.ReportsToCreate, actionData.ReportsToCreate[key])
+	}
+	result.ResearcherMessages = dbService.ResearcherMessages
+	result.ConfidentialResponsesRemoved = dbService.ConfidentialResponsesRemoved
+	result.ExternalEvents = dbService.ExternalEvents
+	result.DBCalls = eventDB.Stats()
+	return result
+}
+
+// CopyParticipantState returns a copy of the state, which can be modified without changing the original maps and slices
+func CopyParticipantState(pState types.ParticipantState) types.ParticipantState {
+	newState := pState
+	if pState.Flags != nil {
+		newState.Flags = make(map[string]string, len(pState.Flags))
+		for k, v := range pState.Flags {
+			newState.Flags[k] = v
+		}
+	}
+	if pState.LastSubmissions != nil {
+		newState.LastSubmissions = make(map[string]int64, len(pState.LastSubmissions))
+		for k, v := range pState.LastSubmissions {
+			newState.LastSubmissions[k] = v
+		}
+	}
+	if pState.AssignedSurveys != nil {
+		newState.AssignedSurveys = make([]types.AssignedSurvey, len(pState.AssignedSurveys))
+		copy(newState.AssignedSurveys, pState.AssignedSurveys)
+	}
+	if pState.Messages != nil {
+		newState.Messages = make([]types.ParticipantMessage, len(pState.Messages))
+		copy(newState.Messages, pState.Messages)
+	}
+	if pState.ScheduledActions != nil {
+		newState.ScheduledActions = make([]types.ScheduledAction, len(pState.ScheduledActions))
+		copy(newState.ScheduledActions, pState.ScheduledActions)
+	}
+	return newState
+}
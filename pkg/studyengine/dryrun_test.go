@@ -0,0 +1,128 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestDryRunRules(t *testing.T) {
+	pState := types.ParticipantState{
+		ParticipantID: "participant1234",
+		StudyStatus:   types.PARTICIPANT_STUDY_STATUS_ACTIVE,
+		Flags: map[string]string{
+			"group": "A",
+			"old":   "1",
+		},
+		AssignedSurveys: []types.AssignedSurvey{
+			{SurveyKey: "weekly", Category: "normal"},
+			{SurveyKey: "intake", Category: "normal"},
+		},
+	}
+	event := types.StudyEvent{
+		InstanceID: "testInstance",
+		StudyKey:   "testStudy",
+	}
+	configs := ActionConfigs{DBService: MockStudyDBService{}}
+
+	t.Run("with changes", func(t *testing.T) {
+		rules := []*types.Expression{
+			{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+				{DType: "str", Str: "group"},
+				{DType: "str", Str: "B"},
+			}},
+			nil,
+			{Name: "DO", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "REMOVE_FLAG", Data: []types.ExpressionArg{{DType: "str", Str: "old"}}}},
+				{DType: "exp", Exp: &types.Expression{Name: "REMOVE_SURVEY_BY_KEY", Data: []types.ExpressionArg{
+					{DType: "str", Str: "weekly"},
+					{DType: "str", Str: "last"},
+				}}},
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_REPORT_DATA", Data: []types.ExpressionArg{
+					{DType: "str", Str: "report1"},
+					{DType: "str", Str: "key1"},
+					{DType: "str", Str: "value1"},
+				}}},
+				{DType: "exp", Exp: &types.Expression{Name: "NOTIFY_RESEARCHER", Data: []types.ExpressionArg{{DType: "str", Str: "msg"}}}},
+			}},
+			{Name: "UPDATE_STUDY_STATUS", Data: []types.ExpressionArg{
+				{DType: "str", Str: types.PARTICIPANT_STUDY_STATUS_ACTIVE},
+			}},
+		}
+
+		result := DryRunRules(rules, pState, event, configs)
+		if result.Error != "" {
+			t.Errorf("unexpected error: %s", result.Error)
+		}
+		if !result.HasChanges() {
+			t.Error("should have changes")
+		}
+		if len(result.StateChangedByRule) != 4 || !result.StateChangedByRule[0] || result.StateChangedByRule[1] || !result.StateChangedByRule[2] || result.StateChangedByRule[3] {
+			t.Errorf("unexpected state changes per rule: %v", result.StateChangedByRule)
+		}
+
+		if pState.Flags["group"] != "A" || len(pState.AssignedSurveys) != 2 || pState.AssignedSurveys[0].SurveyKey != "weekly" {
+			t.Errorf("original state should not be modified: %v", pState)
+		}
+
+		flagChange, ok := result.Diff.Flags["group"]
+		if !ok || flagChange.Before == nil || *flagChange.Before != "A" || flagChange.After == nil || *flagChange.After != "B" {
+			t.Errorf("unexpected flag change: %v", result.Diff.Flags)
+		}
+		flagChange, ok = result.Diff.Flags["old"]
+		if !ok || flagChange.After != nil {
+			t.Errorf("flag should be removed: %v", result.Diff.Flags)
+		}
+		if len(result.Diff.RemovedSurveys) != 1 || result.Diff.RemovedSurveys[0].SurveyKey != "weekly" || len(result.Diff.AddedSurveys) != 0 {
+			t.Errorf("unexpected survey changes: %v, %v", result.Diff.RemovedSurveys, result.Diff.AddedSurveys)
+		}
+		if result.Diff.StudyStatus != nil {
+			t.Errorf("unexpected status change: %v", result.Diff.StudyStatus)
+		}
+		if len(result.ReportsToCreate) != 1 || result.ReportsToCreate[0].Key != "report1" {
+			t.Errorf("unexpected reports: %v", result.ReportsToCreate)
+		}
+		if len(result.ResearcherMessages) != 1 || result.ResearcherMessages[0].Type != "msg" {
+			t.Errorf("unexpected researcher messages: %v", result.ResearcherMessages)
+		}
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		rules := []*types.Expression{
+			{Name: "ADD_NEW_SURVEY", Data: []types.ExpressionArg{
+				{DType: "str", Str: "followup"},
+				{DType: "num", Num: 10},
+				{DType: "num", Num: 20},
+				{DType: "str", Str: "prio"},
+			}},
+			{Name: "WRONG"},
+			{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+				{DType: "str", Str: "group"},
+				{DType: "str", Str: "B"},
+			}},
+		}
+		result := DryRunRules(rules, pState, event, configs)
+		if result.Error == "" {
+			t.Error("should return an error")
+		}
+		if len(result.Diff.AddedSurveys) != 1 || result.Diff.AddedSurveys[0].SurveyKey != "followup" {
+			t.Errorf("unexpected survey changes: %v", result.Diff.AddedSurveys)
+		}
+		if len(result.Diff.Flags) != 0 {
+			t.Errorf("rules after the error should not be evaluated: %v", result.Diff.Flags)
+		}
+	})
+
+	t.Run("without changes", func(t *testing.T) {
+		rules := []*types.Expression{
+			{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+				{DType: "str", Str: "group"},
+				{DType: "str", Str: "A"},
+			}},
+		}
+		result := DryRunRules(rules, pState, event, configs)
+		if result.HasChanges() {
+			t.Errorf("should not have changes: %v", result.Diff)
+		}
+	})
+}
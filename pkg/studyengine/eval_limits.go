@@ -0,0 +1,171 @@
+package studyengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// limits of EvalLimitError
+const (
+	EVAL_LIMIT_MAX_DEPTH = "maxDepth"
+	EVAL_LIMIT_MAX_NODES = "maxNodes"
+	EVAL_LIMIT_TIMEOUT   = "timeout"
+)
+
+var (
+	defaultEvalLimitsLock sync.RWMutex
+	defaultEvalLimits     = types.EvalLimits{
+		MaxDepth:       100,
+		MaxNodes:       100000,
+		TimeoutSeconds: 30,
+	}
+)
+
+// DefaultEvalLimits returns the limits used for evaluations without an explicit budget
+func DefaultEvalLimits() types.EvalLimits {
+	defaultEvalLimitsLock.RLock()
+	defer defaultEvalLimitsLock.RUnlock()
+	return defaultEvalLimits
+}
+
+// SetDefaultEvalLimits replaces the limits used for evaluations without an explicit budget, e.g. from the service config
+func SetDefaultEvalLimits(limits types.EvalLimits) {
+	defaultEvalLimitsLock.Lock()
+	defer defaultEvalLimitsLock.Unlock()
+	defaultEvalLimits = limits
+}
+
+// EvalLimitError is returned when an evaluation exceeds one of its limits
+type EvalLimitError struct {
+	Limit string // one of EVAL_LIMIT_*
+	Value int    // the configured limit
+	Name  string // action or expression evaluated when the limit was exceeded
+}
+
+func (e *EvalLimitError) Error() string {
+	msg := ""
+	switch e.Limit {
+	case EVAL_LIMIT_MAX_DEPTH:
+		msg = fmt.Sprintf("evaluation limit exceeded: nested deeper than %d", e.Value)
+	case EVAL_LIMIT_MAX_NODES:
+		msg = fmt.Sprintf("evaluation limit exceeded: more than %d actions and expressions evaluated", e.Value)
+	default:
+		msg = fmt.Sprintf("evaluation limit exceeded: not finished within %d seconds", e.Value)
+	}
+	if e.Name != "" {
+		msg += fmt.Sprintf(" (at '%s')", e.Name)
+	}
+	return msg
+}
+
+// AsEvalLimitError returns the EvalLimitError wrapped in err, nil if there is none
+func AsEvalLimitError(err error) *EvalLimitError {
+	var limitErr *EvalLimitError
+	if errors.As(err, &limitErr) {
+		return limitErr
+	}
+	return nil
+}
+
+// EvalBudget tracks the nesting depth, the number of evaluated nodes and the deadline of one evaluation.
+// Add it to ActionConfigs to enforce the limits, a nil budget has no limits.
+// Once a limit is exceeded, every following node fails with the same error, so it is not lost in expressions ignoring errors (e.g. conditions).
+type EvalBudget struct {
+	limits types.EvalLimits
+	ctx    context.Context
+	depth  int
+	nodes  int
+	err    error
+}
+
+// NewEvalBudget returns a budget with the limits, the deadline is derived from ctx. Call cancel when the evaluation is finished.
+func NewEvalBudget(ctx context.Context, limits types.EvalLimits) (budget *EvalBudget, cancel context.CancelFunc) {
+	if limits.TimeoutSeconds > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(limits.TimeoutSeconds)*time.Second)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	return &EvalBudget{limits: limits, ctx: ctx}, cancel
+}
+
+// WithEvalBudget returns the configs with a new budget using the default limits, unless they have one already
+func WithEvalBudget(ctx context.Context, configs ActionConfigs) (ActionConfigs, context.CancelFunc) {
+	if configs.Budget != nil {
+		return configs, func() {}
+	}
+	budget, cancel := NewEvalBudget(ctx, DefaultEvalLimits())
+	configs.Budget = budget
+	return configs, cancel
+}
+
+// Context is cancelled at the deadline of the evaluation, used for calls to external services
+func (b *EvalBudget) Context() context.Context {
+	if b == nil {
+		return context.Background()
+	}
+	return b.ctx
+}
+
+// Err returns the error of the first exceeded limit, nil while all limits are kept
+func (b *EvalBudget) Err() error {
+	if b == nil {
+		return nil
+	}
+	if b.err == nil && b.ctx.Err() != nil {
+		b.err = b.contextError("")
+	}
+	return b.err
+}
+
+// contextError is an EvalLimitError at the deadline, or the error of the cancelled parent context
+func (b *EvalBudget) contextError(name string) error {
+	if b.ctx.Err() == context.DeadlineExceeded && b.limits.TimeoutSeconds > 0 {
+		return &EvalLimitError{Limit: EVAL_LIMIT_TIMEOUT, Value: b.limits.TimeoutSeconds, Name: name}
+	}
+	return fmt.Errorf("evaluation cancelled: %w", b.ctx.Err())
+}
+
+// EvaluatedNodes returns the number of actions and expressions evaluated so far
+func (b *EvalBudget) EvaluatedNodes() int {
+	if b == nil {
+		return 0
+	}
+	return b.nodes
+}
+
+// enter counts the action or expression and checks the limits, exit must be called after the evaluation if no error is returned
+func (b *EvalBudget) enter(name string) error {
+	if b == nil {
+		return nil
+	}
+	if b.err != nil {
+		return b.err
+	}
+	if b.ctx.Err() != nil {
+		b.err = b.contextError(name)
+		return b.err
+	}
+	if b.limits.MaxDepth > 0 && b.depth >= b.limits.MaxDepth {
+		b.err = &EvalLimitError{Limit: EVAL_LIMIT_MAX_DEPTH, Value: b.limits.MaxDepth, Name: name}
+		return b.err
+	}
+	if b.limits.MaxNodes > 0 && b.nodes >= b.limits.MaxNodes {
+		b.err = &EvalLimitError{Limit: EVAL_LIMIT_MAX_NODES, Value: b.limits.MaxNodes, Name: name}
+		return b.err
+	}
+	b.depth += 1
+	b.nodes += 1
+	return nil
+}
+
+func (b *EvalBudget) exit() {
+	if b == nil {
+		return
+	}
+	b.depth -= 1
+}
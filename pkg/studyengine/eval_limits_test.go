@@ -0,0 +1,180 @@
+package studyengine
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestEvalLimits(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.ExpressionArg {
+		return types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: name, Data: args}}
+	}
+	// nested returns an expression of the depth
+	nested := func(depth int) types.ExpressionArg {
+		e := exp("eq", str("a"), str("a"))
+		for i := 1; i < depth; i++ {
+			e = exp("not", e)
+		}
+		return e
+	}
+	// wide returns an expression with the number of nodes
+	wide := func(nodes int) types.ExpressionArg {
+		args := []types.ExpressionArg{}
+		for i := 1; i < nodes; i++ {
+			args = append(args, exp("eq", str("a"), str("a")))
+		}
+		return exp("and", args...)
+	}
+	setFlag := func(flag string) types.ExpressionArg {
+		return exp("UPDATE_FLAG", str(flag), str("1"))
+	}
+	evalWithLimits := func(e types.ExpressionArg, limits types.EvalLimits) (*EvalBudget, error) {
+		budget, cancel := NewEvalBudget(context.Background(), limits)
+		t.Cleanup(cancel)
+		_, err := ExpressionEval(*e.Exp, EvalContext{Configs: ActionConfigs{Budget: budget}})
+		return budget, err
+	}
+	event := types.StudyEvent{Type: "TIMER", InstanceID: "instance", StudyKey: "study"}
+	state := ActionData{PState: types.ParticipantState{ParticipantID: "P1"}}
+
+	t.Run("within limits", func(t *testing.T) {
+		budget, err := evalWithLimits(nested(10), types.EvalLimits{MaxDepth: 10, MaxNodes: 10, TimeoutSeconds: 10})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if budget.EvaluatedNodes() != 10 || budget.Err() != nil {
+			t.Errorf("unexpected budget: %d nodes, %v", budget.EvaluatedNodes(), budget.Err())
+		}
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		_, err := evalWithLimits(nested(11), types.EvalLimits{MaxDepth: 10})
+		limitErr := AsEvalLimitError(err)
+		if limitErr == nil || limitErr.Limit != EVAL_LIMIT_MAX_DEPTH || limitErr.Name != "eq" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("max nodes", func(t *testing.T) {
+		if _, err := evalWithLimits(wide(10), types.EvalLimits{MaxDepth: 2, MaxNodes: 10}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err := evalWithLimits(wide(11), types.EvalLimits{MaxDepth: 2, MaxNodes: 10})
+		if limitErr := AsEvalLimitError(err); limitErr == nil || limitErr.Limit != EVAL_LIMIT_MAX_NODES {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+		budget, cancelBudget := NewEvalBudget(ctx, types.EvalLimits{TimeoutSeconds: 5})
+		defer cancelBudget()
+		_, err := ExpressionEval(*nested(1).Exp, EvalContext{Configs: ActionConfigs{Budget: budget}})
+		if limitErr := AsEvalLimitError(err); limitErr == nil || limitErr.Limit != EVAL_LIMIT_TIMEOUT {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		budget, cancelBudget := NewEvalBudget(ctx, types.EvalLimits{})
+		defer cancelBudget()
+		_, err := ExpressionEval(*nested(1).Exp, EvalContext{Configs: ActionConfigs{Budget: budget}})
+		if err == nil || AsEvalLimitError(err) != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("without budget", func(t *testing.T) {
+		if _, err := ExpressionEval(*nested(200).Exp, EvalContext{}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("exceeded limit in a condition aborts the rules", func(t *testing.T) {
+		budget, cancel := NewEvalBudget(context.Background(), types.EvalLimits{MaxDepth: 10})
+		defer cancel()
+		dbService := &DryRunDBService{}
+		configs := ActionConfigs{DBService: dbService, Budget: budget}
+		rules := []types.Expression{
+			*setFlag("a").Exp,
+			{Name: "IF", Data: []types.ExpressionArg{nested(20), setFlag("b")}},
+			*setFlag("c").Exp,
+		}
+		newState, ruleErrors := PerformRules(rules, state, event, configs, "")
+		if len(ruleErrors) != 1 || ruleErrors[0].RuleIndex != 1 || ruleErrors[0].EvalLimit != EVAL_LIMIT_MAX_DEPTH {
+			t.Fatalf("unexpected rule errors: %v", ruleErrors)
+		}
+		if newState.PState.Flags["a"] != "1" || newState.PState.Flags["b"] != "" || newState.PState.Flags["c"] != "" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+
+		ReportRuleErrors(ruleErrors, "P1", event, configs, "", "v1")
+		if len(dbService.ResearcherMessages) != 1 || dbService.ResearcherMessages[0].Payload["evalLimit"] != EVAL_LIMIT_MAX_DEPTH {
+			t.Errorf("unexpected researcher messages: %v", dbService.ResearcherMessages)
+		}
+	})
+
+	t.Run("default limits of rules", func(t *testing.T) {
+		defaultLimits := DefaultEvalLimits()
+		defer SetDefaultEvalLimits(defaultLimits)
+		SetDefaultEvalLimits(types.EvalLimits{MaxNodes: 20})
+
+		rules := []types.Expression{
+			{Name: "IF", Data: []types.ExpressionArg{wide(10), setFlag("a")}},
+			{Name: "IF", Data: []types.ExpressionArg{wide(10), setFlag("b")}},
+		}
+		_, ruleErrors := PerformRules(rules, state, event, ActionConfigs{}, types.RULE_ERROR_POLICY_ALL_OR_NOTHING)
+		if len(ruleErrors) != 1 || ruleErrors[0].RuleIndex != 1 || ruleErrors[0].EvalLimit != EVAL_LIMIT_MAX_NODES {
+			t.Errorf("unexpected rule errors: %v", ruleErrors)
+		}
+
+		result := DryRunRules([]*types.Expression{&rules[0], &rules[1]}, state.PState, event, ActionConfigs{})
+		if result.Error == "" {
+			t.Error("dry run should report the exceeded limit")
+		}
+	})
+
+	t.Run("deadline cancels external calls and skips the fallback", func(t *testing.T) {
+		resetCircuitBreaker("slow-service")
+		defer resetCircuitBreaker("slow-service")
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the closed connection is only noticed after the body is read
+			io.Copy(io.Discard, r.Body)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+
+		budget, cancel := NewEvalBudget(context.Background(), types.EvalLimits{TimeoutSeconds: 1})
+		defer cancel()
+		configs := ActionConfigs{
+			ExternalServiceConfigs: []types.ExternalService{{Name: "slow-service", URL: server.URL, Timeout: 10}},
+			Budget:                 budget,
+		}
+		action := types.Expression{Name: "EXTERNAL_EVENT_HANDLER", Data: []types.ExpressionArg{
+			str("slow-service"), str("route"), str(EXTERNAL_SERVICE_FALLBACK_SKIP),
+		}}
+		start := time.Now()
+		_, err := ActionEval(action, state, types.StudyEvent{Type: "ENTER"}, configs)
+		if limitErr := AsEvalLimitError(err); limitErr == nil || limitErr.Limit != EVAL_LIMIT_TIMEOUT {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if time.Since(start) > 3*time.Second {
+			t.Errorf("call should be cancelled at the deadline, took %s", time.Since(start))
+		}
+	})
+}
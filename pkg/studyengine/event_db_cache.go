@@ -0,0 +1,191 @@
+package studyengine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/influenzanet/study-service/pkg/dbs/studydb"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// DBCallStats counts the lookups of expressions during the evaluation of an event
+type DBCallStats struct {
+	FindSurveyResponses int `json:"findSurveyResponses"` // queries sent to the DB
+	FindReports         int `json:"findReports"`         // queries sent to the DB
+	CachedLookups       int `json:"cachedLookups"`       // lookups answered from the cache
+}
+
+// DBCalls returns the number of queries sent to the DB
+func (s DBCallStats) DBCalls() int {
+	return s.FindSurveyResponses + s.FindReports
+}
+
+// Add returns the sum of both stats, e.g. to summarize a rule run over all participants
+func (s DBCallStats) Add(other DBCallStats) DBCallStats {
+	return DBCallStats{
+		FindSurveyResponses: s.FindSurveyResponses + other.FindSurveyResponses,
+		FindReports:         s.FindReports + other.FindReports,
+		CachedLookups:       s.CachedLookups + other.CachedLookups,
+	}
+}
+
+type responseQueryKey struct {
+	InstanceID string
+	StudyKey   string
+	Query      studydb.ResponseQuery
+}
+
+type reportQueryKey struct {
+	InstanceID string
+	StudyKey   string
+	Query      studydb.ReportQuery
+}
+
+// EventDBService caches the lookups of the wrapped DB service while the rules of one event are evaluated, so identical
+// queries (e.g. of several checkConditionForOldResponses) hit the DB only once. Use one instance per event and participant,
+// the cache is never refreshed. Failed queries are not cached, writes are forwarded.
+type EventDBService struct {
+	DBService StudyDBService
+
+	mu            sync.Mutex
+	stats         DBCallStats
+	responses     map[responseQueryKey][]types.SurveyResponse
+	reports       map[reportQueryKey][]types.Report
+	reservedSlots []types.RandomizationSlot
+}
+
+func NewEventDBService(dbService StudyDBService) *EventDBService {
+	return &EventDBService{
+		DBService: dbService,
+		responses: map[responseQueryKey][]types.SurveyResponse{},
+		reports:   map[reportQueryKey][]types.Report{},
+	}
+}
+
+// WithEventDBCache returns the configs with the DB service wrapped in an EventDBService, unless it is one already.
+// Returns nil for the EventDBService if configs have no DB service.
+func WithEventDBCache(configs ActionConfigs) (ActionConfigs, *EventDBService) {
+	if configs.DBService == nil {
+		return configs, nil
+	}
+	if db, ok := configs.DBService.(*EventDBService); ok {
+		return configs, db
+	}
+	db := NewEventDBService(configs.DBService)
+	configs.DBService = db
+	return configs, db
+}
+
+// Stats returns the lookups counted so far, also for a nil service
+func (db *EventDBService) Stats() DBCallStats {
+	if db == nil {
+		return DBCallStats{}
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.stats
+}
+
+func (db *EventDBService) FindSurveyResponses(instanceID string, studyKey string, query studydb.ResponseQuery) (responses []types.SurveyResponse, err error) {
+	key := responseQueryKey{InstanceID: instanceID, StudyKey: studyKey, Query: query}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if cached, ok := db.responses[key]; ok {
+		db.stats.CachedLookups += 1
+		return append([]types.SurveyResponse{}, cached...), nil
+	}
+	db.stats.FindSurveyResponses += 1
+	responses, err = db.DBService.FindSurveyResponses(instanceID, studyKey, query)
+	if err != nil {
+		return responses, err
+	}
+	db.responses[key] = append([]types.SurveyResponse{}, responses...)
+	return responses, nil
+}
+
+func (db *EventDBService) FindReports(instanceID string, studyKey string, query studydb.ReportQuery) (reports []types.Report, err error) {
+	key := reportQueryKey{InstanceID: instanceID, StudyKey: studyKey, Query: query}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if cached, ok := db.reports[key]; ok {
+		db.stats.CachedLookups += 1
+		return append([]types.Report{}, cached...), nil
+	}
+	db.stats.FindReports += 1
+	reports, err = db.DBService.FindReports(instanceID, studyKey, query)
+	if err != nil {
+		return reports, err
+	}
+	db.reports[key] = append([]types.Report{}, reports...)
+	return reports, nil
+}
+
+// DeleteConfidentialResponses also drops the cached responses, as they may include the removed ones
+func (db *EventDBService) DeleteConfidentialResponses(instanceID string, studyKey string, participantID string, key string) (count int64, err error) {
+	db.mu.Lock()
+	db.responses = map[responseQueryKey][]types.SurveyResponse{}
+	db.mu.Unlock()
+	return db.DBService.DeleteConfidentialResponses(instanceID, studyKey, participantID, key)
+}
+
+func (db *EventDBService) SaveResearcherMessage(instanceID string, studyKey string, message types.StudyMessage) error {
+	return db.DBService.SaveResearcherMessage(instanceID, studyKey, message)
+}
+
+func (db *EventDBService) AddExternalEventToOutbox(instanceID string, studyKey string, entry types.ExternalEventOutboxEntry) error {
+	odb, ok := db.DBService.(ExternalEventOutboxDBService)
+	if !ok {
+		return errors.New("external event outbox is not supported by the DB service")
+	}
+	return odb.AddExternalEventToOutbox(instanceID, studyKey, entry)
+}
+
+// ReserveRandomizationSlot forwards the reservation and records the slot, so it can be released if the rule fails
+func (db *EventDBService) ReserveRandomizationSlot(instanceID string, studyKey string, randomizationKey string, stratum string, seed int64) (types.RandomizationSlot, error) {
+	rdb, ok := db.DBService.(RandomizationDBService)
+	if !ok {
+		return types.RandomizationSlot{}, errors.New("randomization is not supported by the DB service")
+	}
+	slot, err := rdb.ReserveRandomizationSlot(instanceID, studyKey, randomizationKey, stratum, seed)
+	if err != nil {
+		return slot, err
+	}
+	db.mu.Lock()
+	db.reservedSlots = append(db.reservedSlots, slot)
+	db.mu.Unlock()
+	return slot, nil
+}
+
+// ReservedRandomizationSlots returns the slots reserved for the event so far, also for a nil service
+func (db *EventDBService) ReservedRandomizationSlots() []types.RandomizationSlot {
+	if db == nil {
+		return nil
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]types.RandomizationSlot{}, db.reservedSlots...)
+}
+
+func (db *EventDBService) ReleaseRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	rdb, ok := db.DBService.(RandomizationDBService)
+	if !ok {
+		return errors.New("randomization is not supported by the DB service")
+	}
+	return rdb.ReleaseRandomizationSlot(instanceID, studyKey, slot)
+}
+
+func (db *EventDBService) CommitRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	rdb, ok := db.DBService.(RandomizationDBService)
+	if !ok {
+		return errors.New("randomization is not supported by the DB service")
+	}
+	return rdb.CommitRandomizationSlot(instanceID, studyKey, slot)
+}
+
+func (db *EventDBService) GetRandomizationAllocation(instanceID string, studyKey string, randomizationKey string, stratum string) (types.RandomizationAllocation, error) {
+	rdb, ok := db.DBService.(RandomizationDBService)
+	if !ok {
+		return types.RandomizationAllocation{}, errors.New("randomization is not supported by the DB service")
+	}
+	return rdb.GetRandomizationAllocation(instanceID, studyKey, randomizationKey, stratum)
+}
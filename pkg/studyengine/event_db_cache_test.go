@@ -0,0 +1,185 @@
+package studyengine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/dbs/studydb"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// countingDBService counts the queries reaching the DB and fails them while failing is set
+type countingDBService struct {
+	MockStudyDBService
+	responseQueries int
+	reportQueries   int
+	failing         bool
+}
+
+func (db *countingDBService) FindSurveyResponses(instanceID string, studyKey string, query studydb.ResponseQuery) (responses []types.SurveyResponse, err error) {
+	db.responseQueries += 1
+	if db.failing {
+		return nil, errors.New("connection lost")
+	}
+	return db.MockStudyDBService.FindSurveyResponses(instanceID, studyKey, query)
+}
+
+func (db *countingDBService) FindReports(instanceID string, studyKey string, query studydb.ReportQuery) (reports []types.Report, err error) {
+	db.reportQueries += 1
+	if db.failing {
+		return nil, errors.New("connection lost")
+	}
+	return db.MockStudyDBService.FindReports(instanceID, studyKey, query)
+}
+
+func TestEventDBService(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.ExpressionArg {
+		return types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: name, Data: args}}
+	}
+	setFlagIf := func(condition types.ExpressionArg, flag string) types.Expression {
+		return types.Expression{Name: "IF", Data: []types.ExpressionArg{condition, exp("UPDATE_FLAG", str(flag), str("1"))}}
+	}
+	newDB := func() *countingDBService {
+		return &countingDBService{MockStudyDBService: MockStudyDBService{
+			Responses: []types.SurveyResponse{
+				{Key: "weekly", ParticipantID: "P1", SubmittedAt: 20},
+				{Key: "weekly", ParticipantID: "P1", SubmittedAt: 10},
+			},
+			Reports: []types.Report{
+				{Key: "fever", ParticipantID: "P1", Timestamp: 15},
+			},
+		}}
+	}
+	event := types.StudyEvent{Type: "TIMER", InstanceID: "instance", StudyKey: "study"}
+	state := ActionData{PState: types.ParticipantState{ParticipantID: "P1"}}
+	hasWeekly := exp("checkConditionForOldResponses", exp("checkSurveyResponseKey", str("weekly")), str("any"), str("weekly"))
+	countWeekly := exp("aggregateOldResponses", str("count"), exp("timestampWithOffset", num(0)), str("weekly"))
+
+	t.Run("identical queries of the rules hit the DB once", func(t *testing.T) {
+		db := newDB()
+		rules := []types.Expression{
+			setFlagIf(hasWeekly, "a"),
+			setFlagIf(hasWeekly, "b"),
+			setFlagIf(exp("gt", countWeekly, num(1)), "c"),
+			setFlagIf(exp("gt", exp("countReports", str("fever")), num(0)), "d"),
+			setFlagIf(exp("gt", exp("countReports", str("fever")), num(0)), "e"),
+			setFlagIf(exp("gt", exp("countReports", str("fever"), num(16)), num(0)), "f"),
+		}
+		newState, ruleErrors := PerformRules(rules, state, event, ActionConfigs{DBService: db}, "")
+		if len(ruleErrors) > 0 {
+			t.Fatalf("unexpected errors: %v", ruleErrors)
+		}
+		for flag, expected := range map[string]bool{"a": true, "b": true, "c": true, "d": true, "e": true, "f": false} {
+			if _, ok := newState.PState.Flags[flag]; ok != expected {
+				t.Errorf("unexpected flag %s: %v", flag, newState.PState.Flags)
+			}
+		}
+		if db.responseQueries != 1 {
+			t.Errorf("unexpected number of response queries: %d", db.responseQueries)
+		}
+		if db.reportQueries != 2 {
+			t.Errorf("unexpected number of report queries: %d", db.reportQueries)
+		}
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		configs, eventDB := WithEventDBCache(ActionConfigs{DBService: newDB()})
+		ctx := EvalContext{Event: event, ParticipantState: state.PState, Configs: configs}
+		for i := 0; i < 3; i++ {
+			if _, err := ExpressionEval(*hasWeekly.Exp, ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if _, err := ExpressionEval(types.Expression{Name: "countReports", Data: []types.ExpressionArg{str("fever")}}, ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		stats := eventDB.Stats()
+		if stats.FindSurveyResponses != 1 || stats.FindReports != 1 || stats.CachedLookups != 2 || stats.DBCalls() != 2 {
+			t.Errorf("unexpected stats: %+v", stats)
+		}
+		if total := stats.Add(stats); total.DBCalls() != 4 || total.CachedLookups != 4 {
+			t.Errorf("unexpected sum: %+v", total)
+		}
+	})
+
+	t.Run("failed queries are not cached", func(t *testing.T) {
+		db := newDB()
+		db.failing = true
+		eventDB := NewEventDBService(db)
+		query := studydb.ResponseQuery{ParticipantID: "P1", SurveyKey: "weekly"}
+		if _, err := eventDB.FindSurveyResponses("instance", "study", query); err == nil {
+			t.Error("should return error")
+		}
+		db.failing = false
+		responses, err := eventDB.FindSurveyResponses("instance", "study", query)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(responses) != 2 || db.responseQueries != 2 {
+			t.Errorf("unexpected result: %d responses, %d queries", len(responses), db.responseQueries)
+		}
+	})
+
+	t.Run("deleting confidential responses drops cached responses", func(t *testing.T) {
+		db := newDB()
+		eventDB := NewEventDBService(db)
+		query := studydb.ResponseQuery{ParticipantID: "P1", SurveyKey: "weekly"}
+		if _, err := eventDB.FindSurveyResponses("instance", "study", query); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := eventDB.DeleteConfidentialResponses("instance", "study", "P1", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := eventDB.FindSurveyResponses("instance", "study", query); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.responseQueries != 2 {
+			t.Errorf("unexpected number of response queries: %d", db.responseQueries)
+		}
+	})
+
+	t.Run("wrapped only once", func(t *testing.T) {
+		configs, eventDB := WithEventDBCache(ActionConfigs{DBService: newDB()})
+		configs2, eventDB2 := WithEventDBCache(configs)
+		if eventDB2 != eventDB || configs2.DBService != configs.DBService {
+			t.Error("should reuse the event DB service")
+		}
+	})
+
+	t.Run("without DB service", func(t *testing.T) {
+		configs, eventDB := WithEventDBCache(ActionConfigs{})
+		if configs.DBService != nil || eventDB != nil {
+			t.Error("should not wrap a missing DB service")
+		}
+		if eventDB.Stats().DBCalls() != 0 {
+			t.Error("should have no DB calls")
+		}
+	})
+
+	t.Run("optional interfaces of the wrapped service", func(t *testing.T) {
+		eventDB := NewEventDBService(newDB())
+		if _, err := eventDB.ReserveRandomizationSlot("instance", "study", "main", "", 1); err == nil {
+			t.Error("should return error, randomization is not supported by the mock")
+		}
+		if err := eventDB.AddExternalEventToOutbox("instance", "study", types.ExternalEventOutboxEntry{}); err == nil {
+			t.Error("should return error, the outbox is not supported by the mock")
+		}
+	})
+
+	t.Run("dry run reports the DB calls", func(t *testing.T) {
+		rule := setFlagIf(hasWeekly, "a")
+		result := DryRunRules([]*types.Expression{&rule, &rule}, state.PState, event, ActionConfigs{DBService: newDB()})
+		if result.Error != "" {
+			t.Fatalf("unexpected error: %s", result.Error)
+		}
+		if result.DBCalls.FindSurveyResponses != 1 || result.DBCalls.CachedLookups != 1 {
+			t.Errorf("unexpected DB calls: %+v", result.DBCalls)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -22,126 +23,35 @@ type EvalContext struct {
 }
 
 func ExpressionEval(expression types.Expression, evalCtx EvalContext) (val interface{}, err error) {
-	switch expression.Name {
-	case "checkEventType":
-		val, err = evalCtx.checkEventType(expression)
-	// Response checkers:
-	case "checkSurveyResponseKey":
-		val, err = evalCtx.checkSurveyResponseKey(expression)
-	case "responseHasKeysAny":
-		val, err = evalCtx.responseHasKeysAny(expression)
-	case "responseHasOnlyKeysOtherThan":
-		val, err = evalCtx.responseHasOnlyKeysOtherThan(expression)
-	case "getResponseValueAsNum":
-		val, err = evalCtx.getResponseValueAsNum(expression)
-	case "getResponseValueAsStr":
-		val, err = evalCtx.getResponseValueAsStr(expression)
-	case "getSelectedKeys":
-		val, err = evalCtx.getSelectedKeys(expression)
-	case "countResponseItems":
-		val, err = evalCtx.countResponseItems(expression)
-	case "hasResponseKey":
-		val, err = evalCtx.hasResponseKey(expression)
-	case "hasResponseKeyWithValue":
-		val, err = evalCtx.hasResponseKeyWithValue(expression)
-	// Old responses:
-	case "checkConditionForOldResponses":
-		val, err = evalCtx.checkConditionForOldResponses(expression)
-	// Participant state:
-	case "getStudyEntryTime":
-		val, err = evalCtx.getStudyEntryTime(expression, false)
-	case "hasSurveyKeyAssigned":
-		val, err = evalCtx.hasSurveyKeyAssigned(expression, false)
-	case "getSurveyKeyAssignedFrom":
-		val, err = evalCtx.getSurveyKeyAssignedFrom(expression, false)
-	case "getSurveyKeyAssignedUntil":
-		val, err = evalCtx.getSurveyKeyAssignedUntil(expression, false)
-	case "hasStudyStatus":
-		val, err = evalCtx.hasStudyStatus(expression, false)
-	case "hasParticipantFlag":
-		val, err = evalCtx.hasParticipantFlag(expression, false)
-	case "hasParticipantFlagKey":
-		val, err = evalCtx.hasParticipantFlagKey(expression, false)
-	case "getParticipantFlagValue":
-		val, err = evalCtx.getParticipantFlagValue(expression, false)
-	case "getLastSubmissionDate":
-		val, err = evalCtx.getLastSubmissionDate(expression, false)
-	case "lastSubmissionDateOlderThan":
-		val, err = evalCtx.lastSubmissionDateOlderThan(expression, false)
-	case "hasMessageTypeAssigned":
-		val, err = evalCtx.hasMessageTypeAssigned(expression, false)
-	case "getMessageNextTime":
-		val, err = evalCtx.getMessageNextTime(expression, false)
-	// exprssions for merge participant states:
-	case "incomingState:getStudyEntryTime":
-		val, err = evalCtx.getStudyEntryTime(expression, true)
-	case "incomingState:hasSurveyKeyAssigned":
-		val, err = evalCtx.hasSurveyKeyAssigned(expression, true)
-	case "incomingState:getSurveyKeyAssignedFrom":
-		val, err = evalCtx.getSurveyKeyAssignedFrom(expression, true)
-	case "incomingState:getSurveyKeyAssignedUntil":
-		val, err = evalCtx.getSurveyKeyAssignedUntil(expression, true)
-	case "incomingState:hasStudyStatus":
-		val, err = evalCtx.hasStudyStatus(expression, true)
-	case "incomingState:hasParticipantFlag":
-		val, err = evalCtx.hasParticipantFlag(expression, true)
-	case "incomingState:hasParticipantFlagKey":
-		val, err = evalCtx.hasParticipantFlagKey(expression, true)
-	case "incomingState:getParticipantFlagValue":
-		val, err = evalCtx.getParticipantFlagValue(expression, true)
-	case "incomingState:lastSubmissionDateOlderThan":
-		val, err = evalCtx.lastSubmissionDateOlderThan(expression, true)
-	case "incomingState:hasMessageTypeAssigned":
-		val, err = evalCtx.hasMessageTypeAssigned(expression, true)
-	case "incomingState:getMessageNextTime":
-		val, err = evalCtx.getMessageNextTime(expression, true)
-	// Logical and comparisions:
-	case "eq":
-		val, err = evalCtx.eq(expression)
-	case "lt":
-		val, err = evalCtx.lt(expression)
-	case "lte":
-		val, err = evalCtx.lte(expression)
-	case "gt":
-		val, err = evalCtx.gt(expression)
-	case "gte":
-		val, err = evalCtx.gte(expression)
-	case "and":
-		val, err = evalCtx.and(expression)
-	case "or":
-		val, err = evalCtx.or(expression)
-	case "not":
-		val, err = evalCtx.not(expression)
-	
-	// Arithmetics operators
-	case "sum":
-		val, err = evalCtx.sum(expression)
-
-	case "neg":
-		val, err = evalCtx.neg(expression)
-
-	// Other
-	case "timestampWithOffset":
-		val, err = evalCtx.timestampWithOffset(expression)
-	case "getISOWeekForTs":
-		val, err = evalCtx.getISOWeekForTs(expression)
-	case "getTsForNextISOWeek":
-		val, err = evalCtx.getTsForNextISOWeek(expression)
-	case "parseValueAsNum":
-		val, err = evalCtx.parseValueAsNum(expression)
-	case "generateRandomNumber":
-		val, err = evalCtx.generateRandomNumber(expression)
-	case "externalEventEval":
-		val, err = evalCtx.externalEventEval(expression)
-	default:
+	traceNode := evalCtx.Configs.Tracer.begin(TRACE_NODE_KIND_EXPRESSION, expression.Name)
+	defer func() {
+		evalCtx.Configs.Tracer.end(traceNode, val, err)
+	}()
+	if err = evalCtx.Configs.Budget.enter(expression.Name); err != nil {
+		return
+	}
+	defer evalCtx.Configs.Budget.exit()
+
+	handler, ok := compiledExpressionHandler(expression)
+	if !ok {
 		err = fmt.Errorf("expression name not known: %s", expression.Name)
 		logger.Debug.Println(err)
 		return
 	}
-	return
+	return handler(evalCtx, expression)
 }
 
-func (ctx EvalContext) expressionArgResolver(arg types.ExpressionArg) (interface{}, error) {
+func (ctx EvalContext) expressionArgResolver(arg types.ExpressionArg) (val interface{}, err error) {
+	defer func() {
+		if err == nil {
+			ctx.Configs.Tracer.addArg(val)
+		}
+	}()
+
+	if arg.Value != nil {
+		// literal resolved when the rules were compiled
+		return arg.Value, nil
+	}
 	switch arg.DType {
 	case "num":
 		return arg.Num, nil
@@ -298,6 +208,11 @@ func (ctx EvalContext) checkConditionForOldResponses(exp types.Expression) (val
 			Event: types.StudyEvent{
 				Response: resp,
 			},
+			Configs: ActionConfigs{
+				Tracer:    ctx.Configs.Tracer,
+				Variables: ctx.Configs.Variables,
+				Budget:    ctx.Configs.Budget,
+			},
 		}
 
 		expResult, err := ExpressionEval(*condition, oldEvalContext)
@@ -479,6 +394,39 @@ func (ctx EvalContext) getParticipantFlagValue(exp types.Expression, withIncomin
 	return res, nil
 }
 
+// getParticipantFlagKeys returns the sorted keys of the participant flags starting with the optional prefix, as a semicolon separated list
+func (ctx EvalContext) getParticipantFlagKeys(exp types.Expression, withIncomingParticipantState bool) (val string, err error) {
+	pState := ctx.ParticipantState
+	if withIncomingParticipantState {
+		pState = ctx.Event.MergeWithParticipant
+	}
+	if len(exp.Data) > 1 {
+		return val, errors.New("unexpected numbers of arguments")
+	}
+
+	prefix := ""
+	if len(exp.Data) == 1 {
+		arg1, err := ctx.expressionArgResolver(exp.Data[0])
+		if err != nil {
+			return val, err
+		}
+		arg1Val, ok := arg1.(string)
+		if !ok {
+			return val, errors.New("could not cast argument 1")
+		}
+		prefix = arg1Val
+	}
+
+	keys := []string{}
+	for k := range pState.Flags {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, LIST_SEPARATOR), nil
+}
+
 func (ctx EvalContext) hasParticipantFlag(exp types.Expression, withIncomingParticipantState bool) (val bool, err error) {
 	pState := ctx.ParticipantState
 	if withIncomingParticipantState {
@@ -1419,15 +1367,19 @@ func (ctx EvalContext) externalEventEval(exp types.Expression) (val interface{},
 		return val, err
 	}
 
+	route := ""
 	if len(exp.Data) > 1 {
 		arg1, err := ctx.expressionArgResolver(exp.Data[1])
 		if err != nil {
 			return val, err
 		}
 
-		route := arg1.(string)
+		route = arg1.(string)
 		route = strings.TrimPrefix(route, "/")
-		serviceConfig.URL = fmt.Sprintf("%s/%s", serviceConfig.URL, route)
+	}
+	fallback, err := ctx.externalServiceFallbackArg(exp.Data, EXTERNAL_SERVICE_FALLBACK_FALSE)
+	if err != nil {
+		return val, err
 	}
 
 	payload := ExternalEventPayload{
@@ -1438,23 +1390,28 @@ func (ctx EvalContext) externalEventEval(exp types.Expression) (val interface{},
 		Response:         ctx.Event.Response,
 	}
 
-	clientConf := ClientConfig{
-		APIKey:     serviceConfig.APIKey,
-		Timeout:    time.Duration(serviceConfig.Timeout) * time.Second,
-		mTLSConfig: serviceConfig.MutualTLSConfig,
-	}
-	response, err := runHTTPcall(serviceConfig.URL, payload, clientConf)
+	response, err := callExternalService(ctx.Configs.Budget.Context(), serviceConfig, route, payload, externalServiceEvalExpression)
 	if err != nil {
 		logger.Error.Println(err)
+		if budgetErr := ctx.Configs.Budget.Err(); budgetErr != nil {
+			// the fallback does not apply when the evaluation is aborted
+			return val, budgetErr
+		}
+		if fallback == EXTERNAL_SERVICE_FALLBACK_FALSE {
+			return false, nil
+		}
 		return val, err
 	}
 
 	logger.Debug.Printf("%s replied: %v", serviceName, response)
 
-	// if relevant, update participant state:
-	value := response["value"]
+	value := response.Value
 	if exp.ReturnType == "float" {
-		return value.(float64), nil
+		floatValue, ok := value.(float64)
+		if !ok {
+			return val, fmt.Errorf("%s did not reply with a number value", serviceName)
+		}
+		return floatValue, nil
 	}
 	return value, nil
 }
@@ -1470,3 +1427,15 @@ func (ctx EvalContext) mustGetStrValue(arg types.ExpressionArg) (string, error)
 	}
 	return val, nil
 }
+
+func (ctx EvalContext) mustGetNumValue(arg types.ExpressionArg) (float64, error) {
+	arg1, err := ctx.expressionArgResolver(arg)
+	if err != nil {
+		return 0, err
+	}
+	val, ok := arg1.(float64)
+	if !ok {
+		return 0, errors.New("argument should be resolved as type number (float64)")
+	}
+	return val, nil
+}
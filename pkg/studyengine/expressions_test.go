@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 	"fmt"
+	"sort"
 	"github.com/influenzanet/study-service/pkg/dbs/studydb"
 	"github.com/influenzanet/study-service/pkg/types"
 )
@@ -141,6 +142,7 @@ func TestEvalHasStudyStatus(t *testing.T) {
 
 type MockStudyDBService struct {
 	Responses []types.SurveyResponse
+	Reports   []types.Report
 }
 
 func (db MockStudyDBService) FindSurveyResponses(instanceID string, studyKey string, query studydb.ResponseQuery) (responses []types.SurveyResponse, err error) {
@@ -159,10 +161,35 @@ func (db MockStudyDBService) FindSurveyResponses(instanceID string, studyKey str
 		}
 		responses = append(responses, r)
 	}
+	if query.Limit > 0 && len(responses) > int(query.Limit) {
+		responses = responses[:query.Limit]
+	}
 
 	return responses, nil
 }
 
+func (db MockStudyDBService) FindReports(instanceID string, studyKey string, query studydb.ReportQuery) (reports []types.Report, err error) {
+	for _, r := range db.Reports {
+		if query.ParticipantID != r.ParticipantID {
+			continue
+		}
+		if len(query.Key) > 0 && query.Key != r.Key {
+			continue
+		}
+		if query.Since > 0 && r.Timestamp <= query.Since {
+			continue
+		}
+		if query.Until > 0 && r.Timestamp >= query.Until {
+			continue
+		}
+		reports = append(reports, r)
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Timestamp > reports[j].Timestamp
+	})
+	return reports, nil
+}
+
 func (db MockStudyDBService) DeleteConfidentialResponses(instanceID string, studyKey string, participantID string, key string) (count int64, err error) {
 	return
 }
@@ -0,0 +1,310 @@
+package studyengine
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/studyengine/externalgrpc"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	// headers of signed requests to external services, the signature is computed over "<timestamp>.<nonce>.<body>"
+	EXTERNAL_SERVICE_TIMESTAMP_HEADER = "X-Signature-Timestamp"
+	EXTERNAL_SERVICE_NONCE_HEADER     = "X-Signature-Nonce"
+	EXTERNAL_SERVICE_SIGNATURE_HEADER = "X-Signature"
+	EXTERNAL_SERVICE_SIGNATURE_PREFIX = "sha256="
+
+	maxExternalServiceReplySize = 10 << 20
+)
+
+// ExternalServiceReply is the accepted reply of an external service, other fields are ignored or rejected with strictReply
+type ExternalServiceReply struct {
+	PState          *types.ParticipantState `json:"pState,omitempty"`
+	ReportsToCreate map[string]types.Report `json:"reportsToCreate,omitempty"`
+	Value           interface{}             `json:"value,omitempty"`
+}
+
+var errInvalidExternalServiceReply = errors.New("invalid reply")
+
+type externalServiceMethod int
+
+const (
+	externalServiceHandleEvent externalServiceMethod = iota
+	externalServiceEvalExpression
+)
+
+// callExternalService sends the payload to the service with the configured protocol and returns the validated reply.
+// Calls are rejected with ErrCircuitBreakerOpen while the circuit breaker of the service is open.
+// The call is cancelled with ctx (e.g. at the deadline of the evaluation), in addition to the timeout of the service.
+func callExternalService(ctx context.Context, serviceConfig types.ExternalService, route string, payload ExternalEventPayload, method externalServiceMethod) (reply ExternalServiceReply, err error) {
+	if err := ctx.Err(); err != nil {
+		// not counted as failure of the service
+		return reply, err
+	}
+	err = withCircuitBreaker(ctx, serviceConfig, func() error {
+		switch serviceConfig.Protocol {
+		case "", types.EXTERNAL_SERVICE_PROTOCOL_HTTP:
+			reply, err = runHTTPcall(ctx, externalServiceURL(serviceConfig, route), payload, newClientConfig(serviceConfig))
+		case types.EXTERNAL_SERVICE_PROTOCOL_GRPC:
+			reply, err = runGRPCcall(ctx, serviceConfig, route, payload, method)
+		default:
+			err = fmt.Errorf("unknown protocol for external service '%s': %s", serviceConfig.Name, serviceConfig.Protocol)
+		}
+		return err
+	})
+	return reply, err
+}
+
+func externalServiceURL(serviceConfig types.ExternalService, route string) string {
+	if route == "" {
+		return serviceConfig.URL
+	}
+	return fmt.Sprintf("%s/%s", serviceConfig.URL, route)
+}
+
+// decodeExternalServiceReply decodes the reply and checks it belongs to the participant the event was sent for.
+// Values of the wrong type are always rejected, unknown fields only if strict is set.
+func decodeExternalServiceReply(body io.Reader, participantID string, strict bool) (reply ExternalServiceReply, err error) {
+	if err := decodeReplyJSON(body, &reply, strict); err != nil {
+		return ExternalServiceReply{}, fmt.Errorf("%w: %v", errInvalidExternalServiceReply, err)
+	}
+	if err := reply.validate(participantID); err != nil {
+		return ExternalServiceReply{}, err
+	}
+	return reply, nil
+}
+
+func decodeReplyJSON(body io.Reader, v interface{}, strict bool) error {
+	dec := json.NewDecoder(io.LimitReader(body, maxExternalServiceReplySize))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after the object")
+	}
+	return nil
+}
+
+func (reply ExternalServiceReply) validate(participantID string) error {
+	if reply.PState != nil && reply.PState.ParticipantID != participantID {
+		return fmt.Errorf("%w: pState is for a different participant", errInvalidExternalServiceReply)
+	}
+	for key, report := range reply.ReportsToCreate {
+		if key == "" || report.Key == "" {
+			return fmt.Errorf("%w: report without key", errInvalidExternalServiceReply)
+		}
+		if report.ParticipantID != "" && report.ParticipantID != participantID {
+			return fmt.Errorf("%w: report '%s' is for a different participant", errInvalidExternalServiceReply, key)
+		}
+	}
+	switch reply.Value.(type) {
+	case nil, string, float64, bool:
+	default:
+		return fmt.Errorf("%w: value must be a string, number or boolean", errInvalidExternalServiceReply)
+	}
+	return nil
+}
+
+type externalGRPCClientKey struct {
+	URL    string
+	APIKey string
+	TLS    types.MutualTLSConfig
+}
+
+// gRPC clients of the external services, one per service config. The connection of a client is shared by all calls
+// and reconnects by itself, so clients are kept for the lifetime of the service.
+var externalGRPCClients = struct {
+	sync.Mutex
+	byConfig map[externalGRPCClientKey]*externalgrpc.Client
+}{byConfig: map[externalGRPCClientKey]*externalgrpc.Client{}}
+
+// getExternalGRPCClient returns the client for the service config, created with the first call
+func getExternalGRPCClient(serviceConfig types.ExternalService) (*externalgrpc.Client, error) {
+	key := externalGRPCClientKey{URL: serviceConfig.URL, APIKey: serviceConfig.APIKey}
+	var tlsConfig *externalgrpc.TLSConfig
+	if serviceConfig.MutualTLSConfig != nil {
+		key.TLS = *serviceConfig.MutualTLSConfig
+		tlsConfig = &externalgrpc.TLSConfig{
+			CertFile: serviceConfig.MutualTLSConfig.CertFile,
+			KeyFile:  serviceConfig.MutualTLSConfig.KeyFile,
+			CAFile:   serviceConfig.MutualTLSConfig.CAFile,
+		}
+	}
+
+	externalGRPCClients.Lock()
+	defer externalGRPCClients.Unlock()
+	if client, ok := externalGRPCClients.byConfig[key]; ok {
+		return client, nil
+	}
+	client, err := externalgrpc.NewClient(serviceConfig.URL, serviceConfig.APIKey, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	externalGRPCClients.byConfig[key] = client
+	return client, nil
+}
+
+func runGRPCcall(ctx context.Context, serviceConfig types.ExternalService, route string, payload ExternalEventPayload, method externalServiceMethod) (reply ExternalServiceReply, err error) {
+	if serviceConfig.SigningSecret != "" {
+		return reply, fmt.Errorf("external service '%s': signingSecret is not supported with protocol grpc, use mTLS instead", serviceConfig.Name)
+	}
+	client, err := getExternalGRPCClient(serviceConfig)
+	if err != nil {
+		return reply, err
+	}
+
+	req := &externalgrpc.ExternalEventRequest{
+		InstanceId: payload.InstanceID,
+		StudyKey:   payload.StudyKey,
+		EventType:  payload.EventType,
+		Route:      route,
+	}
+	if req.ParticipantState, err = json.Marshal(payload.ParticipantState); err != nil {
+		return reply, err
+	}
+	if req.SurveyResponse, err = json.Marshal(payload.Response); err != nil {
+		return reply, err
+	}
+
+	if serviceConfig.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(serviceConfig.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	switch method {
+	case externalServiceEvalExpression:
+		resp, err := client.EvalExpression(ctx, req)
+		if err != nil {
+			return reply, err
+		}
+		reply.Value = resp.ValueOf()
+	default:
+		resp, err := client.HandleEvent(ctx, req)
+		if err != nil {
+			return reply, err
+		}
+		if len(resp.ParticipantState) > 0 {
+			reply.PState = &types.ParticipantState{}
+			if err := decodeReplyJSON(bytes.NewReader(resp.ParticipantState), reply.PState, serviceConfig.StrictReply); err != nil {
+				return ExternalServiceReply{}, fmt.Errorf("%w: %v", errInvalidExternalServiceReply, err)
+			}
+		}
+		for key, data := range resp.ReportsToCreate {
+			var report types.Report
+			if err := decodeReplyJSON(bytes.NewReader(data), &report, serviceConfig.StrictReply); err != nil {
+				return ExternalServiceReply{}, fmt.Errorf("%w: %v", errInvalidExternalServiceReply, err)
+			}
+			if reply.ReportsToCreate == nil {
+				reply.ReportsToCreate = map[string]types.Report{}
+			}
+			reply.ReportsToCreate[key] = report
+		}
+	}
+	if err := reply.validate(payload.ParticipantState.ParticipantID); err != nil {
+		return ExternalServiceReply{}, err
+	}
+	return reply, nil
+}
+
+// signExternalServiceRequest returns the signature header value for the body sent at the timestamp with the nonce
+func signExternalServiceRequest(secret string, timestamp int64, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return EXTERNAL_SERVICE_SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSignatureNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// VerifyExternalServiceSignature can be used by receivers of external events to check the signature headers of a request.
+// Requests with a timestamp further than maxAge from now are rejected. A captured request can still be replayed within maxAge,
+// use ExternalServiceSignatureVerifier to also reject nonces seen before.
+func VerifyExternalServiceSignature(secret string, body []byte, timestampHeader string, nonceHeader string, signatureHeader string, maxAge time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return errors.New("invalid signature timestamp")
+	}
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > maxAge || age < -maxAge {
+		return errors.New("signature timestamp outside of the accepted window")
+	}
+	if nonceHeader == "" {
+		return errors.New("missing signature nonce")
+	}
+	if !strings.HasPrefix(signatureHeader, EXTERNAL_SERVICE_SIGNATURE_PREFIX) {
+		return errors.New("unsupported signature")
+	}
+	expected := signExternalServiceRequest(secret, timestamp, nonceHeader, body)
+	if !hmac.Equal([]byte(expected), []byte(signatureHeader)) {
+		return errors.New("signature does not match")
+	}
+	return nil
+}
+
+// ExternalServiceSignatureVerifier checks the signature headers of requests and rejects replays: the nonces of accepted
+// requests are kept until their timestamp is outside of MaxAge. The nonces are kept in memory, so replicas of a receiver
+// only detect replays of requests they accepted themselves.
+type ExternalServiceSignatureVerifier struct {
+	Secret string
+	MaxAge time.Duration
+
+	mu     sync.Mutex
+	nonces map[string]time.Time // nonce -> timestamp of the request
+}
+
+func NewExternalServiceSignatureVerifier(secret string, maxAge time.Duration) *ExternalServiceSignatureVerifier {
+	return &ExternalServiceSignatureVerifier{
+		Secret: secret,
+		MaxAge: maxAge,
+		nonces: map[string]time.Time{},
+	}
+}
+
+// Verify checks the signature of the request body and headers at now, a nonce is accepted only once
+func (v *ExternalServiceSignatureVerifier) Verify(body []byte, header http.Header, now time.Time) error {
+	nonce := header.Get(EXTERNAL_SERVICE_NONCE_HEADER)
+	if err := VerifyExternalServiceSignature(v.Secret, body, header.Get(EXTERNAL_SERVICE_TIMESTAMP_HEADER), nonce, header.Get(EXTERNAL_SERVICE_SIGNATURE_HEADER), v.MaxAge, now); err != nil {
+		return err
+	}
+	timestamp, _ := strconv.ParseInt(header.Get(EXTERNAL_SERVICE_TIMESTAMP_HEADER), 10, 64)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for n, t := range v.nonces {
+		if now.Sub(t) > v.MaxAge {
+			delete(v.nonces, n)
+		}
+	}
+	if _, seen := v.nonces[nonce]; seen {
+		return errors.New("signature nonce already used")
+	}
+	v.nonces[nonce] = time.Unix(timestamp, 0)
+	return nil
+}
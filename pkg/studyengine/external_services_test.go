@@ -0,0 +1,297 @@
+package studyengine
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/studyengine/externalgrpc"
+	"github.com/influenzanet/study-service/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDecodeExternalServiceReply(t *testing.T) {
+	t.Run("valid reply", func(t *testing.T) {
+		reply, err := decodeExternalServiceReply(strings.NewReader(`{
+			"pState": {"participantID": "p1", "studyStatus": "active", "flags": {"a": "1"}},
+			"reportsToCreate": {"r1": {"key": "r1", "data": [{"key": "k", "value": "v"}]}},
+			"value": 4
+		}`), "p1", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if reply.PState == nil || reply.PState.Flags["a"] != "1" || reply.ReportsToCreate["r1"].Data[0].Value != "v" || reply.Value.(float64) != 4 {
+			t.Errorf("unexpected reply: %v", reply)
+		}
+	})
+
+	t.Run("empty reply", func(t *testing.T) {
+		reply, err := decodeExternalServiceReply(strings.NewReader(`{}`), "p1", true)
+		if err != nil || reply.PState != nil || reply.Value != nil {
+			t.Errorf("unexpected result: %v, %v", reply, err)
+		}
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		body := `{"status": "ok", "pState": {"participantID": "p1", "extra": 1}, "value": true}`
+		reply, err := decodeExternalServiceReply(strings.NewReader(body), "p1", false)
+		if err != nil || reply.PState == nil || reply.Value != true {
+			t.Errorf("unknown fields should be ignored: %v, %v", reply, err)
+		}
+		if _, err := decodeExternalServiceReply(strings.NewReader(body), "p1", true); err == nil {
+			t.Error("should reject unknown fields with strictReply")
+		}
+	})
+
+	for _, tc := range []struct {
+		name string
+		body string
+	}{
+		{name: "malformed json", body: `{"value": `},
+		{name: "wrong pState type", body: `{"pState": "active"}`},
+		{name: "pState of other participant", body: `{"pState": {"participantID": "p2"}}`},
+		{name: "report without key", body: `{"reportsToCreate": {"r1": {"data": []}}}`},
+		{name: "report of other participant", body: `{"reportsToCreate": {"r1": {"key": "r1", "participantID": "p2"}}}`},
+		{name: "object value", body: `{"value": {"a": 1}}`},
+		{name: "trailing data", body: `{"value": 1} {"value": 2}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := decodeExternalServiceReply(strings.NewReader(tc.body), "p1", false); err == nil {
+				t.Error("should return an error")
+			}
+		})
+	}
+}
+
+func TestExternalServiceSignature(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"eventType":"SUBMIT"}`)
+	signature := signExternalServiceRequest("secret", now.Unix(), "n1", body)
+
+	if err := VerifyExternalServiceSignature("secret", body, "1700000000", "n1", signature, 5*time.Minute, now.Add(time.Minute)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := VerifyExternalServiceSignature("other", body, "1700000000", "n1", signature, 5*time.Minute, now); err == nil {
+		t.Error("should reject wrong secret")
+	}
+	if err := VerifyExternalServiceSignature("secret", []byte(`{"eventType":"ENTER"}`), "1700000000", "n1", signature, 5*time.Minute, now); err == nil {
+		t.Error("should reject modified body")
+	}
+	if err := VerifyExternalServiceSignature("secret", body, "1700000000", "n1", signature, 5*time.Minute, now.Add(time.Hour)); err == nil {
+		t.Error("should reject old timestamp")
+	}
+	if err := VerifyExternalServiceSignature("secret", body, "1700000001", "n1", signature, 5*time.Minute, now); err == nil {
+		t.Error("should reject modified timestamp")
+	}
+	if err := VerifyExternalServiceSignature("secret", body, "1700000000", "n2", signature, 5*time.Minute, now); err == nil {
+		t.Error("should reject modified nonce")
+	}
+
+	t.Run("replayed request", func(t *testing.T) {
+		verifier := NewExternalServiceSignatureVerifier("secret", 5*time.Minute)
+		header := http.Header{}
+		header.Set(EXTERNAL_SERVICE_TIMESTAMP_HEADER, "1700000000")
+		header.Set(EXTERNAL_SERVICE_NONCE_HEADER, "n1")
+		header.Set(EXTERNAL_SERVICE_SIGNATURE_HEADER, signature)
+		if err := verifier.Verify(body, header, now); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := verifier.Verify(body, header, now.Add(time.Minute)); err == nil {
+			t.Error("should reject a nonce used before")
+		}
+		// expired nonces are removed, the request is rejected because of its timestamp then
+		if err := verifier.Verify(body, header, now.Add(time.Hour)); err == nil || len(verifier.nonces) != 1 {
+			t.Errorf("unexpected result: %v, %v", err, verifier.nonces)
+		}
+	})
+}
+
+func TestExternalEventHandlerReply(t *testing.T) {
+	replies := map[string]string{
+		"/state":  `{"pState": {"participantID": "p1", "studyStatus": "active", "flags": {"external": "yes"}}, "reportsToCreate": {"ext": {"key": "ext"}}}`,
+		"/wrong":  `{"pState": {"participantID": "p1", "flags": "yes"}}`,
+		"/status": `{"unexpected": true}`,
+	}
+	verifier := NewExternalServiceSignatureVerifier("secret", time.Minute)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := verifier.Verify(body, r.Header, time.Now()); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(replies[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	configs := ActionConfigs{
+		ExternalServiceConfigs: []types.ExternalService{
+			{Name: "service", URL: server.URL, SigningSecret: "secret", Timeout: 5},
+			{Name: "strict", URL: server.URL, SigningSecret: "secret", Timeout: 5, StrictReply: true},
+		},
+	}
+	action := func(route string) types.Expression {
+		return types.Expression{Name: "EXTERNAL_EVENT_HANDLER", Data: []types.ExpressionArg{
+			{DType: "str", Str: "service"},
+			{DType: "str", Str: route},
+		}}
+	}
+	strictAction := func(route string) types.Expression {
+		exp := action(route)
+		exp.Data[0].Str = "strict"
+		return exp
+	}
+	oldState := ActionData{PState: types.ParticipantState{ParticipantID: "p1", StudyStatus: "active"}}
+
+	t.Run("state and reports from reply", func(t *testing.T) {
+		newState, err := ActionEval(action("state"), oldState, types.StudyEvent{Type: "ENTER"}, configs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["external"] != "yes" {
+			t.Errorf("unexpected state: %v", newState.PState)
+		}
+		if report, ok := newState.ReportsToCreate["ext"]; !ok || report.ParticipantID != "p1" {
+			t.Errorf("unexpected reports: %v", newState.ReportsToCreate)
+		}
+	})
+
+	t.Run("malformed reply", func(t *testing.T) {
+		if _, err := ActionEval(action("wrong"), oldState, types.StudyEvent{Type: "ENTER"}, configs); err == nil {
+			t.Error("should return an error for a wrong type")
+		}
+		if _, err := ActionEval(strictAction("wrong"), oldState, types.StudyEvent{Type: "ENTER"}, configs); err == nil {
+			t.Error("should return an error for a wrong type")
+		}
+	})
+
+	t.Run("unknown fields in reply", func(t *testing.T) {
+		if _, err := ActionEval(action("status"), oldState, types.StudyEvent{Type: "ENTER"}, configs); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if _, err := ActionEval(strictAction("status"), oldState, types.StudyEvent{Type: "ENTER"}, configs); err == nil {
+			t.Error("should return an error with strictReply")
+		}
+	})
+}
+
+type testExternalGRPCService struct {
+	externalgrpc.UnimplementedExternalStudyEngineServiceServer
+}
+
+func (testExternalGRPCService) HandleEvent(ctx context.Context, req *externalgrpc.ExternalEventRequest) (*externalgrpc.HandleEventReply, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(externalgrpc.METADATA_KEY_API_KEY); len(keys) != 1 || keys[0] != "key" {
+		return nil, errors.New("wrong api key")
+	}
+	switch req.Route {
+	case "state":
+		return &externalgrpc.HandleEventReply{
+			ParticipantState: []byte(`{"participantID": "p1", "studyStatus": "active", "flags": {"event": "` + req.EventType + `"}}`),
+			ReportsToCreate:  map[string][]byte{"ext": []byte(`{"key": "ext"}`)},
+		}, nil
+	case "wrong":
+		return &externalgrpc.HandleEventReply{ParticipantState: []byte(`{"participantID": "p2"}`)}, nil
+	}
+	return &externalgrpc.HandleEventReply{}, nil
+}
+
+func (testExternalGRPCService) EvalExpression(ctx context.Context, req *externalgrpc.ExternalEventRequest) (*externalgrpc.EvalExpressionReply, error) {
+	return &externalgrpc.EvalExpressionReply{Value: &externalgrpc.EvalExpressionReply_NumValue{NumValue: float64(len(req.ParticipantState))}}, nil
+}
+
+func TestExternalServiceGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := grpc.NewServer()
+	externalgrpc.RegisterExternalStudyEngineServiceServer(server, testExternalGRPCService{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	configs := ActionConfigs{
+		ExternalServiceConfigs: []types.ExternalService{
+			{Name: "service", URL: lis.Addr().String(), APIKey: "key", Timeout: 5, Protocol: types.EXTERNAL_SERVICE_PROTOCOL_GRPC},
+		},
+	}
+	action := func(route string) types.Expression {
+		return types.Expression{Name: "EXTERNAL_EVENT_HANDLER", Data: []types.ExpressionArg{
+			{DType: "str", Str: "service"},
+			{DType: "str", Str: route},
+		}}
+	}
+	oldState := ActionData{PState: types.ParticipantState{ParticipantID: "p1", StudyStatus: "active"}}
+
+	t.Run("handle event", func(t *testing.T) {
+		newState, err := ActionEval(action("/state"), oldState, types.StudyEvent{Type: "ENTER"}, configs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["event"] != "ENTER" {
+			t.Errorf("unexpected state: %v", newState.PState)
+		}
+		if report, ok := newState.ReportsToCreate["ext"]; !ok || report.ParticipantID != "p1" {
+			t.Errorf("unexpected reports: %v", newState.ReportsToCreate)
+		}
+	})
+
+	t.Run("invalid reply", func(t *testing.T) {
+		if _, err := ActionEval(action("wrong"), oldState, types.StudyEvent{Type: "ENTER"}, configs); err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("eval expression", func(t *testing.T) {
+		exp := types.Expression{Name: "externalEventEval", ReturnType: "float", Data: []types.ExpressionArg{
+			{DType: "str", Str: "service"},
+		}}
+		val, err := ExpressionEval(exp, EvalContext{ParticipantState: oldState.PState, Configs: configs})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, ok := val.(float64); !ok || v <= 0 {
+			t.Errorf("unexpected value: %v", val)
+		}
+	})
+
+	t.Run("async delivery", func(t *testing.T) {
+		entry := types.ExternalEventOutboxEntry{ServiceName: "service", Route: "wrong", Payload: ExternalEventPayload{ParticipantState: oldState.PState}}
+		if err := DeliverExternalEvent(context.Background(), configs.ExternalServiceConfigs, entry); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		noKey := []types.ExternalService{{Name: "service", URL: lis.Addr().String(), Protocol: types.EXTERNAL_SERVICE_PROTOCOL_GRPC}}
+		if err := DeliverExternalEvent(context.Background(), noKey, entry); err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("client per service config", func(t *testing.T) {
+		first, err := getExternalGRPCClient(configs.ExternalServiceConfigs[0])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second, err := getExternalGRPCClient(configs.ExternalServiceConfigs[0])
+		if err != nil || first != second {
+			t.Errorf("the client should be reused: %v", err)
+		}
+		other, err := getExternalGRPCClient(types.ExternalService{Name: "service", URL: lis.Addr().String(), Protocol: types.EXTERNAL_SERVICE_PROTOCOL_GRPC})
+		if err != nil || other == first {
+			t.Errorf("a different config should get its own client: %v", err)
+		}
+	})
+
+	t.Run("signing secret is rejected", func(t *testing.T) {
+		signed := []types.ExternalService{{Name: "service", URL: lis.Addr().String(), APIKey: "key", SigningSecret: "secret", Protocol: types.EXTERNAL_SERVICE_PROTOCOL_GRPC}}
+		entry := types.ExternalEventOutboxEntry{ServiceName: "service", Payload: ExternalEventPayload{ParticipantState: oldState.PState}}
+		if err := DeliverExternalEvent(context.Background(), signed, entry); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package externalgrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// The messages and the service stubs are generated from api/external_study_engine/external-study-engine.proto (make api).
+
+const (
+	// metadata key carrying the apiKey of the service config
+	METADATA_KEY_API_KEY = "api-key"
+)
+
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// Client calls an external service implementing ExternalStudyEngineService
+type Client struct {
+	conn   *grpc.ClientConn
+	client ExternalStudyEngineServiceClient
+	apiKey string
+}
+
+// NewClient returns a client for the service at addr. The connection is established with the first call and reused by later calls,
+// it must be released with Close.
+func NewClient(addr string, apiKey string, tlsConfig *TLSConfig) (*Client, error) {
+	creds, err := transportCredentials(tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn:   conn,
+		client: NewExternalStudyEngineServiceClient(conn),
+		apiKey: apiKey,
+	}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func transportCredentials(tlsConfig *TLSConfig) (credentials.TransportCredentials, error) {
+	if tlsConfig == nil {
+		return insecure.NewCredentials(), nil
+	}
+	cert, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := os.ReadFile(tlsConfig.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	caCertPool := x509.NewCertPool()
+	caCertPool.AppendCertsFromPEM(caCert)
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caCertPool,
+	}), nil
+}
+
+func (c *Client) HandleEvent(ctx context.Context, req *ExternalEventRequest) (*HandleEventReply, error) {
+	return c.client.HandleEvent(c.withAPIKey(ctx), req)
+}
+
+func (c *Client) EvalExpression(ctx context.Context, req *ExternalEventRequest) (*EvalExpressionReply, error) {
+	return c.client.EvalExpression(c.withAPIKey(ctx), req)
+}
+
+// ValueOf returns the value of the reply as string, float64, bool or nil
+func (x *EvalExpressionReply) ValueOf() interface{} {
+	switch v := x.GetValue().(type) {
+	case *EvalExpressionReply_StrValue:
+		return v.StrValue
+	case *EvalExpressionReply_NumValue:
+		return v.NumValue
+	case *EvalExpressionReply_BoolValue:
+		return v.BoolValue
+	}
+	return nil
+}
+
+func (c *Client) withAPIKey(ctx context.Context) context.Context {
+	if c.apiKey == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, METADATA_KEY_API_KEY, c.apiKey)
+}
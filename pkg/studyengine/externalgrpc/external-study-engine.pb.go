@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: external_study_engine/external-study-engine.proto
+
+package externalgrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExternalEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId       string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	StudyKey         string `protobuf:"bytes,2,opt,name=study_key,json=studyKey,proto3" json:"study_key,omitempty"`
+	EventType        string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Route            string `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"` // optional second argument of the action or expression
+	ParticipantState []byte `protobuf:"bytes,5,opt,name=participant_state,json=participantState,proto3" json:"participant_state,omitempty"`
+	SurveyResponse   []byte `protobuf:"bytes,6,opt,name=survey_response,json=surveyResponse,proto3" json:"survey_response,omitempty"`
+}
+
+func (x *ExternalEventRequest) Reset() {
+	*x = ExternalEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_external_study_engine_external_study_engine_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalEventRequest) ProtoMessage() {}
+
+func (x *ExternalEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_study_engine_external_study_engine_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalEventRequest.ProtoReflect.Descriptor instead.
+func (*ExternalEventRequest) Descriptor() ([]byte, []int) {
+	return file_external_study_engine_external_study_engine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExternalEventRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ExternalEventRequest) GetStudyKey() string {
+	if x != nil {
+		return x.StudyKey
+	}
+	return ""
+}
+
+func (x *ExternalEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ExternalEventRequest) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *ExternalEventRequest) GetParticipantState() []byte {
+	if x != nil {
+		return x.ParticipantState
+	}
+	return nil
+}
+
+func (x *ExternalEventRequest) GetSurveyResponse() []byte {
+	if x != nil {
+		return x.SurveyResponse
+	}
+	return nil
+}
+
+type HandleEventReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParticipantState []byte            `protobuf:"bytes,1,opt,name=participant_state,json=participantState,proto3" json:"participant_state,omitempty"` // optional, replaces the participant state
+	ReportsToCreate  map[string][]byte `protobuf:"bytes,2,rep,name=reports_to_create,json=reportsToCreate,proto3" json:"reports_to_create,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HandleEventReply) Reset() {
+	*x = HandleEventReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_external_study_engine_external_study_engine_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleEventReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleEventReply) ProtoMessage() {}
+
+func (x *HandleEventReply) ProtoReflect() protoreflect.Message {
+	mi := &file_external_study_engine_external_study_engine_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleEventReply.ProtoReflect.Descriptor instead.
+func (*HandleEventReply) Descriptor() ([]byte, []int) {
+	return file_external_study_engine_external_study_engine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HandleEventReply) GetParticipantState() []byte {
+	if x != nil {
+		return x.ParticipantState
+	}
+	return nil
+}
+
+func (x *HandleEventReply) GetReportsToCreate() map[string][]byte {
+	if x != nil {
+		return x.ReportsToCreate
+	}
+	return nil
+}
+
+type EvalExpressionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*EvalExpressionReply_StrValue
+	//	*EvalExpressionReply_NumValue
+	//	*EvalExpressionReply_BoolValue
+	Value isEvalExpressionReply_Value `protobuf_oneof:"value"`
+}
+
+func (x *EvalExpressionReply) Reset() {
+	*x = EvalExpressionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_external_study_engine_external_study_engine_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvalExpressionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvalExpressionReply) ProtoMessage() {}
+
+func (x *EvalExpressionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_external_study_engine_external_study_engine_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvalExpressionReply.ProtoReflect.Descriptor instead.
+func (*EvalExpressionReply) Descriptor() ([]byte, []int) {
+	return file_external_study_engine_external_study_engine_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *EvalExpressionReply) GetValue() isEvalExpressionReply_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *EvalExpressionReply) GetStrValue() string {
+	if x, ok := x.GetValue().(*EvalExpressionReply_StrValue); ok {
+		return x.StrValue
+	}
+	return ""
+}
+
+func (x *EvalExpressionReply) GetNumValue() float64 {
+	if x, ok := x.GetValue().(*EvalExpressionReply_NumValue); ok {
+		return x.NumValue
+	}
+	return 0
+}
+
+func (x *EvalExpressionReply) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*EvalExpressionReply_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+type isEvalExpressionReply_Value interface {
+	isEvalExpressionReply_Value()
+}
+
+type EvalExpressionReply_StrValue struct {
+	StrValue string `protobuf:"bytes,1,opt,name=str_value,json=strValue,proto3,oneof"`
+}
+
+type EvalExpressionReply_NumValue struct {
+	NumValue float64 `protobuf:"fixed64,2,opt,name=num_value,json=numValue,proto3,oneof"`
+}
+
+type EvalExpressionReply_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*EvalExpressionReply_StrValue) isEvalExpressionReply_Value() {}
+
+func (*EvalExpressionReply_NumValue) isEvalExpressionReply_Value() {}
+
+func (*EvalExpressionReply_BoolValue) isEvalExpressionReply_Value() {}
+
+var File_external_study_engine_external_study_engine_proto protoreflect.FileDescriptor
+
+var file_external_study_engine_external_study_engine_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2d, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x22, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x22, 0xdf, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x72, 0x76, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x75, 0x72, 0x76, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x10, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b,
+	0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x54, 0x6f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d, 0x0a, 0x13, 0x45, 0x76, 0x61, 0x6c, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09,
+	0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xa5, 0x02, 0x0a, 0x1a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x79, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x0b, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x61, 0x6c, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x44, 0x5a,
+	0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x79,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x67,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_external_study_engine_external_study_engine_proto_rawDescOnce sync.Once
+	file_external_study_engine_external_study_engine_proto_rawDescData = file_external_study_engine_external_study_engine_proto_rawDesc
+)
+
+func file_external_study_engine_external_study_engine_proto_rawDescGZIP() []byte {
+	file_external_study_engine_external_study_engine_proto_rawDescOnce.Do(func() {
+		file_external_study_engine_external_study_engine_proto_rawDescData = protoimpl.X.CompressGZIP(file_external_study_engine_external_study_engine_proto_rawDescData)
+	})
+	return file_external_study_engine_external_study_engine_proto_rawDescData
+}
+
+var file_external_study_engine_external_study_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_external_study_engine_external_study_engine_proto_goTypes = []interface{}{
+	(*ExternalEventRequest)(nil), // 0: influenzanet.study_engine.external.ExternalEventRequest
+	(*HandleEventReply)(nil),     // 1: influenzanet.study_engine.external.HandleEventReply
+	(*EvalExpressionReply)(nil),  // 2: influenzanet.study_engine.external.EvalExpressionReply
+	nil,                          // 3: influenzanet.study_engine.external.HandleEventReply.ReportsToCreateEntry
+}
+var file_external_study_engine_external_study_engine_proto_depIdxs = []int32{
+	3, // 0: influenzanet.study_engine.external.HandleEventReply.reports_to_create:type_name -> influenzanet.study_engine.external.HandleEventReply.ReportsToCreateEntry
+	0, // 1: influenzanet.study_engine.external.ExternalStudyEngineService.HandleEvent:input_type -> influenzanet.study_engine.external.ExternalEventRequest
+	0, // 2: influenzanet.study_engine.external.ExternalStudyEngineService.EvalExpression:input_type -> influenzanet.study_engine.external.ExternalEventRequest
+	1, // 3: influenzanet.study_engine.external.ExternalStudyEngineService.HandleEvent:output_type -> influenzanet.study_engine.external.HandleEventReply
+	2, // 4: influenzanet.study_engine.external.ExternalStudyEngineService.EvalExpression:output_type -> influenzanet.study_engine.external.EvalExpressionReply
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_external_study_engine_external_study_engine_proto_init() }
+func file_external_study_engine_external_study_engine_proto_init() {
+	if File_external_study_engine_external_study_engine_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_external_study_engine_external_study_engine_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_external_study_engine_external_study_engine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleEventReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_external_study_engine_external_study_engine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvalExpressionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_external_study_engine_external_study_engine_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*EvalExpressionReply_StrValue)(nil),
+		(*EvalExpressionReply_NumValue)(nil),
+		(*EvalExpressionReply_BoolValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_external_study_engine_external_study_engine_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_external_study_engine_external_study_engine_proto_goTypes,
+		DependencyIndexes: file_external_study_engine_external_study_engine_proto_depIdxs,
+		MessageInfos:      file_external_study_engine_external_study_engine_proto_msgTypes,
+	}.Build()
+	File_external_study_engine_external_study_engine_proto = out.File
+	file_external_study_engine_external_study_engine_proto_rawDesc = nil
+	file_external_study_engine_external_study_engine_proto_goTypes = nil
+	file_external_study_engine_external_study_engine_proto_depIdxs = nil
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: external_study_engine/external-study-engine.proto
+
+package externalgrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ExternalStudyEngineServiceClient is the client API for ExternalStudyEngineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExternalStudyEngineServiceClient interface {
+	// called by EXTERNAL_EVENT_HANDLER
+	HandleEvent(ctx context.Context, in *ExternalEventRequest, opts ...grpc.CallOption) (*HandleEventReply, error)
+	// called by externalEventEval
+	EvalExpression(ctx context.Context, in *ExternalEventRequest, opts ...grpc.CallOption) (*EvalExpressionReply, error)
+}
+
+type externalStudyEngineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExternalStudyEngineServiceClient(cc grpc.ClientConnInterface) ExternalStudyEngineServiceClient {
+	return &externalStudyEngineServiceClient{cc}
+}
+
+func (c *externalStudyEngineServiceClient) HandleEvent(ctx context.Context, in *ExternalEventRequest, opts ...grpc.CallOption) (*HandleEventReply, error) {
+	out := new(HandleEventReply)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_engine.external.ExternalStudyEngineService/HandleEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *externalStudyEngineServiceClient) EvalExpression(ctx context.Context, in *ExternalEventRequest, opts ...grpc.CallOption) (*EvalExpressionReply, error) {
+	out := new(EvalExpressionReply)
+	err := c.cc.Invoke(ctx, "/influenzanet.study_engine.external.ExternalStudyEngineService/EvalExpression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExternalStudyEngineServiceServer is the server API for ExternalStudyEngineService service.
+// All implementations must embed UnimplementedExternalStudyEngineServiceServer
+// for forward compatibility
+type ExternalStudyEngineServiceServer interface {
+	// called by EXTERNAL_EVENT_HANDLER
+	HandleEvent(context.Context, *ExternalEventRequest) (*HandleEventReply, error)
+	// called by externalEventEval
+	EvalExpression(context.Context, *ExternalEventRequest) (*EvalExpressionReply, error)
+	mustEmbedUnimplementedExternalStudyEngineServiceServer()
+}
+
+// UnimplementedExternalStudyEngineServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExternalStudyEngineServiceServer struct {
+}
+
+func (UnimplementedExternalStudyEngineServiceServer) HandleEvent(context.Context, *ExternalEventRequest) (*HandleEventReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleEvent not implemented")
+}
+func (UnimplementedExternalStudyEngineServiceServer) EvalExpression(context.Context, *ExternalEventRequest) (*EvalExpressionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvalExpression not implemented")
+}
+func (UnimplementedExternalStudyEngineServiceServer) mustEmbedUnimplementedExternalStudyEngineServiceServer() {
+}
+
+// UnsafeExternalStudyEngineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExternalStudyEngineServiceServer will
+// result in compilation errors.
+type UnsafeExternalStudyEngineServiceServer interface {
+	mustEmbedUnimplementedExternalStudyEngineServiceServer()
+}
+
+func RegisterExternalStudyEngineServiceServer(s grpc.ServiceRegistrar, srv ExternalStudyEngineServiceServer) {
+	s.RegisterService(&ExternalStudyEngineService_ServiceDesc, srv)
+}
+
+func _ExternalStudyEngineService_HandleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalStudyEngineServiceServer).HandleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_engine.external.ExternalStudyEngineService/HandleEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalStudyEngineServiceServer).HandleEvent(ctx, req.(*ExternalEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExternalStudyEngineService_EvalExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExternalStudyEngineServiceServer).EvalExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.study_engine.external.ExternalStudyEngineService/EvalExpression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExternalStudyEngineServiceServer).EvalExpression(ctx, req.(*ExternalEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExternalStudyEngineService_ServiceDesc is the grpc.ServiceDesc for ExternalStudyEngineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExternalStudyEngineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "influenzanet.study_engine.external.ExternalStudyEngineService",
+	HandlerType: (*ExternalStudyEngineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleEvent",
+			Handler:    _ExternalStudyEngineService_HandleEvent_Handler,
+		},
+		{
+			MethodName: "EvalExpression",
+			Handler:    _ExternalStudyEngineService_EvalExpression_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "external_study_engine/external-study-engine.proto",
+}
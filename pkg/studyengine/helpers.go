@@ -2,6 +2,7 @@ package studyengine
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -9,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,16 +18,35 @@ import (
 	"github.com/influenzanet/study-service/pkg/types"
 )
 
-// Method to find survey item response in the array of responses
+// Method to find survey item response in the array of responses. Items of (nested) groups are found by their full key, e.g. "intake.G1.Q3".
 func findSurveyItemResponse(responses []types.SurveyItemResponse, key string) (responseOfInterest *types.SurveyItemResponse, err error) {
-	for _, response := range responses {
-		if response.Key == key {
-			return &response, nil
-		}
+	if item := findNestedSurveyItemResponse(responses, "", key); item != nil {
+		return item, nil
 	}
 	return nil, errors.New("item not found")
 }
 
+// findNestedSurveyItemResponse searches the items and, if the key is within a group, the items of the group.
+// Keys of group items may be full keys or relative to the key of the group.
+func findNestedSurveyItemResponse(items []types.SurveyItemResponse, parentKey string, key string) *types.SurveyItemResponse {
+	for i := range items {
+		itemKey := items[i].Key
+		if parentKey != "" && !strings.HasPrefix(itemKey, parentKey+".") {
+			itemKey = parentKey + "." + itemKey
+		}
+		if itemKey == key {
+			item := items[i]
+			return &item
+		}
+		if len(items[i].Items) > 0 && strings.HasPrefix(key, itemKey+".") {
+			if item := findNestedSurveyItemResponse(items[i].Items, itemKey, key); item != nil {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
 // Method to retrive one level of the nested response object
 func findResponseObject(surveyItem *types.SurveyItemResponse, responseKey string) (responseItem *types.ResponseItem, err error) {
 	if surveyItem == nil {
@@ -69,21 +90,75 @@ func getExternalServicesConfigByName(serviceConfigs []types.ExternalService, nam
 	return types.ExternalService{}, fmt.Errorf("no external service config found with name: %s", name)
 }
 
-type ExternalEventPayload struct {
-	ParticipantState types.ParticipantState `json:"participantState"`
-	EventType        string                 `json:"eventType"`
-	StudyKey         string                 `json:"studyKey"`
-	InstanceID       string                 `json:"instanceID"`
-	Response         types.SurveyResponse   `json:"surveyResponses"`
-}
+type ExternalEventPayload = types.ExternalEventPayload
 
 type ClientConfig struct {
-	APIKey     string
-	mTLSConfig *types.MutualTLSConfig
-	Timeout    time.Duration
+	APIKey        string
+	SigningSecret string
+	StrictReply   bool
+	mTLSConfig    *types.MutualTLSConfig
+	Timeout       time.Duration
 }
 
-func runHTTPcall(url string, payload ExternalEventPayload, config ClientConfig) (map[string]interface{}, error) {
+func newClientConfig(serviceConfig types.ExternalService) ClientConfig {
+	return ClientConfig{
+		APIKey:        serviceConfig.APIKey,
+		SigningSecret: serviceConfig.SigningSecret,
+		StrictReply:   serviceConfig.StrictReply,
+		Timeout:       time.Duration(serviceConfig.Timeout) * time.Second,
+		mTLSConfig:    serviceConfig.MutualTLSConfig,
+	}
+}
+
+func runHTTPcall(ctx context.Context, url string, payload ExternalEventPayload, config ClientConfig) (ExternalServiceReply, error) {
+	resp, err := postExternalEvent(ctx, url, payload, config)
+	if err != nil {
+		return ExternalServiceReply{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return ExternalServiceReply{}, &externalServiceStatusError{service: "external service", statusCode: resp.StatusCode}
+	}
+	reply, err := decodeExternalServiceReply(resp.Body, payload.ParticipantState.ParticipantID, config.StrictReply)
+	if err != nil {
+		logger.Debug.Printf("unexpected error: %v", err)
+		return ExternalServiceReply{}, err
+	}
+	return reply, nil
+}
+
+// DeliverExternalEvent sends an event from the outbox to the configured service, cancelled with ctx. Only the status code of the reply is checked.
+func DeliverExternalEvent(ctx context.Context, serviceConfigs []types.ExternalService, entry types.ExternalEventOutboxEntry) error {
+	serviceConfig, err := getExternalServicesConfigByName(serviceConfigs, entry.ServiceName)
+	if err != nil {
+		return err
+	}
+	return withCircuitBreaker(ctx, serviceConfig, func() error {
+		if serviceConfig.Protocol == types.EXTERNAL_SERVICE_PROTOCOL_GRPC {
+			// the reply is not used, so only transport errors count
+			_, err := runGRPCcall(ctx, serviceConfig, entry.Route, entry.Payload, externalServiceHandleEvent)
+			if err != nil && !errors.Is(err, errInvalidExternalServiceReply) {
+				return err
+			}
+			return nil
+		}
+
+		resp, err := postExternalEvent(ctx, externalServiceURL(serviceConfig, entry.Route), entry.Payload, newClientConfig(serviceConfig))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return &externalServiceStatusError{service: entry.ServiceName, statusCode: resp.StatusCode}
+		}
+		return nil
+	})
+}
+
+// postExternalEvent sends the payload, the request is cancelled with ctx
+func postExternalEvent(ctx context.Context, url string, payload ExternalEventPayload, config ClientConfig) (*http.Response, error) {
 	json_data, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
@@ -101,7 +176,7 @@ func runHTTPcall(url string, payload ExternalEventPayload, config ClientConfig)
 		client.Transport = transport
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(json_data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(json_data))
 	if err != nil {
 		logger.Error.Printf("unexpected error: %v", err)
 		return nil, err
@@ -109,6 +184,16 @@ func runHTTPcall(url string, payload ExternalEventPayload, config ClientConfig)
 	if config.APIKey != "" {
 		req.Header.Set("Api-Key", config.APIKey)
 	}
+	if config.SigningSecret != "" {
+		nonce, err := newSignatureNonce()
+		if err != nil {
+			return nil, err
+		}
+		timestamp := time.Now().Unix()
+		req.Header.Set(EXTERNAL_SERVICE_TIMESTAMP_HEADER, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(EXTERNAL_SERVICE_NONCE_HEADER, nonce)
+		req.Header.Set(EXTERNAL_SERVICE_SIGNATURE_HEADER, signExternalServiceRequest(config.SigningSecret, timestamp, nonce, json_data))
+	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := client.Do(req)
@@ -116,14 +201,7 @@ func runHTTPcall(url string, payload ExternalEventPayload, config ClientConfig)
 		logger.Debug.Printf("unexpected error: %v", err)
 		return nil, err
 	}
-
-	var res map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&res)
-	if err != nil {
-		logger.Debug.Printf("unexpected error: %v", err)
-		return nil, err
-	}
-	return res, nil
+	return resp, nil
 }
 
 func getTransportWithMTLSConfig(mTLSConfig *types.MutualTLSConfig) (*http.Transport, error) {
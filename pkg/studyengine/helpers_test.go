@@ -1,6 +1,11 @@
 package studyengine
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/influenzanet/study-service/pkg/types"
@@ -41,6 +46,37 @@ func TestFindSurveyItemResponse(t *testing.T) {
 			t.Errorf("unexpected item: %v", item)
 		}
 	})
+
+	nested := []types.SurveyItemResponse{
+		{Key: "t.Q0"},
+		{Key: "t.G1", Items: []types.SurveyItemResponse{
+			{Key: "t.G1.Q1"},
+			{Key: "t.G1.G2", Items: []types.SurveyItemResponse{
+				{Key: "t.G1.G2.Q1", Response: &types.ResponseItem{Key: "rg"}},
+			}},
+		}},
+		{Key: "t.G3", Items: []types.SurveyItemResponse{
+			{Key: "Q1"}, // key relative to the group
+		}},
+	}
+	for _, key := range []string{"t.Q0", "t.G1", "t.G1.Q1", "t.G1.G2", "t.G1.G2.Q1", "t.G3.Q1"} {
+		t.Run("nested key "+key, func(t *testing.T) {
+			item, err := findSurveyItemResponse(nested, key)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasSuffix(key, item.Key) {
+				t.Errorf("unexpected item: %v", item)
+			}
+		})
+	}
+	for _, key := range []string{"t.G1.Q2", "t.G1.G2.Q2", "t.Q1", "t.G2.Q1", "G1.Q1"} {
+		t.Run("nested key not present "+key, func(t *testing.T) {
+			if _, err := findSurveyItemResponse(nested, key); err == nil {
+				t.Error("should produce error")
+			}
+		})
+	}
 }
 
 func TestFindResponseObject(t *testing.T) {
@@ -143,3 +179,169 @@ func TestGetExternalServicesConfigByName(t *testing.T) {
 		}
 	})
 }
+
+func TestExternalEventHandlerAsync(t *testing.T) {
+	configs := ActionConfigs{
+		DBService: &DryRunDBService{},
+		ExternalServiceConfigs: []types.ExternalService{
+			{Name: "async-service", URL: "http://localhost:1", Async: true},
+		},
+	}
+	action := types.Expression{Name: "EXTERNAL_EVENT_HANDLER", Data: []types.ExpressionArg{
+		{DType: "str", Str: "async-service"},
+		{DType: "str", Str: "/events"},
+	}}
+	event := types.StudyEvent{Type: "ENTER", InstanceID: "instance", StudyKey: "study"}
+	oldState := ActionData{PState: types.ParticipantState{ParticipantID: "p1"}}
+
+	newState, err := ActionEval(action, oldState, event, configs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if newState.PState.ParticipantID != "p1" {
+		t.Errorf("unexpected state: %v", newState.PState)
+	}
+	events := configs.DBService.(*DryRunDBService).ExternalEvents
+	if len(events) != 1 || events[0].ServiceName != "async-service" || events[0].Route != "events" || events[0].Payload.ParticipantState.ParticipantID != "p1" {
+		t.Errorf("unexpected outbox entries: %v", events)
+	}
+}
+
+func TestDeliverExternalEvent(t *testing.T) {
+	received := []types.ExternalEventPayload{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Api-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var payload types.ExternalEventPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, payload)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	serviceConfigs := []types.ExternalService{
+		{Name: "service", URL: server.URL, APIKey: "secret", Timeout: 5, Async: true},
+		{Name: "wrong-key", URL: server.URL, APIKey: "wrong", Timeout: 5, Async: true},
+	}
+	entry := types.ExternalEventOutboxEntry{
+		ServiceName: "service",
+		Route:       "events",
+		Payload:     types.ExternalEventPayload{EventType: "SUBMIT", StudyKey: "study"},
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		if err := DeliverExternalEvent(context.Background(), serviceConfigs, entry); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if len(received) != 1 || received[0].EventType != "SUBMIT" {
+			t.Errorf("unexpected payloads: %v", received)
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		failing := entry
+		failing.Route = "fail"
+		if err := DeliverExternalEvent(context.Background(), serviceConfigs, failing); err == nil {
+			t.Error("should return an error")
+		}
+		failing = entry
+		failing.ServiceName = "wrong-key"
+		if err := DeliverExternalEvent(context.Background(), serviceConfigs, failing); err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := DeliverExternalEvent(ctx, serviceConfigs, entry); err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("unknown service", func(t *testing.T) {
+		unknown := entry
+		unknown.ServiceName = "unknown"
+		if err := DeliverExternalEvent(context.Background(), serviceConfigs, unknown); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestResponseExpressionsInNestedGroups(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: name, Data: args}
+	}
+
+	// intake survey with a question group containing a question and a sub-group
+	ctx := EvalContext{
+		Event: types.StudyEvent{
+			Type: "SUBMIT",
+			Response: types.SurveyResponse{
+				Key: "intake",
+				Responses: []types.SurveyItemResponse{
+					{Key: "intake.Q1", Response: &types.ResponseItem{Key: "rg", Items: []*types.ResponseItem{
+						{Key: "scg", Items: []*types.ResponseItem{{Key: "1"}}},
+					}}},
+					{Key: "intake.G1", Items: []types.SurveyItemResponse{
+						{Key: "intake.G1.Q3", Response: &types.ResponseItem{Key: "rg", Items: []*types.ResponseItem{
+							{Key: "mcg", Items: []*types.ResponseItem{{Key: "a"}, {Key: "c"}}},
+							{Key: "num", Value: "42", Dtype: "number"},
+						}}},
+						{Key: "intake.G1.G2", Items: []types.SurveyItemResponse{
+							{Key: "intake.G1.G2.Q1", Response: &types.ResponseItem{Key: "rg", Items: []*types.ResponseItem{
+								{Key: "input", Value: "hello"},
+							}}},
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected interface{}
+	}{
+		{name: "top level item", exp: exp("hasResponseKey", str("intake.Q1"), str("rg.scg.1")), expected: true},
+		{name: "hasResponseKey in group", exp: exp("hasResponseKey", str("intake.G1.Q3"), str("rg.mcg.c")), expected: true},
+		{name: "hasResponseKey in group missing option", exp: exp("hasResponseKey", str("intake.G1.Q3"), str("rg.mcg.b")), expected: false},
+		{name: "hasResponseKey in sub-group", exp: exp("hasResponseKey", str("intake.G1.G2.Q1"), str("rg.input")), expected: true},
+		{name: "hasResponseKey unknown item in group", exp: exp("hasResponseKey", str("intake.G1.Q4"), str("rg")), expected: false},
+		{name: "hasResponseKeyWithValue in sub-group", exp: exp("hasResponseKeyWithValue", str("intake.G1.G2.Q1"), str("rg.input"), str("hello")), expected: true},
+		{name: "getResponseValueAsNum in group", exp: exp("getResponseValueAsNum", str("intake.G1.Q3"), str("rg.num")), expected: 42.0},
+		{name: "getResponseValueAsStr in sub-group", exp: exp("getResponseValueAsStr", str("intake.G1.G2.Q1"), str("rg.input")), expected: "hello"},
+		{name: "getSelectedKeys in group", exp: exp("getSelectedKeys", str("intake.G1.Q3"), str("rg.mcg")), expected: "a;c"},
+		{name: "countResponseItems in group", exp: exp("countResponseItems", str("intake.G1.Q3"), str("rg.mcg")), expected: 2.0},
+		{name: "responseHasKeysAny in group", exp: exp("responseHasKeysAny", str("intake.G1.Q3"), str("rg.mcg"), str("b"), str("c")), expected: true},
+		{name: "responseHasOnlyKeysOtherThan in group", exp: exp("responseHasOnlyKeysOtherThan", str("intake.G1.Q3"), str("rg.mcg"), str("b")), expected: true},
+		{name: "responseHasOnlyKeysOtherThan in group with key", exp: exp("responseHasOnlyKeysOtherThan", str("intake.G1.Q3"), str("rg.mcg"), str("a")), expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := ExpressionEval(tc.exp, ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val != tc.expected {
+				t.Errorf("unexpected value: %v, expected %v", val, tc.expected)
+			}
+		})
+	}
+
+	t.Run("getResponseValueAsStr unknown item in sub-group", func(t *testing.T) {
+		if _, err := ExpressionEval(exp("getResponseValueAsStr", str("intake.G1.G2.Q2"), str("rg.input")), ctx); err == nil {
+			t.Error("should produce error")
+		}
+	})
+}
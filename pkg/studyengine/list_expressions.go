@@ -0,0 +1,150 @@
+package studyengine
+
+import (
+	"errors"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// listArg resolves the argument as a semicolon separated list (e.g. from getSelectedKeys or a flag value).
+// Numbers and booleans are single items.
+func (ctx EvalContext) listArg(arg types.ExpressionArg) ([]string, error) {
+	v, err := ctx.expressionArgResolver(arg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := valueAsStr(v)
+	if err != nil {
+		return nil, err
+	}
+	return splitList(s), nil
+}
+
+// listArgsAsSet resolves all arguments as lists and returns the set of their items
+func (ctx EvalContext) listArgsAsSet(args []types.ExpressionArg) (map[string]bool, error) {
+	set := map[string]bool{}
+	for _, arg := range args {
+		items, err := ctx.listArg(arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			set[item] = true
+		}
+	}
+	return set, nil
+}
+
+// in checks if the value is one of the items of the following arguments, each argument can be a single value or a list
+func (ctx EvalContext) in(exp types.Expression) (val bool, err error) {
+	if len(exp.Data) < 2 {
+		return val, errors.New("should have at least two arguments")
+	}
+	arg1, err := ctx.expressionArgResolver(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	value, err := valueAsStr(arg1)
+	if err != nil {
+		return val, err
+	}
+	items, err := ctx.listArgsAsSet(exp.Data[1:])
+	if err != nil {
+		return val, err
+	}
+	return items[value], nil
+}
+
+func (ctx EvalContext) listContains(exp types.Expression) (val bool, err error) {
+	if len(exp.Data) != 2 {
+		return val, errors.New("should have two arguments")
+	}
+	list, err := ctx.listArgsAsSet(exp.Data[:1])
+	if err != nil {
+		return val, err
+	}
+	arg2, err := ctx.expressionArgResolver(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	item, err := valueAsStr(arg2)
+	if err != nil {
+		return val, err
+	}
+	return list[item], nil
+}
+
+// listLength returns the number of (non-empty) items of the list
+func (ctx EvalContext) listLength(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("should have one argument")
+	}
+	items, err := ctx.listArg(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	return float64(len(items)), nil
+}
+
+// listIntersectionCount returns the number of distinct items present in both lists
+func (ctx EvalContext) listIntersectionCount(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 2 {
+		return val, errors.New("should have two arguments")
+	}
+	list1, err := ctx.listArgsAsSet(exp.Data[:1])
+	if err != nil {
+		return val, err
+	}
+	list2, err := ctx.listArgsAsSet(exp.Data[1:])
+	if err != nil {
+		return val, err
+	}
+	for item := range list1 {
+		if list2[item] {
+			val += 1
+		}
+	}
+	return val, nil
+}
+
+// anyOf checks if at least one of the items (single values or lists) is in the list
+func (ctx EvalContext) anyOf(exp types.Expression) (val bool, err error) {
+	if len(exp.Data) < 2 {
+		return val, errors.New("should have at least two arguments")
+	}
+	list, err := ctx.listArgsAsSet(exp.Data[:1])
+	if err != nil {
+		return val, err
+	}
+	items, err := ctx.listArgsAsSet(exp.Data[1:])
+	if err != nil {
+		return val, err
+	}
+	for item := range items {
+		if list[item] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// allOf checks if all of the items (single values or lists) are in the list
+func (ctx EvalContext) allOf(exp types.Expression) (val bool, err error) {
+	if len(exp.Data) < 2 {
+		return val, errors.New("should have at least two arguments")
+	}
+	list, err := ctx.listArgsAsSet(exp.Data[:1])
+	if err != nil {
+		return val, err
+	}
+	items, err := ctx.listArgsAsSet(exp.Data[1:])
+	if err != nil {
+		return val, err
+	}
+	for item := range items {
+		if !list[item] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
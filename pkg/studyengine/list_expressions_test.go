@@ -0,0 +1,88 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestListExpressions(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: name, Data: args}
+	}
+	selectedSymptoms := types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getSelectedKeys", Data: []types.ExpressionArg{
+		str("weekly.Q1"), str("rg.mcg"),
+	}}}
+	groupFlag := types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getParticipantFlagValue", Data: []types.ExpressionArg{
+		str("groups"),
+	}}}
+	ctx := EvalContext{
+		Event: types.StudyEvent{
+			Type: "SUBMIT",
+			Response: types.SurveyResponse{
+				Key: "weekly",
+				Responses: []types.SurveyItemResponse{
+					{Key: "weekly.Q1", Response: &types.ResponseItem{
+						Key: "rg", Items: []*types.ResponseItem{
+							{Key: "mcg", Items: []*types.ResponseItem{{Key: "fever"}, {Key: "cough"}, {Key: "fatigue"}}},
+						},
+					}},
+				},
+			},
+		},
+		ParticipantState: types.ParticipantState{Flags: map[string]string{"groups": "a;c", "score": "2"}},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected interface{}
+	}{
+		{name: "in", exp: exp("in", str("b"), str("a"), str("b")), expected: true},
+		{name: "in list", exp: exp("in", str("cough"), selectedSymptoms), expected: true},
+		{name: "in number", exp: exp("in", num(2), num(1), str("2;3")), expected: true},
+		{name: "not in", exp: exp("in", str("x"), str("a;b"), str("c")), expected: false},
+		{name: "listContains", exp: exp("listContains", groupFlag, str("c")), expected: true},
+		{name: "listContains missing", exp: exp("listContains", groupFlag, str("b")), expected: false},
+		{name: "listLength", exp: exp("listLength", selectedSymptoms), expected: 3.0},
+		{name: "listLength empty items", exp: exp("listLength", str(";a;;b;")), expected: 2.0},
+		{name: "listLength empty", exp: exp("listLength", str("")), expected: 0.0},
+		{name: "listIntersectionCount", exp: exp("listIntersectionCount", selectedSymptoms, str("fever;headache;cough;cough;chills;nausea")), expected: 2.0},
+		{name: "anyOf", exp: exp("anyOf", selectedSymptoms, str("headache"), str("chills;fever")), expected: true},
+		{name: "anyOf none", exp: exp("anyOf", selectedSymptoms, str("headache;chills")), expected: false},
+		{name: "allOf", exp: exp("allOf", selectedSymptoms, str("fever"), str("cough")), expected: true},
+		{name: "allOf missing", exp: exp("allOf", groupFlag, str("a;b")), expected: false},
+		{name: "at least 2 of 5 symptoms", exp: exp("gte",
+			types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "listIntersectionCount", Data: []types.ExpressionArg{
+				selectedSymptoms, str("fever;chills;cough;headache;nausea"),
+			}}},
+			num(2),
+		), expected: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if errs := ValidateExpression(tc.exp, "exp"); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+			val, err := ExpressionEval(tc.exp, ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val != tc.expected {
+				t.Errorf("unexpected value: %v, expected %v", val, tc.expected)
+			}
+		})
+	}
+
+	t.Run("failing list argument", func(t *testing.T) {
+		e := exp("listLength", types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getVar", Data: []types.ExpressionArg{str("missing")}}})
+		if _, err := ExpressionEval(e, ctx); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
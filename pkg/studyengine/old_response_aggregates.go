@@ -0,0 +1,158 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/dbs/studydb"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	AGGREGATION_SUM   = "sum"
+	AGGREGATION_AVG   = "avg"
+	AGGREGATION_MIN   = "min"
+	AGGREGATION_MAX   = "max"
+	AGGREGATION_FIRST = "first" // value of the oldest response
+	AGGREGATION_LAST  = "last"  // value of the most recent response
+	AGGREGATION_COUNT = "count" // number of responses with a value
+)
+
+var knownAggregations = map[string]bool{
+	AGGREGATION_SUM:   true,
+	AGGREGATION_AVG:   true,
+	AGGREGATION_MIN:   true,
+	AGGREGATION_MAX:   true,
+	AGGREGATION_FIRST: true,
+	AGGREGATION_LAST:  true,
+	AGGREGATION_COUNT: true,
+}
+
+// aggregateValues returns the aggregate of the values, ordered from the oldest to the most recent
+func aggregateValues(aggregation string, values []float64) (float64, error) {
+	switch aggregation {
+	case AGGREGATION_SUM:
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		return sum, nil
+	case AGGREGATION_COUNT:
+		return float64(len(values)), nil
+	}
+
+	if len(values) == 0 {
+		return 0, fmt.Errorf("no values for aggregation '%s'", aggregation)
+	}
+	switch aggregation {
+	case AGGREGATION_AVG:
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values)), nil
+	case AGGREGATION_MIN:
+		min := values[0]
+		for _, v := range values[1:] {
+			min = math.Min(min, v)
+		}
+		return min, nil
+	case AGGREGATION_MAX:
+		max := values[0]
+		for _, v := range values[1:] {
+			max = math.Max(max, v)
+		}
+		return max, nil
+	case AGGREGATION_FIRST:
+		return values[0], nil
+	case AGGREGATION_LAST:
+		return values[len(values)-1], nil
+	default:
+		return 0, fmt.Errorf("unknown aggregation '%s'", aggregation)
+	}
+}
+
+// aggregateOldResponses evaluates the value expression on the previous responses of the participant to the survey
+// and returns the aggregate. Responses for which the value is missing or not a number are skipped.
+func (ctx EvalContext) aggregateOldResponses(exp types.Expression) (val float64, err error) {
+	if ctx.Configs.DBService == nil {
+		return val, errors.New("aggregateOldResponses: DB connection not available in the context")
+	}
+	if ctx.Event.InstanceID == "" || ctx.Event.StudyKey == "" {
+		return val, errors.New("aggregateOldResponses: instanceID or study key missing from context")
+	}
+	if len(exp.Data) < 3 || len(exp.Data) > 6 {
+		return val, fmt.Errorf("aggregateOldResponses: unexpected numbers of arguments: %d", len(exp.Data))
+	}
+
+	aggregation, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	if !knownAggregations[aggregation] {
+		return val, fmt.Errorf("aggregateOldResponses: unknown aggregation '%s'", aggregation)
+	}
+	if !exp.Data[1].IsExpression() || exp.Data[1].Exp == nil {
+		return val, errors.New("aggregateOldResponses: second argument must be an expression")
+	}
+	valueExp := *exp.Data[1].Exp
+	surveyKey, err := ctx.mustGetStrValue(exp.Data[2])
+	if err != nil {
+		return val, err
+	}
+
+	query := studydb.ResponseQuery{
+		ParticipantID: ctx.ParticipantState.ParticipantID,
+		SurveyKey:     surveyKey,
+	}
+	optionalArgs := []*int64{&query.Since, &query.Until, &query.Limit}
+	for i, target := range optionalArgs {
+		if len(exp.Data) <= 3+i {
+			break
+		}
+		v, err := ctx.mustGetNumValue(exp.Data[3+i])
+		if err != nil {
+			return val, err
+		}
+		*target = int64(v)
+	}
+
+	// responses are returned newest first
+	responses, err := ctx.Configs.DBService.FindSurveyResponses(ctx.Event.InstanceID, ctx.Event.StudyKey, query)
+	if err != nil {
+		return val, err
+	}
+
+	values := []float64{}
+	for i := len(responses) - 1; i >= 0; i-- {
+		oldEvalContext := EvalContext{
+			ParticipantState: ctx.ParticipantState,
+			Event: types.StudyEvent{
+				Response: responses[i],
+			},
+			Configs: ActionConfigs{
+				Tracer:    ctx.Configs.Tracer,
+				Variables: ctx.Configs.Variables,
+				Budget:    ctx.Configs.Budget,
+			},
+		}
+		v, err := ExpressionEval(valueExp, oldEvalContext)
+		if budgetErr := ctx.Configs.Budget.Err(); budgetErr != nil {
+			return val, budgetErr
+		}
+		if err != nil {
+			logger.Debug.Printf("aggregateOldResponses: response %s skipped: %v", responses[i].ID.Hex(), err)
+			continue
+		}
+		n, ok := v.(float64)
+		if !ok {
+			logger.Debug.Printf("aggregateOldResponses: response %s skipped, value is not a number", responses[i].ID.Hex())
+			continue
+		}
+		values = append(values, n)
+	}
+
+	return aggregateValues(aggregation, values)
+}
@@ -0,0 +1,93 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestAggregateOldResponses(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	weekly := func(submittedAt int64, temperature string) types.SurveyResponse {
+		items := []*types.ResponseItem{}
+		if temperature != "" {
+			items = append(items, &types.ResponseItem{Key: "temp", Value: temperature, Dtype: "number"})
+		}
+		return types.SurveyResponse{
+			Key: "weekly", ParticipantID: "P1", SubmittedAt: submittedAt, Responses: []types.SurveyItemResponse{
+				{Key: "weekly.Q1", Response: &types.ResponseItem{Key: "rg", Items: items}},
+			},
+		}
+	}
+	temperature := types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getResponseValueAsNum", Data: []types.ExpressionArg{
+		str("weekly.Q1"), str("rg.temp"),
+	}}}
+	ctx := EvalContext{
+		Event:            types.StudyEvent{InstanceID: "instance", StudyKey: "study"},
+		ParticipantState: types.ParticipantState{ParticipantID: "P1"},
+		Configs: ActionConfigs{DBService: MockStudyDBService{Responses: []types.SurveyResponse{
+			// newest first, like returned by the DB
+			{Key: "weekly", ParticipantID: "P2", SubmittedAt: 70},
+			{Key: "intake", ParticipantID: "P1", SubmittedAt: 60},
+			weekly(50, "38.5"),
+			weekly(40, "38"),
+			weekly(30, ""), // not answered
+			weekly(20, "39"),
+			weekly(10, "37.5"),
+		}}},
+	}
+	aggregate := func(aggregation string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: "aggregateOldResponses", Data: append([]types.ExpressionArg{str(aggregation), temperature, str("weekly")}, args...)}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected float64
+	}{
+		{name: "sum", exp: aggregate("sum"), expected: 153},
+		{name: "avg", exp: aggregate("avg"), expected: 38.25},
+		{name: "min", exp: aggregate("min"), expected: 37.5},
+		{name: "max", exp: aggregate("max"), expected: 39},
+		{name: "first", exp: aggregate("first"), expected: 37.5},
+		{name: "last", exp: aggregate("last"), expected: 38.5},
+		{name: "count", exp: aggregate("count"), expected: 4},
+		{name: "time window", exp: aggregate("first", num(15), num(45)), expected: 39},
+		{name: "avg over last 3 submissions", exp: aggregate("avg", num(0), num(0), num(3)), expected: 38.25},
+		{name: "max over last 3 submissions", exp: aggregate("max", num(0), num(0), num(3)), expected: 38.5},
+		{name: "sum without values", exp: aggregate("sum", num(100)), expected: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if errs := ValidateExpression(tc.exp, "exp"); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+			val, err := ExpressionEval(tc.exp, ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val.(float64) != tc.expected {
+				t.Errorf("unexpected value: %v, expected %v", val, tc.expected)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		exp  types.Expression
+	}{
+		{name: "avg without values", exp: aggregate("avg", num(100))},
+		{name: "unknown aggregation", exp: aggregate("median")},
+		{name: "value not an expression", exp: types.Expression{Name: "aggregateOldResponses", Data: []types.ExpressionArg{str("sum"), num(1), str("weekly")}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ExpressionEval(tc.exp, ctx); err == nil {
+				t.Error("should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package studyengine
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	// separator used to combine multiple strata values into one stratum
+	STRATA_SEPARATOR = "|"
+	// upper limit for the block size, as the whole block is shuffled for every allocation
+	MAX_RANDOMIZATION_BLOCK_SIZE = 1000
+)
+
+// RandomizationDBService is implemented by DB services supporting RANDOMIZE_TO_ARM. The allocation counters are stored in the DB,
+// so they are shared by all participants and service replicas. RANDOMIZE_TO_ARM reserves the next slot atomically, the slots of the
+// participant (ActionData.RandomizationSlots) are committed with CommitRandomizationSlots after the participant state was saved,
+// or released if it was not. Slots of failing rules are released by PerformRules and RunDueScheduledActions.
+type RandomizationDBService interface {
+	GetRandomizationAllocation(instanceID string, studyKey string, randomizationKey string, stratum string) (types.RandomizationAllocation, error)
+	ReserveRandomizationSlot(instanceID string, studyKey string, randomizationKey string, stratum string, seed int64) (types.RandomizationSlot, error)
+	CommitRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error
+	ReleaseRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error
+}
+
+type randomizationArm struct {
+	Name  string
+	Ratio int
+}
+
+// parseRandomizationArms reads a list of arms with optional ratios, e.g. "control:1;treatment:2" (ratio 1 if omitted)
+func parseRandomizationArms(list string) (arms []randomizationArm, err error) {
+	arms = []randomizationArm{}
+	names := map[string]bool{}
+	for _, item := range splitList(list) {
+		parts := strings.SplitN(item, ":", 2)
+		arm := randomizationArm{Name: strings.TrimSpace(parts[0]), Ratio: 1}
+		if len(parts) == 2 {
+			arm.Ratio, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err != nil || arm.Ratio < 1 {
+				return arms, fmt.Errorf("invalid ratio for arm '%s'", arm.Name)
+			}
+		}
+		if arm.Name == "" || strings.ContainsAny(arm.Name, ".$") {
+			return arms, fmt.Errorf("invalid arm name '%s'", arm.Name)
+		}
+		if names[arm.Name] {
+			return arms, fmt.Errorf("duplicate arm '%s'", arm.Name)
+		}
+		names[arm.Name] = true
+		arms = append(arms, arm)
+	}
+	if len(arms) < 2 {
+		return arms, errors.New("at least two arms are required")
+	}
+	return arms, nil
+}
+
+// randomizationBlock returns the unshuffled block, containing every arm according to its ratio.
+// A block size of 0 uses the smallest possible block (sum of the ratios).
+func randomizationBlock(arms []randomizationArm, blockSize int) ([]string, error) {
+	ratioSum := 0
+	for _, arm := range arms {
+		ratioSum += arm.Ratio
+	}
+	if blockSize == 0 {
+		blockSize = ratioSum
+	}
+	if blockSize < 0 || blockSize > MAX_RANDOMIZATION_BLOCK_SIZE || blockSize%ratioSum != 0 {
+		return nil, fmt.Errorf("block size %d must be a multiple of the sum of the ratios (%d) and at most %d", blockSize, ratioSum, MAX_RANDOMIZATION_BLOCK_SIZE)
+	}
+
+	block := make([]string, 0, blockSize)
+	for i := 0; i < blockSize/ratioSum; i++ {
+		for _, arm := range arms {
+			for r := 0; r < arm.Ratio; r++ {
+				block = append(block, arm.Name)
+			}
+		}
+	}
+	return block, nil
+}
+
+// armForSlot returns the arm for the n-th allocation (starting at 0). Each block is shuffled with a permutation derived from
+// the seed and the block number, so every replica computes the same sequence without storing it.
+func armForSlot(block []string, seed int64, slot int64) string {
+	blockNumber := slot / int64(len(block))
+	position := slot % int64(len(block))
+
+	shuffled := make([]string, len(block))
+	copy(shuffled, block)
+	blockSeed := int64(uint64(seed) ^ (uint64(blockNumber+1) * 0x9E3779B97F4A7C15))
+	rng := rand.New(rand.NewSource(blockSeed))
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled[position]
+}
+
+func newRandomizationSeed() (int64, error) {
+	b := make([]byte, 8)
+	if _, err := crand.Read(b); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b) & math.MaxInt64), nil
+}
+
+// randomizeToArm allocates the participant to an arm using (stratified) block randomization and stores the arm in a participant flag.
+// Participants with the flag already set are not randomized again.
+func randomizeToArm(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	newState = oldState
+	if len(action.Data) < 4 {
+		return newState, errors.New("randomizeToArm must have at least four arguments")
+	}
+	EvalContext := EvalContext{
+		Event:            event,
+		ParticipantState: newState.PState,
+		Configs:          configs,
+	}
+	args := make([]interface{}, 4)
+	for i := range args {
+		args[i], err = EvalContext.expressionArgResolver(action.Data[i])
+		if err != nil {
+			return newState, err
+		}
+	}
+	randomizationKey, ok1 := args[0].(string)
+	flagKey, ok2 := args[1].(string)
+	armList, ok3 := args[2].(string)
+	blockSize, ok4 := args[3].(float64)
+	if !ok1 || !ok2 || !ok3 || !ok4 || randomizationKey == "" || flagKey == "" {
+		return newState, errors.New("could not parse arguments")
+	}
+
+	if oldState.PState.Flags[flagKey] != "" {
+		// already randomized
+		return newState, nil
+	}
+
+	strata := []string{}
+	for _, arg := range action.Data[4:] {
+		v, err := EvalContext.expressionArgResolver(arg)
+		if err != nil {
+			return newState, err
+		}
+		switch value := v.(type) {
+		case string:
+			strata = append(strata, value)
+		case float64:
+			strata = append(strata, strconv.FormatFloat(value, 'f', -1, 64))
+		case bool:
+			strata = append(strata, strconv.FormatBool(value))
+		default:
+			return newState, errors.New("could not parse stratum")
+		}
+	}
+	stratum := strings.Join(strata, STRATA_SEPARATOR)
+
+	arms, err := parseRandomizationArms(armList)
+	if err != nil {
+		return newState, err
+	}
+	block, err := randomizationBlock(arms, int(blockSize))
+	if err != nil {
+		return newState, err
+	}
+
+	// nothing may fail after the reservation, the slot would be lost otherwise
+	slot, err := reserveRandomizationSlot(event, configs, randomizationKey, stratum)
+	if err != nil {
+		return newState, err
+	}
+	slot.Arm = armForSlot(block, slot.Seed, slot.Slot)
+
+	newState.RandomizationSlots = make([]types.RandomizationSlot, len(oldState.RandomizationSlots), len(oldState.RandomizationSlots)+1)
+	copy(newState.RandomizationSlots, oldState.RandomizationSlots)
+	newState.RandomizationSlots = append(newState.RandomizationSlots, slot)
+
+	newState.PState.Flags = make(map[string]string, len(oldState.PState.Flags)+1)
+	for k, v := range oldState.PState.Flags {
+		newState.PState.Flags[k] = v
+	}
+	newState.PState.Flags[flagKey] = slot.Arm
+	return newState, nil
+}
+
+// reserveRandomizationSlot takes the next slot of the allocation in the DB. The seed is only used if the allocation is created.
+func reserveRandomizationSlot(event types.StudyEvent, configs ActionConfigs, randomizationKey string, stratum string) (slot types.RandomizationSlot, err error) {
+	dbService, ok := configs.DBService.(RandomizationDBService)
+	if !ok {
+		return slot, errors.New("randomization is not supported by the DB service")
+	}
+	seed, err := newRandomizationSeed()
+	if err != nil {
+		return slot, err
+	}
+	return dbService.ReserveRandomizationSlot(event.InstanceID, event.StudyKey, randomizationKey, stratum, seed)
+}
+
+// CommitRandomizationSlots counts the allocations of the participant in the DB if the participant state was saved,
+// otherwise the slots are released for the next participants. Errors are only logged, as the participant keeps the arm.
+func CommitRandomizationSlots(dbService RandomizationDBService, instanceID string, studyKey string, slots []types.RandomizationSlot, saved bool) {
+	if !saved {
+		releaseRandomizationSlots(dbService, instanceID, studyKey, slots)
+		return
+	}
+	for _, slot := range slots {
+		if err := dbService.CommitRandomizationSlot(instanceID, studyKey, slot); err != nil {
+			logger.Error.Printf("unexpected error when committing randomization slot %d of %s (%s) in study %s: %v", slot.Slot, slot.RandomizationKey, slot.Stratum, studyKey, err)
+		}
+	}
+}
+
+func releaseRandomizationSlots(dbService RandomizationDBService, instanceID string, studyKey string, slots []types.RandomizationSlot) {
+	for _, slot := range slots {
+		if err := dbService.ReleaseRandomizationSlot(instanceID, studyKey, slot); err != nil {
+			logger.Error.Printf("unexpected error when releasing randomization slot %d of %s (%s) in study %s: %v", slot.Slot, slot.RandomizationKey, slot.Stratum, studyKey, err)
+		}
+	}
+}
+
+// releaseReservedRandomizationSlots releases the slots reserved through the event DB service since the given number of reservations,
+// e.g. for a failing rule whose changes are dropped
+func releaseReservedRandomizationSlots(eventDB *EventDBService, event types.StudyEvent, since int) {
+	slots := eventDB.ReservedRandomizationSlots()
+	if since >= len(slots) {
+		return
+	}
+	releaseRandomizationSlots(eventDB, event.InstanceID, event.StudyKey, slots[since:])
+}
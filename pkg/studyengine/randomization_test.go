@@ -0,0 +1,254 @@
+package studyengine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// mockRandomizationDBService keeps the allocations in memory
+type mockRandomizationDBService struct {
+	DryRunDBService
+	mu          sync.Mutex
+	allocations map[string]types.RandomizationAllocation
+}
+
+func (db *mockRandomizationDBService) GetRandomizationAllocation(instanceID string, studyKey string, randomizationKey string, stratum string) (types.RandomizationAllocation, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	allocation, ok := db.allocations[randomizationKey+STRATA_SEPARATOR+stratum]
+	if !ok {
+		return types.RandomizationAllocation{RandomizationKey: randomizationKey, Stratum: stratum}, nil
+	}
+	return allocation, nil
+}
+
+func (db *mockRandomizationDBService) ReserveRandomizationSlot(instanceID string, studyKey string, randomizationKey string, stratum string, seed int64) (types.RandomizationSlot, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.allocations == nil {
+		db.allocations = map[string]types.RandomizationAllocation{}
+	}
+	key := randomizationKey + STRATA_SEPARATOR + stratum
+	allocation, ok := db.allocations[key]
+	if !ok {
+		allocation = types.RandomizationAllocation{RandomizationKey: randomizationKey, Stratum: stratum, Seed: seed, Counts: map[string]int64{}}
+	}
+	slot := types.RandomizationSlot{RandomizationKey: randomizationKey, Stratum: stratum, Seed: allocation.Seed}
+	if len(allocation.Released) > 0 {
+		slot.Slot = allocation.Released[0]
+		allocation.Released = allocation.Released[1:]
+	} else {
+		slot.Slot = allocation.Total
+		allocation.Total += 1
+	}
+	db.allocations[key] = allocation
+	return slot, nil
+}
+
+func (db *mockRandomizationDBService) CommitRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	allocation, ok := db.allocations[slot.RandomizationKey+STRATA_SEPARATOR+slot.Stratum]
+	if !ok {
+		return errors.New("randomization allocation not found")
+	}
+	allocation.Counts[slot.Arm] += 1
+	return nil
+}
+
+func (db *mockRandomizationDBService) ReleaseRandomizationSlot(instanceID string, studyKey string, slot types.RandomizationSlot) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	key := slot.RandomizationKey + STRATA_SEPARATOR + slot.Stratum
+	allocation, ok := db.allocations[key]
+	if !ok {
+		return errors.New("randomization allocation not found")
+	}
+	allocation.Released = append(allocation.Released, slot.Slot)
+	db.allocations[key] = allocation
+	return nil
+}
+
+func TestRandomizeToArm(t *testing.T) {
+	event := types.StudyEvent{Type: "ENTER", InstanceID: "test", StudyKey: "study1"}
+	randomize := func(arms string, blockSize float64, strata ...types.ExpressionArg) types.Expression {
+		data := []types.ExpressionArg{
+			{DType: "str", Str: "main"},
+			{DType: "str", Str: "arm"},
+			{DType: "str", Str: arms},
+			{DType: "num", Num: blockSize},
+		}
+		return types.Expression{Name: "RANDOMIZE_TO_ARM", Data: append(data, strata...)}
+	}
+
+	// randomizeAndCommit performs the action for a new participant and commits the allocation, as done after saving the state
+	randomizeAndCommit := func(t *testing.T, action types.Expression, dbService *mockRandomizationDBService) string {
+		newState, err := ActionEval(action, ActionData{}, event, ActionConfigs{DBService: dbService})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(newState.RandomizationSlots) != 1 {
+			t.Fatalf("unexpected slots: %v", newState.RandomizationSlots)
+		}
+		CommitRandomizationSlots(dbService, event.InstanceID, event.StudyKey, newState.RandomizationSlots, true)
+		return newState.PState.Flags["arm"]
+	}
+
+	t.Run("balanced blocks", func(t *testing.T) {
+		dbService := &mockRandomizationDBService{}
+		action := randomize("control:1;treatment:2", 6)
+		if errs := ValidateStudyRules([]types.Expression{action}); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+		for block := 0; block < 4; block++ {
+			counts := map[string]int{}
+			for i := 0; i < 6; i++ {
+				counts[randomizeAndCommit(t, action, dbService)] += 1
+			}
+			if counts["control"] != 2 || counts["treatment"] != 4 {
+				t.Errorf("unexpected counts in block %d: %v", block, counts)
+			}
+		}
+		allocation := dbService.allocations["main|"]
+		if allocation.Total != 24 || allocation.Counts["control"] != 8 || allocation.Counts["treatment"] != 16 {
+			t.Errorf("unexpected allocation: %v", allocation)
+		}
+	})
+
+	t.Run("concurrent allocations", func(t *testing.T) {
+		dbService := &mockRandomizationDBService{}
+		action := randomize("a;b", 0)
+		slots := make([]types.RandomizationSlot, 20)
+		var wg sync.WaitGroup
+		for i := range slots {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				newState, err := ActionEval(action, ActionData{}, event, ActionConfigs{DBService: dbService})
+				if err != nil || len(newState.RandomizationSlots) != 1 {
+					t.Errorf("unexpected result: %v, %v", newState.RandomizationSlots, err)
+					return
+				}
+				slots[i] = newState.RandomizationSlots[0]
+			}(i)
+		}
+		wg.Wait()
+		taken := map[int64]bool{}
+		for _, slot := range slots {
+			if taken[slot.Slot] || slot.Seed != slots[0].Seed {
+				t.Errorf("every participant should get its own slot of the same sequence: %v", slots)
+				break
+			}
+			taken[slot.Slot] = true
+		}
+	})
+
+	t.Run("released allocations", func(t *testing.T) {
+		dbService := &mockRandomizationDBService{}
+		action := randomize("a;b", 0)
+		first, err := ActionEval(action, ActionData{}, event, ActionConfigs{DBService: dbService})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// e.g. the state could not be saved: the next participant gets the same slot
+		CommitRandomizationSlots(dbService, event.InstanceID, event.StudyKey, first.RandomizationSlots, false)
+		second, err := ActionEval(action, ActionData{}, event, ActionConfigs{DBService: dbService})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if first.RandomizationSlots[0].Slot != 0 || second.RandomizationSlots[0].Slot != 0 {
+			t.Errorf("unexpected slots: %v, %v", first.RandomizationSlots, second.RandomizationSlots)
+		}
+
+		// slots of a failing rule are released by PerformRules
+		failingRule := types.Expression{Name: "DO", Data: []types.ExpressionArg{
+			{DType: "exp", Exp: &action},
+			{DType: "exp", Exp: &types.Expression{Name: "UNKNOWN_ACTION"}},
+		}}
+		_, ruleErrors := PerformRules([]types.Expression{failingRule}, ActionData{}, event, ActionConfigs{DBService: dbService}, "")
+		if len(ruleErrors) != 1 {
+			t.Fatalf("unexpected rule errors: %v", ruleErrors)
+		}
+		allocation := dbService.allocations["main|"]
+		if allocation.Total != 2 || len(allocation.Released) != 1 || allocation.Released[0] != 1 {
+			t.Errorf("unexpected allocation: %v", allocation)
+		}
+	})
+
+	t.Run("stratified", func(t *testing.T) {
+		dbService := &mockRandomizationDBService{}
+		for _, stratum := range []string{"female", "male", "female"} {
+			randomizeAndCommit(t, randomize("a;b", 0, types.ExpressionArg{DType: "str", Str: stratum}, types.ExpressionArg{DType: "num", Num: 2}), dbService)
+		}
+		female := dbService.allocations["main|female|2"]
+		male := dbService.allocations["main|male|2"]
+		if female.Total != 2 || male.Total != 1 {
+			t.Errorf("unexpected allocations: %v", dbService.allocations)
+		}
+	})
+
+	t.Run("same key twice in one event", func(t *testing.T) {
+		dbService := &mockRandomizationDBService{}
+		state, err := ActionEval(randomize("a;b", 0), ActionData{}, event, ActionConfigs{DBService: dbService})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second := randomize("a;b", 0)
+		second.Data[1].Str = "otherFlag"
+		state, err = ActionEval(second, state, event, ActionConfigs{DBService: dbService})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(state.RandomizationSlots) != 2 || state.RandomizationSlots[1].Slot != 1 || state.RandomizationSlots[1].Seed != state.RandomizationSlots[0].Seed {
+			t.Errorf("unexpected slots: %v", state.RandomizationSlots)
+		}
+		if state.PState.Flags["arm"] == state.PState.Flags["otherFlag"] {
+			t.Errorf("both slots of the block should be used: %v", state.PState.Flags)
+		}
+	})
+
+	t.Run("already randomized", func(t *testing.T) {
+		dbService := &mockRandomizationDBService{}
+		oldState := ActionData{PState: types.ParticipantState{Flags: map[string]string{"arm": "control"}}}
+		newState, err := ActionEval(randomize("control;treatment", 2), oldState, event, ActionConfigs{DBService: dbService})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["arm"] != "control" || len(newState.RandomizationSlots) != 0 {
+			t.Errorf("participant should not be randomized again: %v", newState.PState.Flags)
+		}
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		configs := ActionConfigs{DBService: &mockRandomizationDBService{}}
+		for _, action := range []types.Expression{
+			randomize("control", 0),
+			randomize("control;control", 0),
+			randomize("control:0;treatment", 0),
+			randomize("control:1;treatment:2", 4),
+			randomize("a.b;c", 0),
+		} {
+			if _, err := ActionEval(action, ActionData{}, event, configs); err == nil {
+				t.Errorf("should return an error for %v", action.Data)
+			}
+		}
+		if _, err := ActionEval(randomize("a;b", 0), ActionData{}, event, ActionConfigs{}); err == nil {
+			t.Error("should return an error without DB service")
+		}
+	})
+
+	t.Run("same sequence for same seed", func(t *testing.T) {
+		block, err := randomizationBlock([]randomizationArm{{Name: "a", Ratio: 1}, {Name: "b", Ratio: 1}}, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for slot := int64(0); slot < 30; slot++ {
+			if armForSlot(block, 42, slot) != armForSlot(block, 42, slot) {
+				t.Errorf("sequence should be deterministic for slot %d", slot)
+			}
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// ActionHandler performs an action and returns the updated state
+type ActionHandler func(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (ActionData, error)
+
+// ExpressionHandler evaluates an expression in the given context
+type ExpressionHandler func(ctx EvalContext, exp types.Expression) (interface{}, error)
+
+// Metadata describes an action or expression for validation and documentation
+type Metadata struct {
+	Category    string
+	Description string
+	ArgNames    []string   // names of the arguments by position, the last one is used for additional variadic arguments
+	Signature   *Signature // nil if the arguments are not checked
+}
+
+// ActionDef is a registered action
+type ActionDef struct {
+	Name     string
+	Handler  ActionHandler
+	Metadata Metadata
+}
+
+// ExpressionDef is a registered expression
+type ExpressionDef struct {
+	Name     string
+	Handler  ExpressionHandler
+	Metadata Metadata
+}
+
+var (
+	registryLock          sync.RWMutex
+	registeredActions     = map[string]ActionDef{}
+	registeredExpressions = map[string]ExpressionDef{}
+)
+
+// RegisterAction makes an action available for study rules. Embedding applications should register
+// their own actions at startup, before any rule is evaluated. Names must be unique across actions and expressions.
+func RegisterAction(name string, handler ActionHandler, meta Metadata) error {
+	if name == "" || handler == nil {
+		return errors.New("action name and handler must be defined")
+	}
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if isRegistered(name) {
+		return fmt.Errorf("'%s' is already registered", name)
+	}
+	registeredActions[name] = ActionDef{Name: name, Handler: handler, Metadata: meta}
+	return nil
+}
+
+// RegisterExpression makes an expression available for study rules. Embedding applications should register
+// their own expressions at startup, before any rule is evaluated. Names must be unique across actions and expressions.
+func RegisterExpression(name string, handler ExpressionHandler, meta Metadata) error {
+	if name == "" || handler == nil {
+		return errors.New("expression name and handler must be defined")
+	}
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if isRegistered(name) {
+		return fmt.Errorf("'%s' is already registered", name)
+	}
+	registeredExpressions[name] = ExpressionDef{Name: name, Handler: handler, Metadata: meta}
+	return nil
+}
+
+// caller must hold the registry lock
+func isRegistered(name string) bool {
+	if _, ok := registeredActions[name]; ok {
+		return true
+	}
+	_, ok := registeredExpressions[name]
+	return ok
+}
+
+func mustRegisterAction(name string, handler ActionHandler, meta Metadata) {
+	if err := RegisterAction(name, handler, meta); err != nil {
+		panic(err)
+	}
+}
+
+func mustRegisterExpression(name string, handler ExpressionHandler, meta Metadata) {
+	if err := RegisterExpression(name, handler, meta); err != nil {
+		panic(err)
+	}
+}
+
+// LookupAction returns the registered action with the given name
+func LookupAction(name string) (ActionDef, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	def, ok := registeredActions[name]
+	return def, ok
+}
+
+// LookupExpression returns the registered expression with the given name
+func LookupExpression(name string) (ExpressionDef, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	def, ok := registeredExpressions[name]
+	return def, ok
+}
+
+// RegisteredActions returns all registered actions sorted by category and name
+func RegisteredActions() []ActionDef {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	defs := make([]ActionDef, 0, len(registeredActions))
+	for _, def := range registeredActions {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return lessByCategoryAndName(defs[i].Metadata.Category, defs[i].Name, defs[j].Metadata.Category, defs[j].Name)
+	})
+	return defs
+}
+
+// RegisteredExpressions returns all registered expressions sorted by category and name
+func RegisteredExpressions() []ExpressionDef {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	defs := make([]ExpressionDef, 0, len(registeredExpressions))
+	for _, def := range registeredExpressions {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return lessByCategoryAndName(defs[i].Metadata.Category, defs[i].Name, defs[j].Metadata.Category, defs[j].Name)
+	})
+	return defs
+}
+
+func lessByCategoryAndName(catA string, nameA string, catB string, nameB string) bool {
+	if catA != catB {
+		return catA < catB
+	}
+	return nameA < nameB
+}
+
+// WriteActionDocs writes a markdown reference of all registered actions
+func WriteActionDocs(w io.Writer) error {
+	entries := []docEntry{}
+	for _, def := range RegisteredActions() {
+		entries = append(entries, docEntry{Name: def.Name, Metadata: def.Metadata})
+	}
+	return writeDocs(w, "Study Actions Reference", entries)
+}
+
+// WriteExpressionDocs writes a markdown reference of all registered expressions
+func WriteExpressionDocs(w io.Writer) error {
+	entries := []docEntry{}
+	for _, def := range RegisteredExpressions() {
+		entries = append(entries, docEntry{Name: def.Name, Metadata: def.Metadata})
+	}
+	return writeDocs(w, "Study Expressions Reference", entries)
+}
+
+type docEntry struct {
+	Name     string
+	Metadata Metadata
+}
+
+func writeDocs(w io.Writer, title string, entries []docEntry) error {
+	var sb strings.Builder
+	sb.WriteString("# " + title + "\n\n")
+	sb.WriteString("<!-- generated from the studyengine registry, do not edit manually -->\n")
+
+	currentCategory := ""
+	for i, entry := range entries {
+		if i == 0 || entry.Metadata.Category != currentCategory {
+			currentCategory = entry.Metadata.Category
+			category := currentCategory
+			if category == "" {
+				category = "Other"
+			}
+			sb.WriteString("\n## " + category + "\n")
+		}
+		sb.WriteString("\n### " + entry.Name + "\n\n")
+		if entry.Metadata.Description != "" {
+			sb.WriteString(entry.Metadata.Description + "\n\n")
+		}
+		sb.WriteString("```\n" + functionalDescription(entry.Name, entry.Metadata) + "\n```\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// functionalDescription formats the signature like NAME(arg1: str, arg2: num[, arg3: str])
+func functionalDescription(name string, meta Metadata) string {
+	if meta.Signature == nil {
+		return name + "(...)"
+	}
+	sig := meta.Signature
+	fixedArgs := sig.MaxArgs
+	if sig.MaxArgs < 0 {
+		fixedArgs = len(sig.Args)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(name + "(")
+	optionals := 0
+	for i := 0; i <= fixedArgs; i++ {
+		isVarArg := i == fixedArgs
+		if isVarArg && sig.MaxArgs >= 0 {
+			break
+		}
+		if i >= sig.MinArgs {
+			sb.WriteString("[")
+			optionals++
+		}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(argName(meta, i) + ": " + sig.argType(i))
+		if isVarArg {
+			sb.WriteString("...")
+		}
+	}
+	sb.WriteString(strings.Repeat("]", optionals) + ")")
+	return sb.String()
+}
+
+func argName(meta Metadata, index int) string {
+	if index < len(meta.ArgNames) {
+		return meta.ArgNames[index]
+	}
+	if len(meta.ArgNames) > 0 && meta.Signature != nil && meta.Signature.MaxArgs < 0 {
+		return meta.ArgNames[len(meta.ArgNames)-1]
+	}
+	return fmt.Sprintf("arg%d", index+1)
+}
@@ -0,0 +1,134 @@
+package studyengine
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("built-ins are registered", func(t *testing.T) {
+		if _, ok := LookupAction("UPDATE_FLAG"); !ok {
+			t.Error("UPDATE_FLAG should be registered")
+		}
+		if _, ok := LookupExpression("incomingState:hasStudyStatus"); !ok {
+			t.Error("incomingState:hasStudyStatus should be registered")
+		}
+		for _, def := range RegisteredActions() {
+			if def.Metadata.Signature == nil || def.Metadata.Description == "" {
+				t.Errorf("missing metadata for %s", def.Name)
+			}
+		}
+		for _, def := range RegisteredExpressions() {
+			if def.Metadata.Signature == nil || def.Metadata.Description == "" {
+				t.Errorf("missing metadata for %s", def.Name)
+			}
+		}
+	})
+
+	t.Run("register with existing name", func(t *testing.T) {
+		err := RegisterAction("UPDATE_FLAG", ifAction, Metadata{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+		err = RegisterExpression("UPDATE_FLAG", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return nil, nil
+		}, Metadata{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+		err = RegisterExpression("testMissingHandler", nil, Metadata{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("custom action and expression", func(t *testing.T) {
+		t.Cleanup(func() {
+			registryLock.Lock()
+			defer registryLock.Unlock()
+			delete(registeredExpressions, "testFlagCount")
+			delete(registeredActions, "TEST_SET_GROUP")
+		})
+		err := RegisterExpression("testFlagCount", func(ctx EvalContext, exp types.Expression) (interface{}, error) {
+			return float64(len(ctx.ParticipantState.Flags)), nil
+		}, Metadata{
+			Description: "Returns the number of flags.",
+			Signature:   &Signature{MinArgs: 0, MaxArgs: 0},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		err = RegisterAction("TEST_SET_GROUP", func(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (ActionData, error) {
+			newState := oldState
+			newState.PState.Flags = map[string]string{"group": "custom"}
+			return newState, nil
+		}, Metadata{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		rule := types.Expression{Name: "IF", Data: []types.ExpressionArg{
+			{DType: "exp", Exp: &types.Expression{Name: "eq", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "testFlagCount"}},
+				{DType: "num", Num: 0},
+			}}},
+			{DType: "exp", Exp: &types.Expression{Name: "TEST_SET_GROUP", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "getStudyEntryTime"}},
+			}}},
+		}}
+		if errs := ValidateStudyRules([]types.Expression{rule}); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+
+		newState, err := ActionEval(rule, ActionData{}, types.StudyEvent{}, ActionConfigs{})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["group"] != "custom" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+
+		var buf bytes.Buffer
+		if err := WriteExpressionDocs(&buf); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !strings.Contains(buf.String(), "### testFlagCount\n\nReturns the number of flags.\n\n```\ntestFlagCount()\n```") {
+			t.Errorf("custom expression missing in docs: %s", buf.String())
+		}
+	})
+}
+
+func TestFunctionalDescription(t *testing.T) {
+	testCases := []struct {
+		name     string
+		meta     Metadata
+		expected string
+	}{
+		{name: "NO_ARGS", meta: Metadata{Signature: &Signature{}}, expected: "NO_ARGS()"},
+		{name: "UNCHECKED", meta: Metadata{}, expected: "UNCHECKED(...)"},
+		{
+			name: "OPTIONAL",
+			meta: Metadata{
+				ArgNames:  []string{"key", "since"},
+				Signature: &Signature{MinArgs: 1, MaxArgs: 2, Args: []string{ARG_TYPE_STR, ARG_TYPE_NUM}},
+			},
+			expected: "OPTIONAL(key: str[, since: num])",
+		},
+		{
+			name: "VARIADIC",
+			meta: Metadata{
+				ArgNames:  []string{"key", "values"},
+				Signature: &Signature{MinArgs: 2, MaxArgs: -1, Args: []string{ARG_TYPE_STR}, VarArgs: ARG_TYPE_NUM},
+			},
+			expected: "VARIADIC(key: str, values: num...)",
+		},
+	}
+	for _, tc := range testCases {
+		if d := functionalDescription(tc.name, tc.meta); d != tc.expected {
+			t.Errorf("unexpected description: %s, expected: %s", d, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/influenzanet/study-service/pkg/dbs/studydb"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// findParticipantReports returns the saved reports of the participant with the key, newest first.
+// Reports created during the current event are saved after the rules and are not included.
+func (ctx EvalContext) findParticipantReports(name string, reportKey string, since int64) ([]types.Report, error) {
+	if ctx.Configs.DBService == nil {
+		return nil, fmt.Errorf("%s: DB connection not available in the context", name)
+	}
+	if ctx.Event.InstanceID == "" || ctx.Event.StudyKey == "" {
+		return nil, fmt.Errorf("%s: instanceID or study key missing from context", name)
+	}
+	return ctx.Configs.DBService.FindReports(ctx.Event.InstanceID, ctx.Event.StudyKey, studydb.ReportQuery{
+		ParticipantID: ctx.ParticipantState.ParticipantID,
+		Key:           reportKey,
+		Since:         since,
+	})
+}
+
+// optionalSinceArg resolves the optional timestamp argument at the index, 0 (no limit) if omitted
+func (ctx EvalContext) optionalSinceArg(args []types.ExpressionArg, index int) (int64, error) {
+	if len(args) <= index {
+		return 0, nil
+	}
+	since, err := ctx.mustGetNumValue(args[index])
+	if err != nil {
+		return 0, err
+	}
+	return int64(since), nil
+}
+
+// reportDataValue returns the value as number for the dtypes "int" and "float", otherwise as string
+func reportDataValue(data types.ReportData) interface{} {
+	if data.Dtype == "int" || data.Dtype == "float" {
+		if v, err := strconv.ParseFloat(data.Value, 64); err == nil {
+			return v
+		}
+	}
+	return data.Value
+}
+
+// getLastReportValue returns the value of the data key from the most recent report containing it, an empty string if no report contains it
+func (ctx EvalContext) getLastReportValue(exp types.Expression) (val interface{}, err error) {
+	if len(exp.Data) != 2 && len(exp.Data) != 3 {
+		return val, errors.New("should have two or three arguments")
+	}
+	reportKey, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	dataKey, err := ctx.mustGetStrValue(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	since, err := ctx.optionalSinceArg(exp.Data, 2)
+	if err != nil {
+		return val, err
+	}
+
+	reports, err := ctx.findParticipantReports("getLastReportValue", reportKey, since)
+	if err != nil {
+		return val, err
+	}
+	for _, report := range reports {
+		for _, d := range report.Data {
+			if d.Key == dataKey {
+				return reportDataValue(d), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// countReports returns the number of reports with the key, optionally only those created after since
+func (ctx EvalContext) countReports(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 1 && len(exp.Data) != 2 {
+		return val, errors.New("should have one or two arguments")
+	}
+	reportKey, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	since, err := ctx.optionalSinceArg(exp.Data, 1)
+	if err != nil {
+		return val, err
+	}
+
+	reports, err := ctx.findParticipantReports("countReports", reportKey, since)
+	if err != nil {
+		return val, err
+	}
+	return float64(len(reports)), nil
+}
+
+// hasReportWithValue checks if any report with the key contains the data key with the value (compared as string)
+func (ctx EvalContext) hasReportWithValue(exp types.Expression) (val bool, err error) {
+	if len(exp.Data) != 3 && len(exp.Data) != 4 {
+		return val, errors.New("should have three or four arguments")
+	}
+	reportKey, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	dataKey, err := ctx.mustGetStrValue(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	arg3, err := ctx.expressionArgResolver(exp.Data[2])
+	if err != nil {
+		return val, err
+	}
+	since, err := ctx.optionalSinceArg(exp.Data, 3)
+	if err != nil {
+		return val, err
+	}
+
+	reports, err := ctx.findParticipantReports("hasReportWithValue", reportKey, since)
+	if err != nil {
+		return val, err
+	}
+	for _, report := range reports {
+		for _, d := range report.Data {
+			if d.Key != dataKey {
+				continue
+			}
+			if reportValueEquals(d, arg3) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// reportValueEquals compares the stored value with a string, or numerically with a number (values of UPDATE_REPORT_DATA are formatted with decimals)
+func reportValueEquals(data types.ReportData, value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		n, err := strconv.ParseFloat(data.Value, 64)
+		return err == nil && n == v
+	case bool:
+		return data.Value == strconv.FormatBool(v)
+	case string:
+		return data.Value == v
+	default:
+		return false
+	}
+}
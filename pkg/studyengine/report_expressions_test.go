@@ -0,0 +1,76 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestReportExpressions(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: name, Data: args}
+	}
+
+	ctx := EvalContext{
+		Event:            types.StudyEvent{InstanceID: "instance", StudyKey: "study"},
+		ParticipantState: types.ParticipantState{ParticipantID: "p1"},
+		Configs: ActionConfigs{DBService: MockStudyDBService{Reports: []types.Report{
+			{Key: "symptoms", ParticipantID: "p1", Timestamp: 100, Data: []types.ReportData{
+				{Key: "onset", Value: "1000.000000", Dtype: "float"},
+				{Key: "severity", Value: "mild"},
+			}},
+			{Key: "symptoms", ParticipantID: "p1", Timestamp: 300, Data: []types.ReportData{
+				{Key: "severity", Value: "severe"},
+			}},
+			{Key: "symptoms", ParticipantID: "p1", Timestamp: 200, Data: []types.ReportData{
+				{Key: "onset", Value: "2000", Dtype: "int"},
+			}},
+			{Key: "symptoms", ParticipantID: "p2", Timestamp: 400, Data: []types.ReportData{
+				{Key: "severity", Value: "none"},
+			}},
+			{Key: "other", ParticipantID: "p1", Timestamp: 500},
+		}}},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected interface{}
+	}{
+		{name: "last value", exp: exp("getLastReportValue", str("symptoms"), str("severity")), expected: "severe"},
+		{name: "last value skips reports without data key", exp: exp("getLastReportValue", str("symptoms"), str("onset")), expected: 2000.0},
+		{name: "last value since", exp: exp("getLastReportValue", str("symptoms"), str("onset"), num(250)), expected: ""},
+		{name: "last value missing", exp: exp("getLastReportValue", str("unknown"), str("onset")), expected: ""},
+		{name: "count", exp: exp("countReports", str("symptoms")), expected: 3.0},
+		{name: "count since", exp: exp("countReports", str("symptoms"), num(100)), expected: 2.0},
+		{name: "has value", exp: exp("hasReportWithValue", str("symptoms"), str("severity"), str("mild")), expected: true},
+		{name: "has value of other participant", exp: exp("hasReportWithValue", str("symptoms"), str("severity"), str("none")), expected: false},
+		{name: "has numeric value", exp: exp("hasReportWithValue", str("symptoms"), str("onset"), num(1000)), expected: true},
+		{name: "has value since", exp: exp("hasReportWithValue", str("symptoms"), str("severity"), str("mild"), num(100)), expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if errs := ValidateExpression(tc.exp, "exp"); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+			val, err := ExpressionEval(tc.exp, ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val != tc.expected {
+				t.Errorf("unexpected value: %v, expected %v", val, tc.expected)
+			}
+		})
+	}
+
+	t.Run("without DB service", func(t *testing.T) {
+		if _, err := ExpressionEval(exp("countReports", str("symptoms")), EvalContext{Event: ctx.Event}); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package studyengine
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// researcher message type used to report failing rules
+const RESEARCHER_MESSAGE_TYPE_RULE_ERROR = "ruleError"
+
+// RuleError describes a rule which could not be performed for a participant
+type RuleError struct {
+	RuleIndex int    `json:"ruleIndex"`
+	RuleName  string `json:"ruleName"`
+	Error     string `json:"error"`
+	EvalLimit string `json:"evalLimit,omitempty"` // set if the rule exceeded a limit of the evaluation (EVAL_LIMIT_*)
+	// set for a failing scheduled action, RuleIndex is -1 and RuleName the label of the scheduled action then
+	ScheduledActionID string `json:"scheduledActionId,omitempty"`
+}
+
+// IsValidRuleErrorPolicy returns true for the known policies and the empty string (default policy)
+func IsValidRuleErrorPolicy(policy string) bool {
+	switch policy {
+	case "", types.RULE_ERROR_POLICY_SKIP_FAILING_RULE, types.RULE_ERROR_POLICY_ALL_OR_NOTHING:
+		return true
+	default:
+		return false
+	}
+}
+
+// PerformRules evaluates the rules one after another, starting with oldState.
+// If a rule fails, its partial changes are dropped. With RULE_ERROR_POLICY_ALL_OR_NOTHING
+// the evaluation stops and oldState is returned unchanged, otherwise the remaining rules are performed.
+// Side effects already executed through configs (DB writes, external services) cannot be rolled back.
+// DB lookups of the rules are cached for the event, see EventDBService.
+// Without a budget in configs, the default limits apply to all rules together. When a limit is exceeded,
+// the remaining rules are not evaluated.
+func PerformRules(rules []types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs, policy string) (newState ActionData, ruleErrors []RuleError) {
+	ruleErrors = []RuleError{}
+	configs, eventDB := WithEventDBCache(configs)
+	configs, cancel := WithEvalBudget(context.Background(), configs)
+	defer cancel()
+	defer func() {
+		logDBCallStats(eventDB.Stats(), oldState.PState.ParticipantID, event)
+	}()
+	// some actions modify slices and maps in place, so the states before the rules are copied
+	newState = copyActionData(oldState)
+	reservedBefore := len(eventDB.ReservedRandomizationSlots())
+	for index, rule := range rules {
+		reservedBeforeRule := len(eventDB.ReservedRandomizationSlots())
+		state, err := ActionEval(rule, copyActionData(newState), event, configs)
+		if err == nil {
+			// also if the error of the limit was ignored within the rule, e.g. in a condition
+			err = configs.Budget.Err()
+		}
+		if err != nil {
+			ruleErr := RuleError{
+				RuleIndex: index,
+				RuleName:  rule.Name,
+				Error:     err.Error(),
+			}
+			limitErr := AsEvalLimitError(err)
+			if limitErr != nil {
+				ruleErr.EvalLimit = limitErr.Limit
+			}
+			ruleErrors = append(ruleErrors, ruleErr)
+			if policy == types.RULE_ERROR_POLICY_ALL_OR_NOTHING {
+				releaseReservedRandomizationSlots(eventDB, event, reservedBefore)
+				return oldState, ruleErrors
+			}
+			releaseReservedRandomizationSlots(eventDB, event, reservedBeforeRule)
+			if configs.Budget.Err() != nil {
+				// the following rules would fail with the same error
+				break
+			}
+			continue
+		}
+		newState = state
+	}
+	return newState, ruleErrors
+}
+
+// logDBCallStats logs the DB lookups of the rules for a participant, to see what a rule set costs
+func logDBCallStats(stats DBCallStats, participantID string, event types.StudyEvent) {
+	if stats.DBCalls() == 0 && stats.CachedLookups == 0 {
+		return
+	}
+	logger.Debug.Printf("rules for participant %s in study %s (%s): %d DB lookups (%d responses, %d reports), %d from cache", participantID, event.StudyKey, event.Type, stats.DBCalls(), stats.FindSurveyResponses, stats.FindReports, stats.CachedLookups)
+}
+
+// ReportRuleErrors logs the rule errors and saves them as researcher messages, so they are visible in the study management.
+// A message is saved once per rule (or label of a scheduled action) and rules version, until it is deleted. Further errors
+// of the same rule only increase the count of the message, it keeps the participant and error of the first occurrence.
+func ReportRuleErrors(ruleErrors []RuleError, participantID string, event types.StudyEvent, configs ActionConfigs, policy string, rulesVersionID string) {
+	if policy == "" {
+		policy = types.RULE_ERROR_POLICY_SKIP_FAILING_RULE
+	}
+	for _, ruleErr := range ruleErrors {
+		if ruleErr.ScheduledActionID != "" {
+			logger.Error.Printf("error in scheduled action %s (%s) for participant %s in study %s (%s): %s", ruleErr.ScheduledActionID, ruleErr.RuleName, participantID, event.StudyKey, event.Type, ruleErr.Error)
+		} else {
+			logger.Error.Printf("error in rule %d (%s) for participant %s in study %s (%s): %s", ruleErr.RuleIndex, ruleErr.RuleName, participantID, event.StudyKey, event.Type, ruleErr.Error)
+		}
+		if configs.DBService == nil {
+			continue
+		}
+		payload := map[string]string{
+			"eventType": event.Type,
+			"ruleIndex": strconv.Itoa(ruleErr.RuleIndex),
+			"ruleName":  ruleErr.RuleName,
+			"error":     ruleErr.Error,
+			"policy":    policy,
+		}
+		if event.Response.Key != "" {
+			payload["surveyKey"] = event.Response.Key
+		}
+		if ruleErr.EvalLimit != "" {
+			payload["evalLimit"] = ruleErr.EvalLimit
+		}
+		dedupKey := fmt.Sprintf("%s:%s:rule:%d", RESEARCHER_MESSAGE_TYPE_RULE_ERROR, rulesVersionID, ruleErr.RuleIndex)
+		if ruleErr.ScheduledActionID != "" {
+			payload["scheduledActionId"] = ruleErr.ScheduledActionID
+			dedupKey = fmt.Sprintf("%s:%s:scheduled:%s", RESEARCHER_MESSAGE_TYPE_RULE_ERROR, rulesVersionID, ruleErr.RuleName)
+		}
+		if rulesVersionID != "" {
+			payload["rulesVersionId"] = rulesVersionID
+		}
+		message := types.StudyMessage{
+			Type:          RESEARCHER_MESSAGE_TYPE_RULE_ERROR,
+			ParticipantID: participantID,
+			Payload:       payload,
+			DedupKey:      dedupKey,
+		}
+		if err := configs.DBService.SaveResearcherMessage(event.InstanceID, event.StudyKey, message); err != nil {
+			logger.Error.Printf("unexpected error when saving rule error: %v", err)
+		}
+	}
+}
+
+// RuleErrorsToError summarizes the rule errors into one error, nil if there are none
+func RuleErrorsToError(ruleErrors []RuleError) error {
+	if len(ruleErrors) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d rule(s) failed, first error in rule %d: %s", len(ruleErrors), ruleErrors[0].RuleIndex, ruleErrors[0].Error)
+}
+
+func copyActionData(data ActionData) ActionData {
+	newData := ActionData{
+		PState: CopyParticipantState(data.PState),
+	}
+	if data.RandomizationSlots != nil {
+		newData.RandomizationSlots = make([]types.RandomizationSlot, len(data.RandomizationSlots))
+		copy(newData.RandomizationSlots, data.RandomizationSlots)
+	}
+	if data.ReportsToCreate != nil {
+		newData.ReportsToCreate = make(map[string]types.Report, len(data.ReportsToCreate))
+		for k, report := range data.ReportsToCreate {
+			if report.Data != nil {
+				reportData := make([]types.ReportData, len(report.Data))
+				copy(reportData, report.Data)
+				report.Data = reportData
+			}
+			newData.ReportsToCreate[k] = report
+		}
+	}
+	return newData
+}
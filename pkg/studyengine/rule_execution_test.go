@@ -0,0 +1,119 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestPerformRules(t *testing.T) {
+	event := types.StudyEvent{Type: "ENTER", StudyKey: "study1"}
+	updateFlag := func(key string, value string) *types.Expression {
+		return &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+			{DType: "str", Str: key},
+			{DType: "str", Str: value},
+		}}
+	}
+	rules := []types.Expression{
+		*updateFlag("first", "1"),
+		{Name: "DO", Data: []types.ExpressionArg{
+			{DType: "exp", Exp: updateFlag("partial", "1")},
+			{DType: "exp", Exp: &types.Expression{Name: "UPDATE_REPORT_DATA", Data: []types.ExpressionArg{
+				{DType: "str", Str: "report1"},
+				{DType: "str", Str: "attr"},
+				{DType: "str", Str: "value"},
+			}}},
+			{DType: "exp", Exp: &types.Expression{Name: "UNKNOWN_ACTION"}},
+		}},
+		*updateFlag("last", "1"),
+	}
+	newOldState := func() ActionData {
+		return ActionData{
+			PState:          types.ParticipantState{ParticipantID: "p1", Flags: map[string]string{"existing": "1"}},
+			ReportsToCreate: map[string]types.Report{},
+		}
+	}
+
+	t.Run("skip failing rule", func(t *testing.T) {
+		oldState := newOldState()
+		newState, ruleErrors := PerformRules(rules, oldState, event, ActionConfigs{}, types.RULE_ERROR_POLICY_SKIP_FAILING_RULE)
+		if len(ruleErrors) != 1 || ruleErrors[0].RuleIndex != 1 || ruleErrors[0].RuleName != "DO" {
+			t.Errorf("unexpected rule errors: %v", ruleErrors)
+		}
+		flags := newState.PState.Flags
+		if len(flags) != 3 || flags["first"] != "1" || flags["last"] != "1" {
+			t.Errorf("unexpected flags: %v", flags)
+		}
+		if len(newState.ReportsToCreate) != 0 {
+			t.Errorf("changes of failing rule should be dropped: %v", newState.ReportsToCreate)
+		}
+		if len(oldState.PState.Flags) != 1 {
+			t.Errorf("old state should not be modified: %v", oldState.PState.Flags)
+		}
+	})
+
+	t.Run("default policy", func(t *testing.T) {
+		newState, ruleErrors := PerformRules(rules, newOldState(), event, ActionConfigs{}, "")
+		if len(ruleErrors) != 1 || newState.PState.Flags["last"] != "1" {
+			t.Errorf("unexpected result: %v, %v", newState.PState.Flags, ruleErrors)
+		}
+	})
+
+	t.Run("all or nothing", func(t *testing.T) {
+		oldState := newOldState()
+		newState, ruleErrors := PerformRules(rules, oldState, event, ActionConfigs{}, types.RULE_ERROR_POLICY_ALL_OR_NOTHING)
+		if len(ruleErrors) != 1 {
+			t.Errorf("unexpected rule errors: %v", ruleErrors)
+		}
+		if len(newState.PState.Flags) != 1 || len(oldState.PState.Flags) != 1 || len(newState.ReportsToCreate) != 0 {
+			t.Errorf("state should be rolled back: %v, %v", newState.PState.Flags, newState.ReportsToCreate)
+		}
+		if RuleErrorsToError(ruleErrors) == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("without errors", func(t *testing.T) {
+		newState, ruleErrors := PerformRules(rules[:1], newOldState(), event, ActionConfigs{}, types.RULE_ERROR_POLICY_ALL_OR_NOTHING)
+		if len(ruleErrors) != 0 || newState.PState.Flags["first"] != "1" {
+			t.Errorf("unexpected result: %v, %v", newState.PState.Flags, ruleErrors)
+		}
+		if RuleErrorsToError(ruleErrors) != nil {
+			t.Error("should not return an error")
+		}
+	})
+
+	t.Run("report rule errors", func(t *testing.T) {
+		dbService := &DryRunDBService{}
+		configs := ActionConfigs{DBService: dbService}
+		ReportRuleErrors([]RuleError{{RuleIndex: 2, RuleName: "IF", Error: "failed"}}, "p1", event, configs, "", "v1")
+		if len(dbService.ResearcherMessages) != 1 {
+			t.Fatalf("unexpected messages: %v", dbService.ResearcherMessages)
+		}
+		msg := dbService.ResearcherMessages[0]
+		if msg.Type != RESEARCHER_MESSAGE_TYPE_RULE_ERROR || msg.ParticipantID != "p1" || msg.Payload["ruleIndex"] != "2" || msg.Payload["policy"] != types.RULE_ERROR_POLICY_SKIP_FAILING_RULE || msg.Payload["rulesVersionId"] != "v1" {
+			t.Errorf("unexpected message: %v", msg)
+		}
+
+		// same rule for other participants
+		ReportRuleErrors([]RuleError{{RuleIndex: 2, RuleName: "IF", Error: "failed"}}, "p2", event, configs, "", "v1")
+		ReportRuleErrors([]RuleError{{RuleIndex: 2, RuleName: "IF", Error: "failed"}}, "p3", event, configs, "", "v1")
+		if len(dbService.ResearcherMessages) != 1 || dbService.ResearcherMessages[0].Count != 3 || dbService.ResearcherMessages[0].ParticipantID != "p1" {
+			t.Errorf("should be deduplicated: %v", dbService.ResearcherMessages)
+		}
+		if dbService.ResearcherMessages[0].ToAPI().Payload["count"] != "3" {
+			t.Errorf("unexpected payload: %v", dbService.ResearcherMessages[0].ToAPI().Payload)
+		}
+
+		// other rule, other rules version and scheduled action
+		ReportRuleErrors([]RuleError{
+			{RuleIndex: 3, RuleName: "IF", Error: "failed"},
+			{RuleIndex: -1, RuleName: "reminder", Error: "failed", ScheduledActionID: "sa1"},
+			{RuleIndex: -1, RuleName: "reminder", Error: "failed", ScheduledActionID: "sa2"},
+		}, "p1", event, configs, "", "v1")
+		ReportRuleErrors([]RuleError{{RuleIndex: 2, RuleName: "IF", Error: "failed"}}, "p1", event, configs, "", "v2")
+		if len(dbService.ResearcherMessages) != 4 || dbService.ResearcherMessages[2].Count != 2 {
+			t.Errorf("unexpected messages: %v", dbService.ResearcherMessages)
+		}
+	})
+}
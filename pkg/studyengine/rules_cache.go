@@ -0,0 +1,95 @@
+package studyengine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// CachedStudyRules are the current rules of a study with their version, compiled with CompileRules.
+// They are shared between evaluations and must not be modified.
+type CachedStudyRules struct {
+	VersionID string // ID of the study rules version, empty for rules stored in the study (old model)
+	Rules     []types.Expression
+	checkedAt time.Time // last time the version was read from the DB
+}
+
+// StudyRulesDBService is implemented by DB services storing versions of the study rules
+type StudyRulesDBService interface {
+	GetCurrentStudyRulesVersionID(instanceID string, studyKey string) (string, error)
+	GetStudyRules(instanceID string, studyKey string) (rules []types.Expression, err error)
+}
+
+// DefaultRulesVersionCheckInterval is how long cached rules are used without reading the current version from the DB
+const DefaultRulesVersionCheckInterval = 10 * time.Second
+
+// RulesCache keeps the compiled current rules of each study in memory, as long as the rules version is unchanged
+type RulesCache struct {
+	sync.RWMutex
+	byStudy map[string]*CachedStudyRules
+	// rules saved by other instances of the service are used after at most this interval,
+	// the instance saving the rules invalidates its cache at once
+	VersionCheckInterval time.Duration
+}
+
+func NewRulesCache() *RulesCache {
+	return &RulesCache{
+		byStudy:              map[string]*CachedStudyRules{},
+		VersionCheckInterval: DefaultRulesVersionCheckInterval,
+	}
+}
+
+// StudyRulesCache is shared by the services performing study rules, so saving new rules invalidates it for all of them
+var StudyRulesCache = NewRulesCache()
+
+func rulesCacheKey(instanceID string, studyKey string) string {
+	return instanceID + "/" + studyKey
+}
+
+// GetStudyRules returns the compiled current rules of the study. Within the version check interval the cached rules are returned
+// without DB access. After it, the ID of the current version is read from the DB and the rules are loaded and compiled again
+// only if the version changed. Rules of the old model (stored in the study) have no version and are loaded again after the interval.
+func (c *RulesCache) GetStudyRules(dbService StudyRulesDBService, instanceID string, studyKey string) (*CachedStudyRules, error) {
+	key := rulesCacheKey(instanceID, studyKey)
+	now := Now()
+
+	c.RLock()
+	cached, ok := c.byStudy[key]
+	c.RUnlock()
+	if ok && now.Sub(cached.checkedAt) < c.VersionCheckInterval {
+		return cached, nil
+	}
+
+	versionID, err := dbService.GetCurrentStudyRulesVersionID(instanceID, studyKey)
+	if err != nil {
+		versionID = ""
+	}
+	if ok && versionID != "" && cached.VersionID == versionID {
+		c.Lock()
+		defer c.Unlock()
+		// a new entry for the same version, the current one may be in use by other evaluations
+		checked := &CachedStudyRules{VersionID: cached.VersionID, Rules: cached.Rules, checkedAt: now}
+		c.byStudy[key] = checked
+		return checked, nil
+	}
+
+	rules, err := dbService.GetStudyRules(instanceID, studyKey)
+	if err != nil {
+		return nil, err
+	}
+	CompileRules(rules)
+	cached = &CachedStudyRules{VersionID: versionID, Rules: rules, checkedAt: now}
+
+	c.Lock()
+	defer c.Unlock()
+	c.byStudy[key] = cached
+	return cached, nil
+}
+
+// Invalidate removes the rules of the study, e.g. after new rules are saved
+func (c *RulesCache) Invalidate(instanceID string, studyKey string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.byStudy, rulesCacheKey(instanceID, studyKey))
+}
@@ -0,0 +1,182 @@
+package studyengine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// mockStudyRulesDBService returns the rules of the current version and counts the loaded rules
+type mockStudyRulesDBService struct {
+	versionID     string
+	rules         []types.Expression
+	loaded        int
+	versionChecks int
+}
+
+func (db *mockStudyRulesDBService) GetCurrentStudyRulesVersionID(instanceID string, studyKey string) (string, error) {
+	db.versionChecks += 1
+	if db.versionID == "" {
+		return "", errors.New("no documents in result")
+	}
+	return db.versionID, nil
+}
+
+func (db *mockStudyRulesDBService) GetStudyRules(instanceID string, studyKey string) (rules []types.Expression, err error) {
+	db.loaded += 1
+	return db.rules, nil
+}
+
+func TestRulesCache(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	setFlag := func(flag string, value types.ExpressionArg) types.Expression {
+		return types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{str(flag), value}}
+	}
+	t.Run("cache per rules version", func(t *testing.T) {
+		cache := NewRulesCache()
+		// the version is read on every call
+		cache.VersionCheckInterval = 0
+		db := &mockStudyRulesDBService{versionID: "v1", rules: []types.Expression{setFlag("a", str("1"))}}
+
+		first, err := cache.GetStudyRules(db, "instance", "study")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second, err := cache.GetStudyRules(db, "instance", "study")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.loaded != 1 || first.VersionID != "v1" || &first.Rules[0] != &second.Rules[0] {
+			t.Errorf("should be cached: %d loads", db.loaded)
+		}
+
+		if _, err := cache.GetStudyRules(db, "instance", "otherStudy"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.loaded != 2 {
+			t.Errorf("studies should be cached separately: %d loads", db.loaded)
+		}
+
+		// new version saved, e.g. by another replica
+		db.versionID = "v2"
+		db.rules = []types.Expression{setFlag("b", str("1"))}
+		current, err := cache.GetStudyRules(db, "instance", "study")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.loaded != 3 || current.VersionID != "v2" || current.Rules[0].Data[0].Str != "b" {
+			t.Errorf("should load the new version: %d loads, %v", db.loaded, current)
+		}
+
+		cache.Invalidate("instance", "study")
+		if _, err := cache.GetStudyRules(db, "instance", "study"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.loaded != 4 {
+			t.Errorf("should load after invalidation: %d loads", db.loaded)
+		}
+	})
+
+	t.Run("version read after the check interval", func(t *testing.T) {
+		now := time.Now()
+		Now = func() time.Time { return now }
+		defer func() { Now = time.Now }()
+
+		cache := NewRulesCache()
+		db := &mockStudyRulesDBService{versionID: "v1", rules: []types.Expression{setFlag("a", str("1"))}}
+		if _, err := cache.GetStudyRules(db, "instance", "study"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		db.versionID = "v2"
+		db.rules = []types.Expression{setFlag("b", str("1"))}
+		rules, err := cache.GetStudyRules(db, "instance", "study")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.versionChecks != 1 || rules.VersionID != "v1" {
+			t.Errorf("should use the cached rules within the interval: %d version checks, %s", db.versionChecks, rules.VersionID)
+		}
+
+		now = now.Add(DefaultRulesVersionCheckInterval)
+		rules, err = cache.GetStudyRules(db, "instance", "study")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.versionChecks != 2 || db.loaded != 2 || rules.VersionID != "v2" {
+			t.Errorf("should load the new version after the interval: %d version checks, %d loads, %s", db.versionChecks, db.loaded, rules.VersionID)
+		}
+
+		// unchanged version after the interval
+		now = now.Add(DefaultRulesVersionCheckInterval)
+		if _, err := cache.GetStudyRules(db, "instance", "study"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.versionChecks != 3 || db.loaded != 2 {
+			t.Errorf("should keep the rules of the version: %d version checks, %d loads", db.versionChecks, db.loaded)
+		}
+	})
+
+	t.Run("rules without version loaded after the check interval", func(t *testing.T) {
+		now := time.Now()
+		Now = func() time.Time { return now }
+		defer func() { Now = time.Now }()
+
+		cache := NewRulesCache()
+		db := &mockStudyRulesDBService{rules: []types.Expression{setFlag("a", str("1"))}}
+		for i := 0; i < 2; i++ {
+			rules, err := cache.GetStudyRules(db, "instance", "study")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rules.VersionID != "" || len(rules.Rules) != 1 {
+				t.Errorf("unexpected rules: %v", rules)
+			}
+		}
+		if db.loaded != 1 {
+			t.Errorf("should be cached within the interval: %d loads", db.loaded)
+		}
+		now = now.Add(DefaultRulesVersionCheckInterval)
+		if _, err := cache.GetStudyRules(db, "instance", "study"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if db.loaded != 2 {
+			t.Errorf("should load the rules after the interval: %d loads", db.loaded)
+		}
+	})
+
+	t.Run("cached rules are compiled", func(t *testing.T) {
+		cache := NewRulesCache()
+		db := &mockStudyRulesDBService{versionID: "v1", rules: []types.Expression{
+			{Name: "IF", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "eq", Data: []types.ExpressionArg{{DType: "num", Num: 2}, {DType: "num", Num: 2}}}},
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{str("status"), str("active")}}},
+			}},
+			{Name: "UNKNOWN_ACTION"},
+		}}
+		rules, err := cache.GetStudyRules(db, "instance", "study")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		condition := rules.Rules[0].Data[0].Exp
+		action := rules.Rules[0].Data[1].Exp
+		if rules.Rules[0].Compiled == nil || condition.Compiled == nil || action.Compiled == nil || rules.Rules[1].Compiled != nil {
+			t.Errorf("handlers should be resolved for known names: %v", rules.Rules)
+		}
+		if condition.Data[0].Value != 2.0 || action.Data[1].Value != "active" {
+			t.Errorf("literals should be resolved: %v, %v", condition.Data[0].Value, action.Data[1].Value)
+		}
+
+		newState, ruleErrors := PerformRules(rules.Rules, ActionData{}, types.StudyEvent{Type: "TIMER"}, ActionConfigs{}, types.RULE_ERROR_POLICY_SKIP_FAILING_RULE)
+		if newState.PState.Flags["status"] != "active" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+		if len(ruleErrors) != 1 || ruleErrors[0].RuleIndex != 1 {
+			t.Errorf("unknown action should fail: %v", ruleErrors)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package studyengine
+
+import (
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// compiledExpression is the handler of an action or expression, resolved from the registry when the rules are compiled.
+// It is stored by pointer, so copies of compiled states (e.g. with scheduled actions) stay equal for reflect.DeepEqual.
+type compiledExpression struct {
+	action     ActionHandler
+	expression ExpressionHandler
+}
+
+// CompileRules resolves the handlers of all actions and expressions of the rules and the values of their literal arguments,
+// so they are not looked up again for every evaluation. The rules are modified in place and must not be modified afterwards.
+// Unknown names are left unresolved and fail when they are evaluated, as for rules that are not compiled.
+func CompileRules(rules []types.Expression) {
+	for i := range rules {
+		compileExpression(&rules[i])
+	}
+}
+
+func compileExpression(exp *types.Expression) {
+	if def, ok := LookupAction(exp.Name); ok {
+		exp.Compiled = &compiledExpression{action: def.Handler}
+	} else if def, ok := LookupExpression(exp.Name); ok {
+		exp.Compiled = &compiledExpression{expression: def.Handler}
+	}
+	for i := range exp.Data {
+		arg := &exp.Data[i]
+		switch arg.DType {
+		case "exp":
+			if arg.Exp != nil {
+				compileExpression(arg.Exp)
+			}
+		case "num":
+			arg.Value = arg.Num
+		default:
+			arg.Value = arg.Str
+		}
+	}
+}
+
+// compiledActionHandler returns the handler resolved when the rules were compiled, or looks it up in the registry
+func compiledActionHandler(action types.Expression) (ActionHandler, bool) {
+	if compiled, ok := action.Compiled.(*compiledExpression); ok && compiled.action != nil {
+		return compiled.action, true
+	}
+	def, ok := LookupAction(action.Name)
+	return def.Handler, ok
+}
+
+// compiledExpressionHandler returns the handler resolved when the rules were compiled, or looks it up in the registry
+func compiledExpressionHandler(expression types.Expression) (ExpressionHandler, bool) {
+	if compiled, ok := expression.Compiled.(*compiledExpression); ok && compiled.expression != nil {
+		return compiled.expression, true
+	}
+	def, ok := LookupExpression(expression.Name)
+	return def.Handler, ok
+}
@@ -0,0 +1,170 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/influenzanet/study-service/pkg/types"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// scheduleActions stores the actions (without evaluating them) to be performed later by the timer.
+// The variables bound at this point (LET, FOREACH) are stored with them.
+func scheduleActions(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	newState = oldState
+	if len(action.Data) < 3 {
+		return newState, errors.New("scheduleActions must have at least three arguments")
+	}
+	EvalContext := EvalContext{
+		Event:            event,
+		ParticipantState: newState.PState,
+		Configs:          configs,
+	}
+	arg1, err := EvalContext.expressionArgResolver(action.Data[0])
+	if err != nil {
+		return newState, err
+	}
+	arg2, err := EvalContext.expressionArgResolver(action.Data[1])
+	if err != nil {
+		return newState, err
+	}
+
+	executeAt, ok1 := arg1.(float64)
+	label, ok2 := arg2.(string)
+	if !ok1 || !ok2 {
+		return newState, errors.New("could not parse arguments")
+	}
+
+	actions := []types.Expression{}
+	for i, arg := range action.Data[2:] {
+		if !arg.IsExpression() || arg.Exp == nil {
+			return newState, fmt.Errorf("argument %d should be an action", i+3)
+		}
+		actions = append(actions, *arg.Exp)
+	}
+
+	newScheduledAction := types.ScheduledAction{
+		ID:        primitive.NewObjectID().Hex(),
+		Label:     label,
+		ExecuteAt: int64(executeAt),
+		Actions:   actions,
+		Variables: configs.Variables,
+	}
+	newState.PState.ScheduledActions = make([]types.ScheduledAction, len(oldState.PState.ScheduledActions))
+	copy(newState.PState.ScheduledActions, oldState.PState.ScheduledActions)
+
+	newState.PState.ScheduledActions = append(newState.PState.ScheduledActions, newScheduledAction)
+	return
+}
+
+// cancelScheduledActions removes all pending scheduled actions with the label
+func cancelScheduledActions(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	newState = oldState
+	if len(action.Data) != 1 {
+		return newState, errors.New("cancelScheduledActions must have exactly one argument")
+	}
+	EvalContext := EvalContext{
+		Event:            event,
+		ParticipantState: newState.PState,
+		Configs:          configs,
+	}
+	arg1, err := EvalContext.expressionArgResolver(action.Data[0])
+	if err != nil {
+		return newState, err
+	}
+	label, ok := arg1.(string)
+	if !ok {
+		return newState, errors.New("could not parse argument")
+	}
+
+	scheduledActions := []types.ScheduledAction{}
+	for _, sa := range oldState.PState.ScheduledActions {
+		if sa.Label != label {
+			scheduledActions = append(scheduledActions, sa)
+		}
+	}
+	newState.PState.ScheduledActions = scheduledActions
+	return
+}
+
+// RunDueScheduledActions performs all scheduled actions of the participant with executeAt <= now, in the order of their execution time.
+// Every scheduled entry is handled like a rule: if one of its actions fails, its partial changes are dropped and a RuleError
+// (with ScheduledActionID set) is returned. With RULE_ERROR_POLICY_ALL_OR_NOTHING no changes are kept after a failure.
+// Due entries are removed from the state, also if they fail, so failing actions are not retried on every timer event.
+// Only entries not performed because a limit of the evaluation was exceeded are kept for the next run.
+// Actions scheduled while performing due actions are kept for a later run, even if they are already due.
+func RunDueScheduledActions(oldState ActionData, event types.StudyEvent, configs ActionConfigs, now int64, policy string) (newState ActionData, ruleErrors []RuleError) {
+	ruleErrors = []RuleError{}
+	newState = oldState
+
+	due := []types.ScheduledAction{}
+	pending := []types.ScheduledAction{}
+	for _, sa := range oldState.PState.ScheduledActions {
+		if sa.ExecuteAt <= now {
+			due = append(due, sa)
+		} else {
+			pending = append(pending, sa)
+		}
+	}
+	if len(due) == 0 {
+		return
+	}
+	newState = copyActionData(oldState)
+	newState.PState.ScheduledActions = pending
+	// state without the due entries, kept if the policy drops all changes
+	unchangedState := copyActionData(newState)
+	// randomization slots of dropped changes are released
+	configs, eventDB := WithEventDBCache(configs)
+	reservedBefore := len(eventDB.ReservedRandomizationSlots())
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].ExecuteAt < due[j].ExecuteAt
+	})
+	for i, sa := range due {
+		saConfigs := configs
+		saConfigs.Variables = sa.Variables
+		reservedBeforeEntry := len(eventDB.ReservedRandomizationSlots())
+		state, err := performScheduledAction(sa, copyActionData(newState), event, saConfigs)
+		if err == nil {
+			err = configs.Budget.Err()
+		}
+		if err != nil {
+			ruleErr := RuleError{
+				RuleIndex:         -1,
+				RuleName:          sa.Label,
+				Error:             err.Error(),
+				ScheduledActionID: sa.ID,
+			}
+			limitErr := AsEvalLimitError(err)
+			if limitErr != nil {
+				ruleErr.EvalLimit = limitErr.Limit
+			}
+			ruleErrors = append(ruleErrors, ruleErr)
+			if policy == types.RULE_ERROR_POLICY_ALL_OR_NOTHING {
+				releaseReservedRandomizationSlots(eventDB, event, reservedBefore)
+				return unchangedState, ruleErrors
+			}
+			releaseReservedRandomizationSlots(eventDB, event, reservedBeforeEntry)
+			if configs.Budget.Err() != nil {
+				// the remaining entries would fail with the same error, they are kept for the next run
+				newState.PState.ScheduledActions = append(newState.PState.ScheduledActions, due[i+1:]...)
+				break
+			}
+			continue
+		}
+		newState = state
+	}
+	return
+}
+
+func performScheduledAction(sa types.ScheduledAction, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	newState = oldState
+	for _, action := range sa.Actions {
+		newState, err = ActionEval(action, newState, event, configs)
+		if err != nil {
+			return newState, err
+		}
+	}
+	return
+}
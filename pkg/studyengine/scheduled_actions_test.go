@@ -0,0 +1,199 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestScheduledActions(t *testing.T) {
+	event := types.StudyEvent{Type: "TIMER"}
+	configs := ActionConfigs{}
+
+	scheduleRule := func(executeAt float64, label string, actions ...types.Expression) types.Expression {
+		data := []types.ExpressionArg{
+			{DType: "num", Num: executeAt},
+			{DType: "str", Str: label},
+		}
+		for i := range actions {
+			data = append(data, types.ExpressionArg{DType: "exp", Exp: &actions[i]})
+		}
+		return types.Expression{Name: "SCHEDULE_ACTIONS", Data: data}
+	}
+	updateFlag := func(key string, value string) types.Expression {
+		return types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+			{DType: "str", Str: key},
+			{DType: "str", Str: value},
+		}}
+	}
+
+	t.Run("schedule actions", func(t *testing.T) {
+		oldState := ActionData{PState: types.ParticipantState{ParticipantID: "p1"}}
+		newState, err := ActionEval(scheduleRule(100, "followup",
+			types.Expression{Name: "ADD_NEW_SURVEY", Data: []types.ExpressionArg{
+				{DType: "str", Str: "followup"},
+				{DType: "num", Num: 0},
+				{DType: "num", Num: 0},
+				{DType: "str", Str: "normal"},
+			}},
+			updateFlag("followupAssigned", "true"),
+		), oldState, event, configs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(oldState.PState.ScheduledActions) != 0 {
+			t.Error("old state should not be modified")
+		}
+		if len(newState.PState.ScheduledActions) != 1 {
+			t.Fatalf("unexpected scheduled actions: %v", newState.PState.ScheduledActions)
+		}
+		sa := newState.PState.ScheduledActions[0]
+		if sa.ID == "" || sa.Label != "followup" || sa.ExecuteAt != 100 || len(sa.Actions) != 2 || sa.Actions[0].Name != "ADD_NEW_SURVEY" {
+			t.Errorf("unexpected scheduled action: %v", sa)
+		}
+		if len(newState.PState.AssignedSurveys) != 0 {
+			t.Error("actions should not be performed yet")
+		}
+	})
+
+	t.Run("with wrong arguments", func(t *testing.T) {
+		_, err := ActionEval(types.Expression{Name: "SCHEDULE_ACTIONS", Data: []types.ExpressionArg{
+			{DType: "num", Num: 100},
+			{DType: "str", Str: "label"},
+			{DType: "str", Str: "not an action"},
+		}}, ActionData{}, event, configs)
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("cancel by label", func(t *testing.T) {
+		state := ActionData{}
+		var err error
+		for _, rule := range []types.Expression{
+			scheduleRule(100, "a", updateFlag("a", "1")),
+			scheduleRule(200, "b", updateFlag("b", "1")),
+			scheduleRule(300, "a", updateFlag("a", "2")),
+		} {
+			state, err = ActionEval(rule, state, event, configs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		newState, err := ActionEval(types.Expression{Name: "CANCEL_SCHEDULED_ACTIONS", Data: []types.ExpressionArg{
+			{DType: "str", Str: "a"},
+		}}, state, event, configs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(newState.PState.ScheduledActions) != 1 || newState.PState.ScheduledActions[0].Label != "b" {
+			t.Errorf("unexpected scheduled actions: %v", newState.PState.ScheduledActions)
+		}
+		if len(state.PState.ScheduledActions) != 3 {
+			t.Error("old state should not be modified")
+		}
+	})
+
+	t.Run("run due actions", func(t *testing.T) {
+		state := ActionData{PState: types.ParticipantState{
+			Flags: map[string]string{},
+			ScheduledActions: []types.ScheduledAction{
+				{ID: "1", ExecuteAt: 200, Actions: []types.Expression{updateFlag("order", "second")}},
+				{ID: "2", ExecuteAt: 100, Actions: []types.Expression{
+					updateFlag("order", "first"),
+					{Name: "UNKNOWN_ACTION"},
+					updateFlag("afterError", "performed"),
+				}},
+				{ID: "3", ExecuteAt: 1000, Actions: []types.Expression{updateFlag("later", "true")}},
+				{ID: "4", ExecuteAt: 150, Actions: []types.Expression{
+					scheduleRule(50, "", updateFlag("rescheduled", "true")),
+				}},
+			},
+		}}
+
+		newState, ruleErrors := RunDueScheduledActions(state, event, configs, 500, "")
+		if len(ruleErrors) != 1 || ruleErrors[0].ScheduledActionID != "2" || ruleErrors[0].RuleIndex != -1 {
+			t.Errorf("should report the failed action: %v", ruleErrors)
+		}
+		if newState.PState.Flags["order"] != "second" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+		if _, ok := newState.PState.Flags["afterError"]; ok {
+			t.Error("changes of the failing scheduled action should be dropped")
+		}
+		if len(state.PState.ScheduledActions) != 4 || len(state.PState.Flags) != 0 {
+			t.Error("old state should not be modified")
+		}
+		if _, ok := newState.PState.Flags["later"]; ok {
+			t.Error("pending action should not be performed")
+		}
+		if _, ok := newState.PState.Flags["rescheduled"]; ok {
+			t.Error("newly scheduled action should not be performed in the same run")
+		}
+		if len(newState.PState.ScheduledActions) != 2 || newState.PState.ScheduledActions[0].ID != "3" || newState.PState.ScheduledActions[1].ExecuteAt != 50 {
+			t.Errorf("unexpected scheduled actions: %v", newState.PState.ScheduledActions)
+		}
+	})
+
+	t.Run("without due actions", func(t *testing.T) {
+		state := ActionData{PState: types.ParticipantState{
+			ScheduledActions: []types.ScheduledAction{{ID: "1", ExecuteAt: 1000}},
+		}}
+		newState, ruleErrors := RunDueScheduledActions(state, event, configs, 500, "")
+		if len(ruleErrors) > 0 {
+			t.Errorf("unexpected errors: %v", ruleErrors)
+		}
+		if len(newState.PState.ScheduledActions) != 1 {
+			t.Errorf("unexpected scheduled actions: %v", newState.PState.ScheduledActions)
+		}
+	})
+
+	t.Run("all or nothing", func(t *testing.T) {
+		state := ActionData{PState: types.ParticipantState{
+			Flags: map[string]string{},
+			ScheduledActions: []types.ScheduledAction{
+				{ID: "1", ExecuteAt: 100, Actions: []types.Expression{updateFlag("a", "1")}},
+				{ID: "2", ExecuteAt: 200, Actions: []types.Expression{{Name: "UNKNOWN_ACTION"}}},
+				{ID: "3", ExecuteAt: 1000, Actions: []types.Expression{updateFlag("later", "true")}},
+			},
+		}}
+		newState, ruleErrors := RunDueScheduledActions(state, event, configs, 500, types.RULE_ERROR_POLICY_ALL_OR_NOTHING)
+		if len(ruleErrors) != 1 {
+			t.Errorf("unexpected errors: %v", ruleErrors)
+		}
+		if len(newState.PState.Flags) != 0 {
+			t.Errorf("changes should be dropped: %v", newState.PState.Flags)
+		}
+		if len(newState.PState.ScheduledActions) != 1 || newState.PState.ScheduledActions[0].ID != "3" {
+			t.Errorf("due actions should be removed: %v", newState.PState.ScheduledActions)
+		}
+	})
+
+	t.Run("variables are kept for the scheduled actions", func(t *testing.T) {
+		getVar := types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getVar", Data: []types.ExpressionArg{{DType: "str", Str: "item"}}}}
+		forEach := types.Expression{Name: "FOREACH", Data: []types.ExpressionArg{
+			{DType: "str", Str: "item"},
+			{DType: "str", Str: "a;b"},
+			{DType: "exp", Exp: &types.Expression{Name: "SCHEDULE_ACTIONS", Data: []types.ExpressionArg{
+				{DType: "num", Num: 100},
+				{DType: "str", Str: ""},
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{getVar, getVar}}},
+			}}},
+		}}
+		state, err := ActionEval(forEach, ActionData{PState: types.ParticipantState{Flags: map[string]string{}}}, event, configs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(state.PState.ScheduledActions) != 2 || state.PState.ScheduledActions[1].Variables["item"] != "b" {
+			t.Fatalf("unexpected scheduled actions: %v", state.PState.ScheduledActions)
+		}
+
+		newState, ruleErrors := RunDueScheduledActions(state, event, configs, 500, "")
+		if len(ruleErrors) > 0 {
+			t.Errorf("unexpected errors: %v", ruleErrors)
+		}
+		if newState.PState.Flags["a"] != "a" || newState.PState.Flags["b"] != "b" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	// patterns of regexMatch are compiled once, up to this number of distinct patterns
+	maxCachedRegexps = 256
+)
+
+var regexpCache = struct {
+	sync.Mutex
+	byPattern map[string]*regexp.Regexp
+}{byPattern: map[string]*regexp.Regexp{}}
+
+func compileCachedRegexp(pattern string) (*regexp.Regexp, error) {
+	regexpCache.Lock()
+	defer regexpCache.Unlock()
+	if re, ok := regexpCache.byPattern[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(regexpCache.byPattern) >= maxCachedRegexps {
+		regexpCache.byPattern = map[string]*regexp.Regexp{}
+	}
+	regexpCache.byPattern[pattern] = re
+	return re, nil
+}
+
+// valueAsStr converts strings, numbers and booleans to their string representation
+func valueAsStr(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case string:
+		return value, nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	default:
+		return "", fmt.Errorf("cannot convert value of type %T to string", v)
+	}
+}
+
+// concat joins the arguments (strings, numbers or booleans) into one string
+func (ctx EvalContext) concat(exp types.Expression) (val string, err error) {
+	var sb strings.Builder
+	for idx, dataExp := range exp.Data {
+		arg, err := ctx.expressionArgResolver(dataExp)
+		if err != nil {
+			return val, err
+		}
+		s, err := valueAsStr(arg)
+		if err != nil {
+			return val, fmt.Errorf("argument %d: %v", idx+1, err)
+		}
+		sb.WriteString(s)
+	}
+	return sb.String(), nil
+}
+
+// substring returns the characters from start (0 based) with the optional length, indexes beyond the end of the string are clamped
+func (ctx EvalContext) substring(exp types.Expression) (val string, err error) {
+	if len(exp.Data) != 2 && len(exp.Data) != 3 {
+		return val, errors.New("should have two or three arguments")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	start, err := ctx.mustGetNumValue(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	if math.IsNaN(start) || math.IsInf(start, 0) {
+		return val, errors.New("start should be a finite number")
+	}
+	if start < 0 {
+		return val, errors.New("start should not be negative")
+	}
+
+	// compared as floats, so large values are clamped before the conversion to int
+	runes := []rune(str)
+	from := len(runes)
+	if start < float64(from) {
+		from = int(start)
+	}
+	to := len(runes)
+	if len(exp.Data) == 3 {
+		length, err := ctx.mustGetNumValue(exp.Data[2])
+		if err != nil {
+			return val, err
+		}
+		if math.IsNaN(length) || math.IsInf(length, 0) {
+			return val, errors.New("length should be a finite number")
+		}
+		if length < 0 {
+			return val, errors.New("length should not be negative")
+		}
+		if length < float64(to-from) {
+			to = from + int(length)
+		}
+	}
+	return string(runes[from:to]), nil
+}
+
+func (ctx EvalContext) toLower(exp types.Expression) (val string, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("should have one argument")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	return strings.ToLower(str), nil
+}
+
+func (ctx EvalContext) toUpper(exp types.Expression) (val string, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("should have one argument")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	return strings.ToUpper(str), nil
+}
+
+// trim removes leading and trailing white space
+func (ctx EvalContext) trim(exp types.Expression) (val string, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("should have one argument")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	return strings.TrimSpace(str), nil
+}
+
+// strLength returns the number of characters (not bytes) of the string
+func (ctx EvalContext) strLength(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("should have one argument")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	return float64(utf8.RuneCountInString(str)), nil
+}
+
+// regexMatch checks if the string contains a match of the pattern (RE2 syntax, use ^ and $ to match the whole string)
+func (ctx EvalContext) regexMatch(exp types.Expression) (val bool, err error) {
+	if len(exp.Data) != 2 {
+		return val, errors.New("should have two arguments")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	pattern, err := ctx.mustGetStrValue(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	re, err := compileCachedRegexp(pattern)
+	if err != nil {
+		return val, err
+	}
+	return re.MatchString(str), nil
+}
+
+// splitAndGet splits the string by the separator and returns the part at the index (0 based, negative counts from the end).
+// An empty string is returned if the index is out of range.
+func (ctx EvalContext) splitAndGet(exp types.Expression) (val string, err error) {
+	if len(exp.Data) != 3 {
+		return val, errors.New("should have three arguments")
+	}
+	str, err := ctx.mustGetStrValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	separator, err := ctx.mustGetStrValue(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	if separator == "" {
+		return val, errors.New("separator should not be empty")
+	}
+	index, err := ctx.mustGetNumValue(exp.Data[2])
+	if err != nil {
+		return val, err
+	}
+
+	parts := strings.Split(str, separator)
+	i := int(index)
+	if i < 0 {
+		i = len(parts) + i
+	}
+	if i < 0 || i >= len(parts) {
+		return "", nil
+	}
+	return parts[i], nil
+}
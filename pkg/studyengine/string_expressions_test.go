@@ -0,0 +1,114 @@
+package studyengine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestStringExpressions(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: name, Data: args}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected interface{}
+	}{
+		{name: "concat", exp: exp("concat", str("region_"), num(12), str("_"), num(1.5)), expected: "region_12_1.5"},
+		{name: "substring", exp: exp("substring", str("75011"), num(0), num(2)), expected: "75"},
+		{name: "substring without length", exp: exp("substring", str("75011"), num(2)), expected: "011"},
+		{name: "substring beyond end", exp: exp("substring", str("ab"), num(1), num(10)), expected: "b"},
+		{name: "substring start beyond end", exp: exp("substring", str("ab"), num(5)), expected: ""},
+		{name: "substring multi-byte", exp: exp("substring", str("Zürich"), num(1), num(2)), expected: "ür"},
+		{name: "substring huge start", exp: exp("substring", str("abc"), num(1e300)), expected: ""},
+		{name: "substring huge length", exp: exp("substring", str("abc"), num(1), num(1e300)), expected: "bc"},
+		{name: "toLower", exp: exp("toLower", str("AbC")), expected: "abc"},
+		{name: "toUpper", exp: exp("toUpper", str("AbC")), expected: "ABC"},
+		{name: "trim", exp: exp("trim", str(" \t 1234 AB\n")), expected: "1234 AB"},
+		{name: "strLength", exp: exp("strLength", str("Zürich")), expected: 6.0},
+		{name: "regexMatch", exp: exp("regexMatch", str("1234 AB"), str(`^[0-9]{4} ?[A-Z]{2}$`)), expected: true},
+		{name: "regexMatch no match", exp: exp("regexMatch", str("1234"), str(`^[0-9]{5}$`)), expected: false},
+		{name: "splitAndGet", exp: exp("splitAndGet", str("a;b;c"), str(";"), num(1)), expected: "b"},
+		{name: "splitAndGet from end", exp: exp("splitAndGet", str("a;b;c"), str(";"), num(-1)), expected: "c"},
+		{name: "splitAndGet out of range", exp: exp("splitAndGet", str("a;b;c"), str(";"), num(3)), expected: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if errs := ValidateExpression(tc.exp, "exp"); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+			val, err := ExpressionEval(tc.exp, EvalContext{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val != tc.expected {
+				t.Errorf("unexpected value: %v, expected %v", val, tc.expected)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		exp  types.Expression
+	}{
+		{name: "concat with failing argument", exp: exp("concat", str("a"), types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getVar", Data: []types.ExpressionArg{str("missing")}}})},
+		{name: "substring negative start", exp: exp("substring", str("abc"), num(-1))},
+		{name: "substring NaN start", exp: exp("substring", str("abc"), num(math.NaN()))},
+		{name: "substring infinite start", exp: exp("substring", str("abc"), num(math.Inf(1)))},
+		{name: "substring NaN length", exp: exp("substring", str("abc"), num(0), num(math.NaN()))},
+		{name: "substring infinite length", exp: exp("substring", str("abc"), num(0), num(math.Inf(1)))},
+		{name: "substring of number", exp: exp("substring", num(75011), num(0))},
+		{name: "regexMatch invalid pattern", exp: exp("regexMatch", str("abc"), str("("))},
+		{name: "splitAndGet empty separator", exp: exp("splitAndGet", str("abc"), str(""), num(0))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ExpressionEval(tc.exp, EvalContext{}); err == nil {
+				t.Error("should return an error")
+			}
+		})
+	}
+
+	t.Run("region flag from postal code", func(t *testing.T) {
+		action := types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+			str("region"),
+			{DType: "exp", Exp: &types.Expression{Name: "substring", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "trim", Data: []types.ExpressionArg{
+					{DType: "exp", Exp: &types.Expression{Name: "getResponseValueAsStr", Data: []types.ExpressionArg{
+						str("intake.Q3"), str("rg.postal"),
+					}}},
+				}}},
+				num(0),
+				num(2),
+			}}},
+		}}
+		if errs := ValidateStudyRules([]types.Expression{action}); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+		event := types.StudyEvent{
+			Type: "SUBMIT",
+			Response: types.SurveyResponse{
+				Key: "intake",
+				Responses: []types.SurveyItemResponse{
+					{Key: "intake.Q3", Response: &types.ResponseItem{
+						Key: "rg", Items: []*types.ResponseItem{{Key: "postal", Value: " 75011 "}},
+					}},
+				},
+			},
+		}
+		newState, err := ActionEval(action, ActionData{}, event, ActionConfigs{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["region"] != "75" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+	})
+}
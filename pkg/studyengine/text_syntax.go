@@ -0,0 +1,396 @@
+package studyengine
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// Text syntax of study rules, as an alternative to the JSON form of types.Expression:
+//
+//	// comment until the end of the line
+//	IFTHEN(checkEventType("SUBMIT"), UPDATE_FLAG("x", "1"))
+//	IF(gt(getResponseValueAsNum("weekly", "rg.scg"), 38.5), ADD_MESSAGE(...))
+//
+// Arguments are expressions, double quoted strings (Go escapes, e.g. "\"" or "\n") and numbers.
+// A return type is written after the arguments: externalEventEval("service", "route"):float.
+// Literal arguments without dtype are evaluated as strings, so they are written as their quoted string value.
+// Expressions nested deeper than the MaxDepth of DefaultEvalLimits are rejected, like by the evaluation.
+
+// TextSyntaxError is a syntax error at a position of the parsed text
+type TextSyntaxError struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *TextSyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+// ParseRulesText parses the rules in text syntax, separated by whitespace
+func ParseRulesText(text string) ([]types.Expression, error) {
+	p := newTextParser(text)
+	rules := []types.Expression{}
+	for {
+		p.skipSpace()
+		if p.atEnd() {
+			return rules, nil
+		}
+		rule, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+}
+
+// ParseExpressionText parses a single expression in text syntax
+func ParseExpressionText(text string) (types.Expression, error) {
+	p := newTextParser(text)
+	p.skipSpace()
+	exp, err := p.parseExpression()
+	if err != nil {
+		return exp, err
+	}
+	p.skipSpace()
+	if !p.atEnd() {
+		return exp, p.errorf("unexpected '%c' after the expression", p.peek())
+	}
+	return exp, nil
+}
+
+type textParser struct {
+	text     string
+	pos      int
+	depth    int
+	maxDepth int // zero disables the limit
+}
+
+func newTextParser(text string) textParser {
+	return textParser{text: text, maxDepth: DefaultEvalLimits().MaxDepth}
+}
+
+func (p *textParser) atEnd() bool {
+	return p.pos >= len(p.text)
+}
+
+func (p *textParser) peek() byte {
+	if p.atEnd() {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *textParser) errorf(format string, args ...interface{}) error {
+	return p.errorAt(p.pos, format, args...)
+}
+
+func (p *textParser) errorAt(pos int, format string, args ...interface{}) error {
+	before := p.text[:pos]
+	line := strings.Count(before, "\n") + 1
+	column := pos - strings.LastIndex(before, "\n")
+	return &TextSyntaxError{Line: line, Column: column, Msg: fmt.Sprintf(format, args...)}
+}
+
+// skipSpace skips whitespace and comments
+func (p *textParser) skipSpace() {
+	for !p.atEnd() {
+		switch c := p.peek(); {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			p.pos += 1
+		case strings.HasPrefix(p.text[p.pos:], "//"):
+			end := strings.IndexByte(p.text[p.pos:], '\n')
+			if end < 0 {
+				p.pos = len(p.text)
+			} else {
+				p.pos += end
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9') || c == '.' || c == '-' || c == ':'
+}
+
+func isNumberChar(c byte) bool {
+	return (c >= '0' && c <= '9') || c == '.' || c == '-' || c == '+' || c == 'e' || c == 'E'
+}
+
+func (p *textParser) parseName() (string, error) {
+	start := p.pos
+	if !isNameStart(p.peek()) {
+		if p.atEnd() {
+			return "", p.errorf("name expected, but the text ended")
+		}
+		return "", p.errorf("name expected, but found '%c'", p.peek())
+	}
+	for !p.atEnd() && isNameChar(p.peek()) {
+		p.pos += 1
+	}
+	return p.text[start:p.pos], nil
+}
+
+// parseExpression parses name(args...)[:returnType]
+func (p *textParser) parseExpression() (types.Expression, error) {
+	exp := types.Expression{}
+	p.depth += 1
+	defer func() { p.depth -= 1 }()
+	if p.maxDepth > 0 && p.depth > p.maxDepth {
+		return exp, p.errorf("expressions nested deeper than %d levels", p.maxDepth)
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return exp, err
+	}
+	exp.Name = name
+
+	p.skipSpace()
+	if p.peek() != '(' {
+		return exp, p.errorf("'(' expected after '%s'", name)
+	}
+	p.pos += 1
+	for {
+		p.skipSpace()
+		if p.atEnd() {
+			return exp, p.errorf("')' expected to close '%s'", name)
+		}
+		if p.peek() == ')' {
+			p.pos += 1
+			return exp, p.parseReturnType(&exp)
+		}
+		arg, err := p.parseArg()
+		if err != nil {
+			return exp, err
+		}
+		exp.Data = append(exp.Data, arg)
+
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos += 1
+		case ')':
+		default:
+			if p.atEnd() {
+				return exp, p.errorf("')' expected to close '%s'", name)
+			}
+			return exp, p.errorf("',' or ')' expected in arguments of '%s', but found '%c'", name, p.peek())
+		}
+	}
+}
+
+func (p *textParser) parseReturnType(exp *types.Expression) error {
+	end := p.pos
+	p.skipSpace()
+	if p.peek() != ':' {
+		p.pos = end
+		return nil
+	}
+	p.pos += 1
+	p.skipSpace()
+	returnType, err := p.parseName()
+	if err != nil {
+		return err
+	}
+	exp.ReturnType = returnType
+	return nil
+}
+
+func (p *textParser) parseArg() (types.ExpressionArg, error) {
+	c := p.peek()
+	switch {
+	case c == '"':
+		s, err := p.parseString()
+		return types.ExpressionArg{DType: "str", Str: s}, err
+	case isNameStart(c):
+		exp, err := p.parseExpression()
+		return types.ExpressionArg{DType: "exp", Exp: &exp}, err
+	case isNumberChar(c):
+		n, err := p.parseNumber()
+		return types.ExpressionArg{DType: "num", Num: n}, err
+	default:
+		return types.ExpressionArg{}, p.errorf("argument expected, but found '%c'", c)
+	}
+}
+
+func (p *textParser) parseString() (string, error) {
+	start := p.pos
+	p.pos += 1
+	for !p.atEnd() {
+		switch p.peek() {
+		case '\\':
+			p.pos += 2
+			continue
+		case '\n':
+			return "", p.errorAt(start, "string not closed before the end of the line")
+		case '"':
+			p.pos += 1
+			s, err := strconv.Unquote(p.text[start:p.pos])
+			if err != nil {
+				return "", p.errorAt(start, "invalid string %s", p.text[start:p.pos])
+			}
+			return s, nil
+		}
+		p.pos += 1
+	}
+	return "", p.errorAt(start, "string not closed before the end of the text")
+}
+
+func (p *textParser) parseNumber() (float64, error) {
+	start := p.pos
+	for !p.atEnd() && isNumberChar(p.peek()) {
+		p.pos += 1
+	}
+	n, err := strconv.ParseFloat(p.text[start:p.pos], 64)
+	if err != nil {
+		return 0, p.errorAt(start, "invalid number '%s'", p.text[start:p.pos])
+	}
+	return n, nil
+}
+
+// text is split into lines if an expression is longer than this
+const textSyntaxLineLength = 100
+
+// FormatRulesText returns the rules in text syntax, separated by empty lines.
+// Rules which cannot be written in the text syntax (e.g. unknown dtypes) return an error.
+func FormatRulesText(rules []types.Expression) (string, error) {
+	var sb strings.Builder
+	for i, rule := range rules {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		text, err := FormatExpressionText(rule)
+		if err != nil {
+			return "", fmt.Errorf("rules[%d]: %v", i, err)
+		}
+		sb.WriteString(text)
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// FormatExpressionText returns the expression in text syntax, nested expressions are indented if they do not fit on one line
+func FormatExpressionText(exp types.Expression) (string, error) {
+	var sb strings.Builder
+	if err := formatExpression(&sb, exp, ""); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func formatExpression(sb *strings.Builder, exp types.Expression, indent string) error {
+	inline, err := formatInline(exp)
+	if err != nil {
+		return err
+	}
+	if len(indent)+len(inline) <= textSyntaxLineLength || len(exp.Data) == 0 {
+		sb.WriteString(inline)
+		return nil
+	}
+
+	sb.WriteString(exp.Name)
+	sb.WriteString("(\n")
+	argIndent := indent + "  "
+	for i, arg := range exp.Data {
+		sb.WriteString(argIndent)
+		if arg.DType == "exp" {
+			if err := formatExpression(sb, *arg.Exp, argIndent); err != nil {
+				return err
+			}
+		} else {
+			literal, err := formatLiteral(arg)
+			if err != nil {
+				return err
+			}
+			sb.WriteString(literal)
+		}
+		if i < len(exp.Data)-1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString(indent)
+	sb.WriteString(")")
+	sb.WriteString(formatReturnType(exp))
+	return nil
+}
+
+func formatInline(exp types.Expression) (string, error) {
+	if err := checkTextName(exp.Name); err != nil {
+		return "", err
+	}
+	if exp.ReturnType != "" {
+		if err := checkTextName(exp.ReturnType); err != nil {
+			return "", fmt.Errorf("return type of '%s': %v", exp.Name, err)
+		}
+	}
+	args := make([]string, len(exp.Data))
+	for i, arg := range exp.Data {
+		var err error
+		if arg.DType == "exp" {
+			if arg.Exp == nil {
+				return "", fmt.Errorf("'%s': dtype is 'exp', but expression is missing", exp.Name)
+			}
+			args[i], err = formatInline(*arg.Exp)
+		} else {
+			args[i], err = formatLiteral(arg)
+		}
+		if err != nil {
+			return "", fmt.Errorf("'%s': %v", exp.Name, err)
+		}
+	}
+	return exp.Name + "(" + strings.Join(args, ", ") + ")" + formatReturnType(exp), nil
+}
+
+func formatReturnType(exp types.Expression) string {
+	if exp.ReturnType != "" {
+		return ":" + exp.ReturnType
+	}
+	return ""
+}
+
+func formatLiteral(arg types.ExpressionArg) (string, error) {
+	switch arg.DType {
+	case "str":
+		return strconv.Quote(arg.Str), nil
+	case "num":
+		return formatNumber(arg.Num)
+	case "":
+		// no dtype: evaluated as string, a number would be lost (e.g. in conditions checking num)
+		if arg.Num != 0 {
+			return "", fmt.Errorf("number %v of a literal without dtype not supported", arg.Num)
+		}
+		return strconv.Quote(arg.Str), nil
+	default:
+		return "", fmt.Errorf("dtype '%s' not supported", arg.DType)
+	}
+}
+
+func formatNumber(n float64) (string, error) {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return "", fmt.Errorf("number %v not supported", n)
+	}
+	return strconv.FormatFloat(n, 'g', -1, 64), nil
+}
+
+func checkTextName(name string) error {
+	if name == "" || !isNameStart(name[0]) {
+		return fmt.Errorf("name '%s' not supported", name)
+	}
+	for i := 1; i < len(name); i++ {
+		if !isNameChar(name[i]) {
+			return fmt.Errorf("name '%s' not supported", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package studyengine
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestTextSyntax(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.ExpressionArg {
+		return types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: name, Data: args}}
+	}
+	// roundTrip checks that the expression is parsed back from its text unchanged
+	roundTrip := func(t *testing.T, e types.Expression) {
+		text, err := FormatExpressionText(e)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		parsed, err := ParseExpressionText(text)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", text, err)
+		}
+		if !reflect.DeepEqual(parsed, e) {
+			t.Errorf("round trip of %s failed:\n%#v\n%#v", text, parsed, e)
+		}
+	}
+
+	t.Run("parse rules", func(t *testing.T) {
+		text := `
+			// participants entering the study
+			IFTHEN(checkEventType("ENTER"), UPDATE_FLAG("group", "A"), ADD_NEW_SURVEY("intake", 0, 0, "normal"))
+
+			IF(
+				gt(getResponseValueAsNum("weekly", "weekly.Q1"), -38.5e-1), // fever
+				UPDATE_FLAG("fever", "\"yes\"\n"),
+			)
+			IF(externalEventEval("service", "route"):float, DO())
+		`
+		rules, err := ParseRulesText(text)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []types.Expression{
+			{Name: "IFTHEN", Data: []types.ExpressionArg{
+				exp("checkEventType", str("ENTER")),
+				exp("UPDATE_FLAG", str("group"), str("A")),
+				exp("ADD_NEW_SURVEY", str("intake"), num(0), num(0), str("normal")),
+			}},
+			{Name: "IF", Data: []types.ExpressionArg{
+				exp("gt", exp("getResponseValueAsNum", str("weekly"), str("weekly.Q1")), num(-3.85)),
+				exp("UPDATE_FLAG", str("fever"), str("\"yes\"\n")),
+			}},
+			{Name: "IF", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "externalEventEval", ReturnType: "float", Data: []types.ExpressionArg{str("service"), str("route")}}},
+				exp("DO"),
+			}},
+		}
+		if !reflect.DeepEqual(rules, expected) {
+			t.Errorf("unexpected rules:\n%#v", rules)
+		}
+
+		// same as decoding the JSON form
+		encoded, _ := json.Marshal(expected)
+		decoded := []types.Expression{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(rules, decoded) {
+			t.Errorf("should be equal to the decoded JSON:\n%#v", decoded)
+		}
+	})
+
+	t.Run("syntax errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			text   string
+			line   int
+			column int
+		}{
+			{text: `UPDATE_FLAG("a", "1"`, line: 1, column: 21},
+			{text: `UPDATE_FLAG("a" "1")`, line: 1, column: 17},
+			{text: "DO(\n  UPDATE_FLAG(\"a\", 1x))", line: 2, column: 21},
+			{text: `DO("a)`, line: 1, column: 4},
+			{text: `DO(1.2.3)`, line: 1, column: 4},
+			{text: `DO(UPDATE_FLAG)`, line: 1, column: 15},
+			{text: `DO(*)`, line: 1, column: 4},
+			{text: `DO() )`, line: 1, column: 6},
+			{text: `DO():`, line: 1, column: 6},
+		} {
+			rules, err := ParseRulesText(tc.text)
+			syntaxErr, ok := err.(*TextSyntaxError)
+			if !ok || syntaxErr.Line != tc.line || syntaxErr.Column != tc.column {
+				t.Errorf("unexpected result for %s: %v, %v", tc.text, rules, err)
+			}
+		}
+		if _, err := ParseExpressionText(`DO() DO()`); err == nil {
+			t.Error("should return an error for more than one expression")
+		}
+	})
+
+	t.Run("nesting limit", func(t *testing.T) {
+		maxDepth := DefaultEvalLimits().MaxDepth
+		nested := func(depth int) string {
+			return strings.Repeat("not(", depth-1) + "isDefined()" + strings.Repeat(")", depth-1)
+		}
+		if _, err := ParseExpressionText(nested(maxDepth)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if _, err := ParseExpressionText(nested(maxDepth + 1)); err == nil {
+			t.Error("should return an error")
+		}
+		if _, err := ParseRulesText(nested(1000000)); err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("format", func(t *testing.T) {
+		rules := []types.Expression{
+			{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{str("a"), num(1.5)}},
+			{Name: "IFTHEN", Data: []types.ExpressionArg{
+				exp("and", exp("checkSurveyResponseKey", str("weekly")), exp("responseHasKeysAny", str("weekly.Q1"), str("rg.mcg"), str("1"), str("2"))),
+				exp("UPDATE_FLAG", str("symptoms"), str("yes")),
+				exp("ADD_MESSAGE", exp("timestampWithOffset", num(86400)), str("reminder")),
+			}},
+		}
+		text, err := FormatRulesText(rules)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := `UPDATE_FLAG("a", 1.5)
+
+IFTHEN(
+  and(checkSurveyResponseKey("weekly"), responseHasKeysAny("weekly.Q1", "rg.mcg", "1", "2")),
+  UPDATE_FLAG("symptoms", "yes"),
+  ADD_MESSAGE(timestampWithOffset(86400), "reminder")
+)
+`
+		if text != expected {
+			t.Errorf("unexpected text:\n%s", text)
+		}
+		parsed, err := ParseRulesText(text)
+		if err != nil || !reflect.DeepEqual(parsed, rules) {
+			t.Errorf("unexpected result: %v, %v", parsed, err)
+		}
+	})
+
+	t.Run("literals without dtype", func(t *testing.T) {
+		text, err := FormatExpressionText(types.Expression{Name: "GET_LAST_SURVEY_ITEM", Data: []types.ExpressionArg{
+			{Str: "weekly"}, {},
+		}})
+		if err != nil || text != `GET_LAST_SURVEY_ITEM("weekly", "")` {
+			t.Errorf("unexpected result: %s, %v", text, err)
+		}
+		for _, arg := range []types.ExpressionArg{{Num: 10}, {Str: "weekly", Num: 10}} {
+			if _, err := FormatExpressionText(types.Expression{Name: "GET_LAST_SURVEY_ITEM", Data: []types.ExpressionArg{arg}}); err == nil {
+				t.Errorf("should return an error for %v", arg)
+			}
+		}
+	})
+
+	t.Run("not supported by the text syntax", func(t *testing.T) {
+		for _, e := range []types.Expression{
+			{Name: "DO", Data: []types.ExpressionArg{{DType: "bool"}}},
+			{Name: "DO", Data: []types.ExpressionArg{{DType: "exp"}}},
+			{Name: "DO", Data: []types.ExpressionArg{exp("1abc")}},
+			{Name: "my action"},
+			{Name: ""},
+		} {
+			if _, err := FormatRulesText([]types.Expression{e}); err == nil {
+				t.Errorf("should return an error for %v", e)
+			}
+		}
+	})
+
+	t.Run("round trip of all actions and expressions", func(t *testing.T) {
+		names := []string{}
+		for _, def := range RegisteredActions() {
+			names = append(names, def.Name)
+		}
+		for _, def := range RegisteredExpressions() {
+			names = append(names, def.Name)
+		}
+		longArg := str(strings.Repeat("long ", 30))
+		for _, name := range names {
+			roundTrip(t, types.Expression{Name: name})
+			roundTrip(t, types.Expression{Name: name, Data: []types.ExpressionArg{
+				str("key \"quoted\" é\t\n"), num(-0.25), num(1e21), str(""), exp(name, num(3)),
+			}})
+			roundTrip(t, types.Expression{Name: "DO", Data: []types.ExpressionArg{
+				exp(name, longArg, exp(name, longArg, exp(name))),
+			}})
+			roundTrip(t, types.Expression{Name: name, ReturnType: "float", Data: []types.ExpressionArg{str("a")}})
+		}
+	})
+}
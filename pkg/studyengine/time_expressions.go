@@ -0,0 +1,203 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// the service image has no zoneinfo, so the timezone database is embedded
+	_ "time/tzdata"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	CALENDAR_PERIOD_DAY   = "day"
+	CALENDAR_PERIOD_WEEK  = "week" // ISO week, starting on Monday
+	CALENDAR_PERIOD_MONTH = "month"
+)
+
+var locationCache = struct {
+	sync.Mutex
+	byName map[string]*time.Location
+}{byName: map[string]*time.Location{}}
+
+// loadLocation returns the IANA timezone, UTC for an empty name
+func loadLocation(name string) (*time.Location, error) {
+	locationCache.Lock()
+	defer locationCache.Unlock()
+	if loc, ok := locationCache.byName[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone '%s'", name)
+	}
+	locationCache.byName[name] = loc
+	return loc, nil
+}
+
+// locationArg resolves the optional timezone argument at the index, e.g. "Europe/Paris" or a participant flag value, UTC if omitted or empty
+func (ctx EvalContext) locationArg(args []types.ExpressionArg, index int) (*time.Location, error) {
+	if len(args) <= index {
+		return time.UTC, nil
+	}
+	name, err := ctx.mustGetStrValue(args[index])
+	if err != nil {
+		return nil, err
+	}
+	return loadLocation(strings.TrimSpace(name))
+}
+
+// localTimeArgs resolves the timestamp and optional timezone arguments of the calendar expressions
+func (ctx EvalContext) localTimeArgs(exp types.Expression) (time.Time, error) {
+	if len(exp.Data) != 1 && len(exp.Data) != 2 {
+		return time.Time{}, errors.New("should have one or two arguments")
+	}
+	ts, err := ctx.mustGetNumValue(exp.Data[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := ctx.locationArg(exp.Data, 1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(ts), 0).In(loc), nil
+}
+
+// getDayOfWeek returns the ISO day of the week (1 = Monday, 7 = Sunday) in the timezone
+func (ctx EvalContext) getDayOfWeek(exp types.Expression) (val float64, err error) {
+	t, err := ctx.localTimeArgs(exp)
+	if err != nil {
+		return val, err
+	}
+	weekday := int(t.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	return float64(weekday), nil
+}
+
+func (ctx EvalContext) getMonth(exp types.Expression) (val float64, err error) {
+	t, err := ctx.localTimeArgs(exp)
+	if err != nil {
+		return val, err
+	}
+	return float64(t.Month()), nil
+}
+
+func (ctx EvalContext) getYear(exp types.Expression) (val float64, err error) {
+	t, err := ctx.localTimeArgs(exp)
+	if err != nil {
+		return val, err
+	}
+	return float64(t.Year()), nil
+}
+
+// startOfPeriod returns the local midnight starting the day, ISO week or month of t
+func startOfPeriod(t time.Time, period string) time.Time {
+	switch period {
+	case CALENDAR_PERIOD_WEEK:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case CALENDAR_PERIOD_MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+// endOfPeriod returns the last second of the day, ISO week or month of t
+func endOfPeriod(t time.Time, period string) time.Time {
+	start := startOfPeriod(t, period)
+	var next time.Time
+	switch period {
+	case CALENDAR_PERIOD_WEEK:
+		next = time.Date(start.Year(), start.Month(), start.Day()+7, 0, 0, 0, 0, start.Location())
+	case CALENDAR_PERIOD_MONTH:
+		next = time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, start.Location())
+	default:
+		next = time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, start.Location())
+	}
+	return next.Add(-time.Second)
+}
+
+func (ctx EvalContext) startOf(exp types.Expression, period string) (val float64, err error) {
+	t, err := ctx.localTimeArgs(exp)
+	if err != nil {
+		return val, err
+	}
+	return float64(startOfPeriod(t, period).Unix()), nil
+}
+
+func (ctx EvalContext) endOf(exp types.Expression, period string) (val float64, err error) {
+	t, err := ctx.localTimeArgs(exp)
+	if err != nil {
+		return val, err
+	}
+	return float64(endOfPeriod(t, period).Unix()), nil
+}
+
+// parseTimeOfDay reads a local time in the format "HH:MM"
+func parseTimeOfDay(value string) (hour int, minute int, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("time of day '%s' should have the format HH:MM", value)
+	}
+	hour, err1 := strconv.Atoi(parts[0])
+	minute, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("time of day '%s' should have the format HH:MM", value)
+	}
+	return hour, minute, nil
+}
+
+// nextWeekdayAt returns the first time after the reference, that is on the weekday at the local time
+func nextWeekdayAt(reference time.Time, weekday time.Weekday, hour int, minute int) time.Time {
+	daysAhead := (int(weekday) - int(reference.Weekday()) + 7) % 7
+	next := time.Date(reference.Year(), reference.Month(), reference.Day()+daysAhead, hour, minute, 0, 0, reference.Location())
+	if !next.After(reference) {
+		next = time.Date(reference.Year(), reference.Month(), reference.Day()+daysAhead+7, hour, minute, 0, 0, reference.Location())
+	}
+	return next
+}
+
+// getTsForNextWeekday returns the timestamp of the next weekday at the local time of day in the timezone, after the reference (default: now)
+func (ctx EvalContext) getTsForNextWeekday(exp types.Expression) (val float64, err error) {
+	if len(exp.Data) < 2 || len(exp.Data) > 4 {
+		return val, errors.New("should have two to four arguments")
+	}
+	weekday, err := ctx.mustGetNumValue(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	if weekday < 1 || weekday > 7 {
+		return val, errors.New("weekday should be between 1 (Monday) and 7 (Sunday)")
+	}
+	timeOfDay, err := ctx.mustGetStrValue(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+	hour, minute, err := parseTimeOfDay(timeOfDay)
+	if err != nil {
+		return val, err
+	}
+	loc, err := ctx.locationArg(exp.Data, 2)
+	if err != nil {
+		return val, err
+	}
+	reference := Now()
+	if len(exp.Data) == 4 {
+		ts, err := ctx.mustGetNumValue(exp.Data[3])
+		if err != nil {
+			return val, err
+		}
+		reference = time.Unix(int64(ts), 0)
+	}
+
+	next := nextWeekdayAt(reference.In(loc), time.Weekday(int(weekday)%7), hour, minute)
+	return float64(next.Unix()), nil
+}
@@ -0,0 +1,108 @@
+package studyengine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestCalendarExpressions(t *testing.T) {
+	str := func(s string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "str", Str: s}
+	}
+	num := func(n float64) types.ExpressionArg {
+		return types.ExpressionArg{DType: "num", Num: n}
+	}
+	exp := func(name string, args ...types.ExpressionArg) types.Expression {
+		return types.Expression{Name: name, Data: args}
+	}
+	ts := func(t time.Time) float64 {
+		return float64(t.Unix())
+	}
+	paris, _ := time.LoadLocation("Europe/Paris")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	// Sunday 2024-03-31 23:30 UTC: already Monday in Paris (after the switch to summer time) and Tokyo
+	ref := time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name     string
+		exp      types.Expression
+		expected float64
+	}{
+		{name: "day of week UTC", exp: exp("getDayOfWeek", num(ts(ref))), expected: 7},
+		{name: "day of week Paris", exp: exp("getDayOfWeek", num(ts(ref)), str("Europe/Paris")), expected: 1},
+		{name: "month UTC", exp: exp("getMonth", num(ts(ref))), expected: 3},
+		{name: "month Tokyo", exp: exp("getMonth", num(ts(ref)), str("Asia/Tokyo")), expected: 4},
+		{name: "year", exp: exp("getYear", num(ts(time.Date(2024, 12, 31, 20, 0, 0, 0, time.UTC))), str("Asia/Tokyo")), expected: 2025},
+		{name: "start of day Paris", exp: exp("startOfDay", num(ts(ref)), str("Europe/Paris")), expected: ts(time.Date(2024, 4, 1, 0, 0, 0, 0, paris))},
+		{name: "end of day UTC", exp: exp("endOfDay", num(ts(ref))), expected: ts(time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC))},
+		{name: "start of week UTC", exp: exp("startOfWeek", num(ts(ref))), expected: ts(time.Date(2024, 3, 25, 0, 0, 0, 0, time.UTC))},
+		{name: "start of week Paris", exp: exp("startOfWeek", num(ts(ref)), str("Europe/Paris")), expected: ts(time.Date(2024, 4, 1, 0, 0, 0, 0, paris))},
+		{name: "end of week Paris", exp: exp("endOfWeek", num(ts(ref)), str("Europe/Paris")), expected: ts(time.Date(2024, 4, 7, 23, 59, 59, 0, paris))},
+		{name: "start of month Tokyo", exp: exp("startOfMonth", num(ts(ref)), str("Asia/Tokyo")), expected: ts(time.Date(2024, 4, 1, 0, 0, 0, 0, tokyo))},
+		{name: "end of month UTC", exp: exp("endOfMonth", num(ts(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)))), expected: ts(time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC))},
+		{name: "next Monday 08:00 Paris", exp: exp("getTsForNextWeekday", num(1), str("08:00"), str("Europe/Paris"), num(ts(time.Date(2024, 3, 29, 12, 0, 0, 0, time.UTC)))), expected: ts(time.Date(2024, 4, 1, 8, 0, 0, 0, paris))},
+		{name: "next Monday 08:00 Tokyo", exp: exp("getTsForNextWeekday", num(1), str("08:00"), str("Asia/Tokyo"), num(ts(time.Date(2024, 3, 29, 12, 0, 0, 0, time.UTC)))), expected: ts(time.Date(2024, 4, 1, 8, 0, 0, 0, tokyo))},
+		{name: "same day before time", exp: exp("getTsForNextWeekday", num(1), str("08:00"), str("UTC"), num(ts(time.Date(2024, 4, 1, 7, 0, 0, 0, time.UTC)))), expected: ts(time.Date(2024, 4, 1, 8, 0, 0, 0, time.UTC))},
+		{name: "same day after time", exp: exp("getTsForNextWeekday", num(1), str("08:00"), str("UTC"), num(ts(time.Date(2024, 4, 1, 8, 0, 0, 0, time.UTC)))), expected: ts(time.Date(2024, 4, 8, 8, 0, 0, 0, time.UTC))},
+		{name: "next Sunday", exp: exp("getTsForNextWeekday", num(7), str("20:30"), str(""), num(ts(time.Date(2024, 4, 1, 8, 0, 0, 0, time.UTC)))), expected: ts(time.Date(2024, 4, 7, 20, 30, 0, 0, time.UTC))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if errs := ValidateExpression(tc.exp, "exp"); len(errs) > 0 {
+				t.Errorf("unexpected validation errors: %v", errs)
+			}
+			val, err := ExpressionEval(tc.exp, EvalContext{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if val.(float64) != tc.expected {
+				t.Errorf("unexpected value: %s, expected %s", time.Unix(int64(val.(float64)), 0).UTC(), time.Unix(int64(tc.expected), 0).UTC())
+			}
+		})
+	}
+
+	t.Run("default reference is now", func(t *testing.T) {
+		Now = func() time.Time { return time.Date(2024, 3, 29, 12, 0, 0, 0, time.UTC) }
+		defer func() { Now = time.Now }()
+		val, err := ExpressionEval(exp("getTsForNextWeekday", num(1), str("08:00")), EvalContext{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if val.(float64) != ts(time.Date(2024, 4, 1, 8, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected value: %v", val)
+		}
+	})
+
+	t.Run("timezone from participant flag", func(t *testing.T) {
+		e := exp("getTsForNextWeekday", num(1), str("08:00"),
+			types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getParticipantFlagValue", Data: []types.ExpressionArg{str("timezone")}}},
+			num(ts(time.Date(2024, 3, 29, 12, 0, 0, 0, time.UTC))),
+		)
+		ctx := EvalContext{ParticipantState: types.ParticipantState{Flags: map[string]string{"timezone": "Asia/Tokyo"}}}
+		val, err := ExpressionEval(e, ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if val.(float64) != ts(time.Date(2024, 4, 1, 8, 0, 0, 0, tokyo)) {
+			t.Errorf("unexpected value: %v", val)
+		}
+	})
+
+	for _, tc := range []struct {
+		name string
+		exp  types.Expression
+	}{
+		{name: "unknown timezone", exp: exp("startOfDay", num(0), str("Mars/Olympus"))},
+		{name: "invalid weekday", exp: exp("getTsForNextWeekday", num(0), str("08:00"))},
+		{name: "invalid time of day", exp: exp("getTsForNextWeekday", num(1), str("8h"))},
+		{name: "time of day out of range", exp: exp("getTsForNextWeekday", num(1), str("24:00"))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ExpressionEval(tc.exp, EvalContext{}); err == nil {
+				t.Error("should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package studyengine
+
+import (
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+const (
+	TRACE_NODE_KIND_ACTION     = "action"
+	TRACE_NODE_KIND_EXPRESSION = "expression"
+)
+
+// EvalTraceNode is one evaluated action or expression with its resolved arguments and result
+type EvalTraceNode struct {
+	Kind     string           `json:"kind"`
+	Name     string           `json:"name"`
+	Args     []interface{}    `json:"args,omitempty"`
+	Result   interface{}      `json:"result,omitempty"`
+	Error    string           `json:"error,omitempty"`
+	Children []*EvalTraceNode `json:"children,omitempty"`
+}
+
+// EvalTracer records the tree of evaluated actions and expressions. Add it to ActionConfigs to enable tracing.
+// A nil tracer is valid and records nothing.
+type EvalTracer struct {
+	Roots     []*EvalTraceNode `json:"roots"`
+	Truncated bool             `json:"truncated,omitempty"` // MaxNodes was reached, later nodes are missing
+	MaxNodes  int              `json:"-"`                   // nodes recorded at most, 0 for no limit
+	stack     []*EvalTraceNode
+	nodes     int
+	skipped   int // depth of nodes not recorded
+}
+
+func NewEvalTracer() *EvalTracer {
+	return &EvalTracer{Roots: []*EvalTraceNode{}}
+}
+
+func (t *EvalTracer) begin(kind string, name string) *EvalTraceNode {
+	if t == nil {
+		return nil
+	}
+	if t.skipped > 0 || (t.MaxNodes > 0 && t.nodes >= t.MaxNodes) {
+		t.Truncated = true
+		t.skipped += 1
+		return nil
+	}
+	t.nodes += 1
+	node := &EvalTraceNode{Kind: kind, Name: name}
+	if len(t.stack) > 0 {
+		parent := t.stack[len(t.stack)-1]
+		parent.Children = append(parent.Children, node)
+	} else {
+		t.Roots = append(t.Roots, node)
+	}
+	t.stack = append(t.stack, node)
+	return node
+}
+
+func (t *EvalTracer) end(node *EvalTraceNode, result interface{}, err error) {
+	if t == nil {
+		return
+	}
+	if node == nil {
+		if t.skipped > 0 {
+			t.skipped -= 1
+		}
+		return
+	}
+	node.Result = result
+	if err != nil {
+		node.Error = err.Error()
+	}
+	// pop until the node is removed, to recover from unbalanced calls
+	for len(t.stack) > 0 {
+		top := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		if top == node {
+			break
+		}
+	}
+}
+
+// addArg records a resolved argument for the currently evaluated node
+func (t *EvalTracer) addArg(value interface{}) {
+	if t == nil || t.skipped > 0 || len(t.stack) < 1 {
+		return
+	}
+	current := t.stack[len(t.stack)-1]
+	current.Args = append(current.Args, value)
+}
+
+// actionTraceResult describes the effect of an action in the trace
+func actionTraceResult(oldState ActionData, newState ActionData) interface{} {
+	diff := types.DiffParticipantStates(oldState.PState, newState.PState)
+	if diff.IsEmpty() {
+		return nil
+	}
+	return diff
+}
@@ -0,0 +1,124 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestEvalTracer(t *testing.T) {
+	actionData := ActionData{
+		PState: types.ParticipantState{
+			ParticipantID: "participant1234",
+			Flags: map[string]string{
+				"group": "A",
+			},
+		},
+		ReportsToCreate: map[string]types.Report{},
+	}
+	event := types.StudyEvent{
+		Type: "TIMER",
+	}
+
+	action := types.Expression{
+		Name: "IF",
+		Data: []types.ExpressionArg{
+			{DType: "exp", Exp: &types.Expression{Name: "hasParticipantFlag", Data: []types.ExpressionArg{
+				{DType: "str", Str: "group"},
+				{DType: "str", Str: "A"},
+			}}},
+			{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+				{DType: "str", Str: "next"},
+				{DType: "exp", Exp: &types.Expression{Name: "getParticipantFlagValue", Data: []types.ExpressionArg{
+					{DType: "str", Str: "group"},
+				}}},
+			}}},
+		},
+	}
+
+	t.Run("without tracer", func(t *testing.T) {
+		_, err := ActionEval(action, actionData, event, ActionConfigs{})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with tracer", func(t *testing.T) {
+		tracer := NewEvalTracer()
+		newState, err := ActionEval(action, actionData, event, ActionConfigs{Tracer: tracer})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["next"] != "A" {
+			t.Errorf("unexpected flags: %v", newState.PState.Flags)
+		}
+
+		if len(tracer.Roots) != 1 {
+			t.Fatalf("unexpected number of roots: %d", len(tracer.Roots))
+		}
+		root := tracer.Roots[0]
+		if root.Kind != TRACE_NODE_KIND_ACTION || root.Name != "IF" || len(root.Children) != 2 {
+			t.Fatalf("unexpected root: %v", root)
+		}
+		diff, ok := root.Result.(types.ParticipantStateDiff)
+		if !ok || len(diff.Flags) != 1 {
+			t.Errorf("unexpected root result: %v", root.Result)
+		}
+
+		condition := root.Children[0]
+		if condition.Kind != TRACE_NODE_KIND_EXPRESSION || condition.Name != "hasParticipantFlag" || condition.Result != true {
+			t.Errorf("unexpected condition node: %v", condition)
+		}
+		if len(condition.Args) != 2 || condition.Args[0] != "group" || condition.Args[1] != "A" {
+			t.Errorf("unexpected condition args: %v", condition.Args)
+		}
+
+		updateFlag := root.Children[1]
+		if updateFlag.Name != "UPDATE_FLAG" || len(updateFlag.Children) != 1 {
+			t.Fatalf("unexpected action node: %v", updateFlag)
+		}
+		if len(updateFlag.Args) != 2 || updateFlag.Args[0] != "next" || updateFlag.Args[1] != "A" {
+			t.Errorf("unexpected resolved args: %v", updateFlag.Args)
+		}
+		if updateFlag.Children[0].Name != "getParticipantFlagValue" || updateFlag.Children[0].Result != "A" {
+			t.Errorf("unexpected expression node: %v", updateFlag.Children[0])
+		}
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		tracer := NewEvalTracer()
+		_, err := ActionEval(types.Expression{
+			Name: "UPDATE_STUDY_STATUS",
+			Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "wrong"}},
+			},
+		}, actionData, event, ActionConfigs{Tracer: tracer})
+		if err == nil {
+			t.Error("should return an error")
+		}
+		if len(tracer.Roots) != 1 || tracer.Roots[0].Error == "" || len(tracer.Roots[0].Children) != 1 || tracer.Roots[0].Children[0].Error == "" {
+			t.Errorf("errors should be recorded: %v", tracer.Roots)
+		}
+	})
+
+	t.Run("max nodes", func(t *testing.T) {
+		tracer := NewEvalTracer()
+		tracer.MaxNodes = 2
+		newState, err := ActionEval(action, actionData, event, ActionConfigs{Tracer: tracer})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if newState.PState.Flags["next"] != "A" {
+			t.Errorf("evaluation should not be affected: %v", newState.PState.Flags)
+		}
+		if !tracer.Truncated || len(tracer.Roots) != 1 || len(tracer.Roots[0].Children) != 1 {
+			t.Fatalf("unexpected trace: %v", tracer.Roots)
+		}
+		if condition := tracer.Roots[0].Children[0]; condition.Name != "hasParticipantFlag" || len(condition.Args) != 2 {
+			t.Errorf("unexpected condition node: %v", condition)
+		}
+		if len(tracer.Roots[0].Args) != 0 {
+			t.Errorf("args of skipped nodes should not be recorded: %v", tracer.Roots[0].Args)
+		}
+	})
+}
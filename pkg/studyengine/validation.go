@@ -0,0 +1,280 @@
+package studyengine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// Argument types used to describe the expected arguments of actions and expressions
+const (
+	ARG_TYPE_ANY         = "any"         // literal string, number or expression
+	ARG_TYPE_STR         = "str"         // literal string or expression
+	ARG_TYPE_NUM         = "num"         // literal number or expression
+	ARG_TYPE_CONST       = "const"       // literal string or number, expressions are not resolved
+	ARG_TYPE_STR_CONST   = "strConst"    // literal string (dtype may be omitted), expressions are not resolved
+	ARG_TYPE_STR_LITERAL = "strLiteral"  // literal string with dtype "str"
+	ARG_TYPE_NUM_CONST   = "numConst"    // literal number (dtype may be omitted), expressions are not resolved
+	ARG_TYPE_EXP         = "exp"         // expression that is evaluated later (e.g. on old responses)
+	ARG_TYPE_CONDITION   = "condition"   // literal number (0 is false) or expression resolving to a boolean
+	ARG_TYPE_ACTION      = "action"      // action to be performed
+	ARG_TYPE_ACTION_ANY  = "actionOrAny" // action, or ignored if not an expression
+)
+
+// Signature describes the number and the types of arguments an action or expression accepts
+type Signature struct {
+	MinArgs int
+	MaxArgs int      // -1 for no upper limit
+	Args    []string // argument types by position
+	VarArgs string   // type of arguments after the ones listed in Args
+}
+
+func (s Signature) argType(index int) string {
+	if index < len(s.Args) {
+		return s.Args[index]
+	}
+	if s.VarArgs != "" {
+		return s.VarArgs
+	}
+	return ARG_TYPE_ANY
+}
+
+// rules used in survey definitions, resolved by the service when preparing a survey
+var prefillRuleSignatures = map[string]Signature{
+	"PREFILL_SLOT_WITH_VALUE": {MinArgs: 3, MaxArgs: 3, Args: []string{ARG_TYPE_STR_CONST, ARG_TYPE_STR_CONST, ARG_TYPE_CONST}},
+	"GET_LAST_SURVEY_ITEM":    {MinArgs: 2, MaxArgs: 3, Args: []string{ARG_TYPE_STR_CONST, ARG_TYPE_STR_CONST, ARG_TYPE_NUM_CONST}},
+}
+
+var contextRuleSignatures = map[string]Signature{
+	"LAST_RESPONSES_BY_KEY":  {MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_STR_CONST, ARG_TYPE_NUM_CONST}},
+	"ALL_RESPONSES_SINCE":    {MinArgs: 1, MaxArgs: 1, Args: []string{ARG_TYPE_NUM_CONST}},
+	"RESPONSES_SINCE_BY_KEY": {MinArgs: 2, MaxArgs: 2, Args: []string{ARG_TYPE_NUM_CONST, ARG_TYPE_STR_CONST}},
+}
+
+// ValidationError describes one problem found in an expression tree
+type ValidationError struct {
+	Path string `json:"path"` // location in the tree, e.g. rules[2].IFTHEN.data[1].UPDATE_FLAG.data[0]
+	Msg  string `json:"msg"`
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Msg)
+}
+
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ValidateStudyRules checks that every rule is a known action with the expected arguments
+func ValidateStudyRules(rules []types.Expression) ValidationErrors {
+	v := validator{}
+	for i, rule := range rules {
+		v.checkAction(rule, fmt.Sprintf("rules[%d]", i))
+	}
+	return v.errors
+}
+
+// ValidateExpression checks an expression evaluated on its own (e.g. the participant file upload rule)
+func ValidateExpression(exp types.Expression, path string) ValidationErrors {
+	v := validator{}
+	v.checkExpression(exp, path)
+	return v.errors
+}
+
+// ValidatePrefillRules checks the prefill rules of a survey definition
+func ValidatePrefillRules(rules []types.Expression) ValidationErrors {
+	v := validator{}
+	for i, rule := range rules {
+		v.checkServiceRule(rule, fmt.Sprintf("prefillRules[%d]", i), prefillRuleSignatures)
+	}
+	return v.errors
+}
+
+// ValidateContextRules checks the context rules of a survey definition
+func ValidateContextRules(rules *types.SurveyContextDef) ValidationErrors {
+	v := validator{}
+	if rules == nil {
+		return v.errors
+	}
+	if rules.Mode != nil && rules.Mode.IsExpression() {
+		v.addError("contextRules.mode", "expression arg type not supported")
+	}
+	for i, rule := range rules.PreviousResponses {
+		v.checkServiceRule(rule, fmt.Sprintf("contextRules.previousResponses[%d]", i), contextRuleSignatures)
+	}
+	return v.errors
+}
+
+type validator struct {
+	errors ValidationErrors
+}
+
+func (v *validator) addError(path string, format string, args ...interface{}) {
+	v.errors = append(v.errors, ValidationError{Path: path, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) checkAction(action types.Expression, path string) {
+	def, ok := LookupAction(action.Name)
+	if !ok {
+		if _, isExp := LookupExpression(action.Name); isExp {
+			v.addError(path, "'%s' is an expression, but an action is expected", action.Name)
+		} else {
+			v.addError(path, "unknown action '%s'", action.Name)
+		}
+		return
+	}
+	v.checkArgs(action, path, def.Metadata.Signature)
+}
+
+func (v *validator) checkExpression(exp types.Expression, path string) {
+	def, ok := LookupExpression(exp.Name)
+	if !ok {
+		if _, isAction := LookupAction(exp.Name); isAction {
+			v.addError(path, "'%s' is an action, but an expression is expected", exp.Name)
+		} else {
+			v.addError(path, "unknown expression '%s'", exp.Name)
+		}
+		return
+	}
+	v.checkArgs(exp, path, def.Metadata.Signature)
+}
+
+func (v *validator) checkServiceRule(rule types.Expression, path string, signatures map[string]Signature) {
+	sig, ok := signatures[rule.Name]
+	if !ok {
+		v.addError(path, "unknown rule '%s'", rule.Name)
+		return
+	}
+	v.checkArgs(rule, path, &sig)
+}
+
+// checkArgs validates the arguments against the signature, only nested expressions are checked if sig is nil
+func (v *validator) checkArgs(exp types.Expression, path string, sig *Signature) {
+	if sig == nil {
+		for i, arg := range exp.Data {
+			if !arg.IsExpression() || arg.Exp == nil {
+				continue
+			}
+			argPath := fmt.Sprintf("%s.%s.data[%d]", path, exp.Name, i)
+			if _, isAction := LookupAction(arg.Exp.Name); isAction {
+				v.checkAction(*arg.Exp, argPath)
+			} else {
+				v.checkExpression(*arg.Exp, argPath)
+			}
+		}
+		return
+	}
+
+	argCount := len(exp.Data)
+	if argCount < sig.MinArgs || (sig.MaxArgs >= 0 && argCount > sig.MaxArgs) {
+		v.addError(path, "'%s' %s, but has %d", exp.Name, expectedArgCount(*sig), argCount)
+	}
+
+	for i, arg := range exp.Data {
+		argPath := fmt.Sprintf("%s.%s.data[%d]", path, exp.Name, i)
+		v.checkArg(arg, argPath, sig.argType(i))
+	}
+}
+
+func (v *validator) checkArg(arg types.ExpressionArg, path string, argType string) {
+	switch arg.DType {
+	case "exp":
+		if arg.Exp == nil {
+			v.addError(path, "dtype is 'exp', but expression is missing")
+			return
+		}
+	case "num", "str", "":
+		if arg.Exp != nil {
+			v.addError(path, "contains an expression, but dtype is '%s'", arg.DType)
+			return
+		}
+	default:
+		v.addError(path, "unknown dtype '%s'", arg.DType)
+		return
+	}
+
+	isExp := arg.IsExpression()
+	isNum := arg.IsNumber()
+	isStr := !isExp && !isNum
+
+	switch argType {
+	case ARG_TYPE_ACTION:
+		if !isExp {
+			v.addError(path, "expected an action, but got dtype '%s'", arg.DType)
+			return
+		}
+		v.checkAction(*arg.Exp, path)
+	case ARG_TYPE_ACTION_ANY:
+		if isExp {
+			v.checkAction(*arg.Exp, path)
+		}
+	case ARG_TYPE_CONDITION:
+		if arg.IsString() {
+			v.addError(path, "expected a number or an expression as condition, but got a string")
+			return
+		}
+		if isExp {
+			v.checkExpression(*arg.Exp, path)
+		}
+	case ARG_TYPE_EXP:
+		if !isExp {
+			v.addError(path, "expected an expression, but got dtype '%s'", arg.DType)
+			return
+		}
+		v.checkExpression(*arg.Exp, path)
+	case ARG_TYPE_STR:
+		if isNum {
+			v.addError(path, "expected a string, but got a number")
+			return
+		}
+		if isExp {
+			v.checkExpression(*arg.Exp, path)
+		}
+	case ARG_TYPE_NUM:
+		if isStr {
+			v.addError(path, "expected a number, but got a string")
+			return
+		}
+		if isExp {
+			v.checkExpression(*arg.Exp, path)
+		}
+	case ARG_TYPE_CONST:
+		if isExp {
+			v.addError(path, "expected a string or a number, expressions are not supported here")
+		}
+	case ARG_TYPE_STR_CONST:
+		if !isStr {
+			v.addError(path, "expected a string, but got dtype '%s'", arg.DType)
+		}
+	case ARG_TYPE_STR_LITERAL:
+		if !arg.IsString() {
+			v.addError(path, "expected dtype 'str', but got '%s'", arg.DType)
+		}
+	case ARG_TYPE_NUM_CONST:
+		if !isNum && arg.DType != "" {
+			v.addError(path, "expected a number, but got dtype '%s'", arg.DType)
+		}
+	default:
+		if isExp {
+			v.checkExpression(*arg.Exp, path)
+		}
+	}
+}
+
+func expectedArgCount(sig Signature) string {
+	switch {
+	case sig.MaxArgs < 0:
+		return fmt.Sprintf("expects at least %d argument(s)", sig.MinArgs)
+	case sig.MinArgs == sig.MaxArgs:
+		return fmt.Sprintf("expects exactly %d argument(s)", sig.MinArgs)
+	default:
+		return fmt.Sprintf("expects %d to %d arguments", sig.MinArgs, sig.MaxArgs)
+	}
+}
@@ -0,0 +1,136 @@
+package studyengine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestValidateStudyRules(t *testing.T) {
+	t.Run("valid rules", func(t *testing.T) {
+		rules := []types.Expression{
+			{Name: "IFTHEN", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "and", Data: []types.ExpressionArg{
+					{DType: "exp", Exp: &types.Expression{Name: "checkEventType", Data: []types.ExpressionArg{{DType: "str", Str: "SUBMIT"}}}},
+					{DType: "exp", Exp: &types.Expression{Name: "incomingState:hasSurveyKeyAssigned", Data: []types.ExpressionArg{{DType: "str", Str: "weekly"}}}},
+				}}},
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+					{DType: "str", Str: "lastWeekly"},
+					{DType: "exp", Exp: &types.Expression{Name: "timestampWithOffset", Data: []types.ExpressionArg{{DType: "num", Num: 0}}}},
+				}}},
+				{DType: "exp", Exp: &types.Expression{Name: "ADD_NEW_SURVEY", Data: []types.ExpressionArg{
+					{DType: "str", Str: "weekly"},
+					{DType: "num", Num: 0},
+					{DType: "num", Num: 0},
+					{DType: "str", Str: "prio"},
+				}}},
+			}},
+			{Name: "IF", Data: []types.ExpressionArg{
+				{DType: "num", Num: 1},
+				{DType: "exp", Exp: &types.Expression{Name: "START_NEW_STUDY_SESSION"}},
+			}},
+		}
+		errs := ValidateStudyRules(rules)
+		if len(errs) > 0 {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		rules := []types.Expression{
+			{Name: "UNKNOWN_ACTION"},
+			{Name: "checkEventType", Data: []types.ExpressionArg{{DType: "str", Str: "SUBMIT"}}},
+			{Name: "IF", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG"}},
+				{DType: "str", Str: "not an action"},
+			}},
+			{Name: "ADD_NEW_SURVEY", Data: []types.ExpressionArg{
+				{DType: "str", Str: "weekly"},
+				{DType: "str", Str: "0"},
+				{DType: "num", Num: 0},
+			}},
+			{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+				{DType: "str", Str: "key", Exp: &types.Expression{Name: "getStudyEntryTime"}},
+				{DType: "bool"},
+			}},
+		}
+		errs := ValidateStudyRules(rules)
+		expected := []string{
+			"rules[0]: unknown action 'UNKNOWN_ACTION'",
+			"rules[1]: 'checkEventType' is an expression, but an action is expected",
+			"rules[2].IF.data[0]: 'UPDATE_FLAG' is an action, but an expression is expected",
+			"rules[2].IF.data[1]: expected an action, but got dtype 'str'",
+			"rules[3]: 'ADD_NEW_SURVEY' expects exactly 4 argument(s), but has 3",
+			"rules[3].ADD_NEW_SURVEY.data[1]: expected a number, but got a string",
+			"rules[4].UPDATE_FLAG.data[0]: contains an expression, but dtype is 'str'",
+			"rules[4].UPDATE_FLAG.data[1]: unknown dtype 'bool'",
+		}
+		if len(errs) != len(expected) {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		for i, e := range expected {
+			if errs[i].Error() != e {
+				t.Errorf("unexpected error at %d: %s, expected: %s", i, errs[i].Error(), e)
+			}
+		}
+		if !strings.Contains(errs.Error(), "; ") {
+			t.Errorf("errors should be joined: %s", errs.Error())
+		}
+	})
+
+	t.Run("nested expression", func(t *testing.T) {
+		rules := []types.Expression{
+			{Name: "DO", Data: []types.ExpressionArg{
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_STUDY_STATUS", Data: []types.ExpressionArg{
+					{DType: "exp", Exp: &types.Expression{Name: "hasSurveyKeyAssigned", Data: []types.ExpressionArg{
+						{DType: "exp", Exp: &types.Expression{Name: "getParticipantFlagValue", Data: []types.ExpressionArg{{Str: "key"}}}},
+					}}},
+				}}},
+			}},
+		}
+		errs := ValidateStudyRules(rules)
+		if len(errs) != 1 || errs[0].Path != "rules[0].DO.data[0].UPDATE_STUDY_STATUS.data[0].hasSurveyKeyAssigned.data[0]" {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+}
+
+func TestValidateSurveyRules(t *testing.T) {
+	t.Run("prefill rules", func(t *testing.T) {
+		errs := ValidatePrefillRules([]types.Expression{
+			{Name: "GET_LAST_SURVEY_ITEM", Data: []types.ExpressionArg{{Str: "s1"}, {Str: "s1.1"}}},
+			{Name: "PREFILL_SLOT_WITH_VALUE", Data: []types.ExpressionArg{{Str: "s1.1"}, {Str: "rg.scg"}, {DType: "num", Num: 2}}},
+		})
+		if len(errs) > 0 {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+
+		errs = ValidatePrefillRules([]types.Expression{
+			{Name: "GET_LAST_SURVEY_ITEM", Data: []types.ExpressionArg{{Str: "s1"}}},
+			{Name: "PREFILL_SLOT_WITH_VALUE", Data: []types.ExpressionArg{{Str: "s1.1"}, {Str: "rg.scg"}, {DType: "exp", Exp: &types.Expression{Name: "getStudyEntryTime"}}}},
+			{Name: "UPDATE_FLAG"},
+		})
+		if len(errs) != 3 || errs[1].Path != "prefillRules[1].PREFILL_SLOT_WITH_VALUE.data[2]" || errs[2].Path != "prefillRules[2]" {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+
+	t.Run("context rules", func(t *testing.T) {
+		errs := ValidateContextRules(nil)
+		if len(errs) > 0 {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+
+		errs = ValidateContextRules(&types.SurveyContextDef{
+			Mode: &types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getStudyEntryTime"}},
+			PreviousResponses: []types.Expression{
+				{Name: "LAST_RESPONSES_BY_KEY", Data: []types.ExpressionArg{{DType: "str", Str: "weekly"}, {DType: "num", Num: 2}}},
+				{Name: "ALL_RESPONSES_SINCE", Data: []types.ExpressionArg{{DType: "str", Str: "yesterday"}}},
+			},
+		})
+		if len(errs) != 2 || errs[0].Path != "contextRules.mode" || errs[1].Path != "contextRules.previousResponses[1].ALL_RESPONSES_SINCE.data[0]" {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package studyengine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+// separator of list values, as returned by e.g. getSelectedKeys
+const LIST_SEPARATOR = ";"
+
+// withVariable returns a copy of the configs with the variable bound to the value, the original configs are not modified
+func (configs ActionConfigs) withVariable(name string, value interface{}) ActionConfigs {
+	variables := make(map[string]interface{}, len(configs.Variables)+1)
+	for k, v := range configs.Variables {
+		variables[k] = v
+	}
+	variables[name] = value
+	configs.Variables = variables
+	return configs
+}
+
+// splitList converts a semicolon separated list into its items, empty items are ignored
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, LIST_SEPARATOR) {
+		if item == "" {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// forEachAction performs the actions once for every item of the list, with the current item bound to the variable
+func forEachAction(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	newState = oldState
+	if len(action.Data) < 3 {
+		return newState, errors.New("forEachAction must have at least three arguments")
+	}
+	EvalContext := EvalContext{
+		Event:            event,
+		ParticipantState: newState.PState,
+		Configs:          configs,
+	}
+	arg1, err := EvalContext.expressionArgResolver(action.Data[0])
+	if err != nil {
+		return newState, err
+	}
+	arg2, err := EvalContext.expressionArgResolver(action.Data[1])
+	if err != nil {
+		return newState, err
+	}
+
+	varName, ok1 := arg1.(string)
+	list, ok2 := arg2.(string)
+	if !ok1 || !ok2 || varName == "" {
+		return newState, errors.New("could not parse arguments")
+	}
+
+	for _, item := range splitList(list) {
+		itemConfigs := configs.withVariable(varName, item)
+		for _, a := range action.Data[2:] {
+			if !a.IsExpression() {
+				continue
+			}
+			newState, err = ActionEval(*a.Exp, newState, event, itemConfigs)
+			if err != nil {
+				return newState, err
+			}
+		}
+	}
+	return
+}
+
+// letAction evaluates the value once and performs the actions with the value bound to the variable
+func letAction(action types.Expression, oldState ActionData, event types.StudyEvent, configs ActionConfigs) (newState ActionData, err error) {
+	newState = oldState
+	if len(action.Data) < 3 {
+		return newState, errors.New("letAction must have at least three arguments")
+	}
+	EvalContext := EvalContext{
+		Event:            event,
+		ParticipantState: newState.PState,
+		Configs:          configs,
+	}
+	arg1, err := EvalContext.expressionArgResolver(action.Data[0])
+	if err != nil {
+		return newState, err
+	}
+	varName, ok := arg1.(string)
+	if !ok || varName == "" {
+		return newState, errors.New("could not parse arguments")
+	}
+	value, err := EvalContext.expressionArgResolver(action.Data[1])
+	if err != nil {
+		return newState, err
+	}
+
+	scopeConfigs := configs.withVariable(varName, value)
+	for _, a := range action.Data[2:] {
+		if !a.IsExpression() {
+			continue
+		}
+		newState, err = ActionEval(*a.Exp, newState, event, scopeConfigs)
+		if err != nil {
+			return newState, err
+		}
+	}
+	return
+}
+
+// let evaluates the value once and returns the result of the expression evaluated with the value bound to the variable
+func (ctx EvalContext) let(exp types.Expression) (val interface{}, err error) {
+	if len(exp.Data) != 3 {
+		return val, errors.New("unexpected numbers of arguments")
+	}
+	arg1, err := ctx.expressionArgResolver(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	varName, ok := arg1.(string)
+	if !ok || varName == "" {
+		return val, errors.New("could not cast argument")
+	}
+	value, err := ctx.expressionArgResolver(exp.Data[1])
+	if err != nil {
+		return val, err
+	}
+
+	scopeCtx := ctx
+	scopeCtx.Configs = ctx.Configs.withVariable(varName, value)
+	return scopeCtx.expressionArgResolver(exp.Data[2])
+}
+
+// getVar returns the value bound to the variable in the current scope
+func (ctx EvalContext) getVar(exp types.Expression) (val interface{}, err error) {
+	if len(exp.Data) != 1 {
+		return val, errors.New("unexpected numbers of arguments")
+	}
+	arg1, err := ctx.expressionArgResolver(exp.Data[0])
+	if err != nil {
+		return val, err
+	}
+	name, ok := arg1.(string)
+	if !ok {
+		return val, errors.New("could not cast argument")
+	}
+	val, ok = ctx.Configs.Variables[name]
+	if !ok {
+		return nil, fmt.Errorf("variable not defined: %s", name)
+	}
+	return val, nil
+}
@@ -0,0 +1,169 @@
+package studyengine
+
+import (
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestForEachAction(t *testing.T) {
+	getVar := func(name string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getVar", Data: []types.ExpressionArg{
+			{DType: "str", Str: name},
+		}}}
+	}
+	event := types.StudyEvent{
+		Type: "SUBMIT",
+		Response: types.SurveyResponse{
+			Key: "weekly",
+			Responses: []types.SurveyItemResponse{
+				{Key: "weekly.Q1", Response: &types.ResponseItem{
+					Key: "rg", Items: []*types.ResponseItem{
+						{Key: "mcg", Items: []*types.ResponseItem{{Key: "fever"}, {Key: "cough"}}},
+					},
+				}},
+			},
+		},
+	}
+
+	t.Run("over selected keys", func(t *testing.T) {
+		action := types.Expression{Name: "FOREACH", Data: []types.ExpressionArg{
+			{DType: "str", Str: "symptom"},
+			{DType: "exp", Exp: &types.Expression{Name: "getSelectedKeys", Data: []types.ExpressionArg{
+				{DType: "str", Str: "weekly.Q1"},
+				{DType: "str", Str: "rg.mcg"},
+			}}},
+			{DType: "exp", Exp: &types.Expression{Name: "ADD_NEW_SURVEY", Data: []types.ExpressionArg{
+				getVar("symptom"),
+				{DType: "num", Num: 0},
+				{DType: "num", Num: 0},
+				{DType: "str", Str: "normal"},
+			}}},
+		}}
+		if errs := ValidateStudyRules([]types.Expression{action}); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+		newState, err := ActionEval(action, ActionData{}, event, ActionConfigs{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		surveys := newState.PState.AssignedSurveys
+		if len(surveys) != 2 || surveys[0].SurveyKey != "fever" || surveys[1].SurveyKey != "cough" {
+			t.Errorf("unexpected surveys: %v", surveys)
+		}
+	})
+
+	t.Run("over flag keys and literal list", func(t *testing.T) {
+		action := types.Expression{Name: "FOREACH", Data: []types.ExpressionArg{
+			{DType: "str", Str: "flag"},
+			{DType: "exp", Exp: &types.Expression{Name: "getParticipantFlagKeys", Data: []types.ExpressionArg{
+				{DType: "str", Str: "symptom_"},
+			}}},
+			{DType: "exp", Exp: &types.Expression{Name: "FOREACH", Data: []types.ExpressionArg{
+				{DType: "str", Str: "suffix"},
+				{DType: "str", Str: "a;;b"},
+				{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+					getVar("flag"),
+					getVar("suffix"),
+				}}},
+			}}},
+		}}
+		oldState := ActionData{PState: types.ParticipantState{Flags: map[string]string{
+			"symptom_fever": "",
+			"symptom_cough": "",
+			"other":         "",
+		}}}
+		newState, err := ActionEval(action, oldState, types.StudyEvent{}, ActionConfigs{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		flags := newState.PState.Flags
+		if flags["symptom_fever"] != "b" || flags["symptom_cough"] != "b" || flags["other"] != "" {
+			t.Errorf("unexpected flags: %v", flags)
+		}
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		action := types.Expression{Name: "FOREACH", Data: []types.ExpressionArg{
+			{DType: "str", Str: "item"},
+			{DType: "str", Str: ""},
+			{DType: "exp", Exp: &types.Expression{Name: "UNKNOWN_ACTION"}},
+		}}
+		if _, err := ActionEval(action, ActionData{}, types.StudyEvent{}, ActionConfigs{}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("variable outside of scope", func(t *testing.T) {
+		_, err := ExpressionEval(*getVar("item").Exp, EvalContext{})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestLet(t *testing.T) {
+	getVar := func(name string) types.ExpressionArg {
+		return types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "getVar", Data: []types.ExpressionArg{
+			{DType: "str", Str: name},
+		}}}
+	}
+	updateFlag := func(key string, value types.ExpressionArg) types.ExpressionArg {
+		return types.ExpressionArg{DType: "exp", Exp: &types.Expression{Name: "UPDATE_FLAG", Data: []types.ExpressionArg{
+			{DType: "str", Str: key},
+			value,
+		}}}
+	}
+
+	t.Run("LET action", func(t *testing.T) {
+		action := types.Expression{Name: "LET", Data: []types.ExpressionArg{
+			{DType: "str", Str: "group"},
+			{DType: "exp", Exp: &types.Expression{Name: "getParticipantFlagValue", Data: []types.ExpressionArg{
+				{DType: "str", Str: "group"},
+			}}},
+			updateFlag("copy", getVar("group")),
+			{DType: "exp", Exp: &types.Expression{Name: "LET", Data: []types.ExpressionArg{
+				{DType: "str", Str: "group"},
+				{DType: "str", Str: "inner"},
+				updateFlag("inner", getVar("group")),
+			}}},
+			updateFlag("outer", getVar("group")),
+		}}
+		if errs := ValidateStudyRules([]types.Expression{action}); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+		oldState := ActionData{PState: types.ParticipantState{Flags: map[string]string{"group": "A"}}}
+		newState, err := ActionEval(action, oldState, types.StudyEvent{}, ActionConfigs{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		flags := newState.PState.Flags
+		if flags["copy"] != "A" || flags["inner"] != "inner" || flags["outer"] != "A" {
+			t.Errorf("unexpected flags: %v", flags)
+		}
+	})
+
+	t.Run("let expression", func(t *testing.T) {
+		exp := types.Expression{Name: "let", Data: []types.ExpressionArg{
+			{DType: "str", Str: "score"},
+			{DType: "exp", Exp: &types.Expression{Name: "sum", Data: []types.ExpressionArg{
+				{DType: "num", Num: 2},
+				{DType: "num", Num: 3},
+			}}},
+			{DType: "exp", Exp: &types.Expression{Name: "sum", Data: []types.ExpressionArg{
+				getVar("score"),
+				getVar("score"),
+			}}},
+		}}
+		if errs := ValidateExpression(exp, "exp"); len(errs) > 0 {
+			t.Errorf("unexpected validation errors: %v", errs)
+		}
+		val, err := ExpressionEval(exp, EvalContext{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if val.(float64) != 10 {
+			t.Errorf("unexpected value: %v", val)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package studytimer
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/study-service/pkg/dbs/studydb"
+	"github.com/influenzanet/study-service/pkg/studyengine"
+	"github.com/influenzanet/study-service/pkg/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultExternalEventMaxAttempts  = 10
+	externalEventRetryBaseDelay      = 30       // seconds, doubled for every failed attempt
+	externalEventRetryMaxDelay       = 6 * 3600 // seconds
+	externalEventDeliveryLockSeconds = 5 * 60   // other workers skip an event for this time while it is being delivered
+	externalEventDeliveryTimeout     = 4 * 60   // seconds, shorter than the lock, so an event is not delivered by two workers at once
+	maxExternalEventsPerStudyAndRun  = 100
+	externalEventPurgeInterval       = 3600 // seconds between two deletions of delivered events
+)
+
+// ExternalEventDeliveryService delivers the external events of the outbox in the background
+type ExternalEventDeliveryService struct {
+	globalDBService             *globaldb.GlobalDBService
+	studyDBService              *studydb.StudyDBService
+	studyEngineExternalServices []types.ExternalService
+	DeliveryInterval            int // seconds
+	RetentionDays               int // delivered events are deleted after this time
+	lastPurge                   int64
+}
+
+func NewExternalEventDeliveryService(config types.StudyConfig, studyDBServ *studydb.StudyDBService, globalDBServ *globaldb.GlobalDBService, studyEngineExternalServices []types.ExternalService) *ExternalEventDeliveryService {
+	return &ExternalEventDeliveryService{
+		globalDBService:             globalDBServ,
+		studyDBService:              studyDBServ,
+		studyEngineExternalServices: studyEngineExternalServices,
+		DeliveryInterval:            config.ExternalEventDeliveryInterval,
+		RetentionDays:               config.ExternalEventRetentionDays,
+	}
+}
+
+func (s *ExternalEventDeliveryService) Run() {
+	go s.startDeliveryThread(s.DeliveryInterval)
+}
+
+func (s *ExternalEventDeliveryService) startDeliveryThread(interval int) {
+	for {
+		<-time.After(time.Duration(interval) * time.Second)
+		s.DeliverDueExternalEvents()
+	}
+}
+
+// DeliverDueExternalEvents tries to deliver the pending events of all studies, which are due
+func (s *ExternalEventDeliveryService) DeliverDueExternalEvents() {
+	instances, err := s.globalDBService.GetAllInstances()
+	if err != nil {
+		logger.Error.Printf("unexpected error: %s", err.Error())
+	}
+	now := time.Now().Unix()
+	purge := s.RetentionDays > 0 && now-s.lastPurge >= externalEventPurgeInterval
+	if purge {
+		s.lastPurge = now
+	}
+	for _, instance := range instances {
+		studies, err := s.studyDBService.GetStudiesByStatus(instance.InstanceID, "", true)
+		if err != nil {
+			logger.Error.Printf("unexpected error: %s", err.Error())
+			continue
+		}
+		for _, study := range studies {
+			s.deliverDueExternalEventsForStudy(instance.InstanceID, study.Key)
+			if purge {
+				s.deleteDeliveredExternalEvents(instance.InstanceID, study.Key, now)
+			}
+		}
+	}
+}
+
+// deleteDeliveredExternalEvents removes the events delivered more than RetentionDays ago from the outbox
+func (s *ExternalEventDeliveryService) deleteDeliveredExternalEvents(instanceID string, studyKey string, now int64) {
+	count, err := s.studyDBService.DeleteDeliveredExternalEvents(instanceID, studyKey, now-int64(s.RetentionDays)*24*3600)
+	if err != nil {
+		logger.Error.Printf("unexpected error when deleting delivered external events (%s, %s): %v", instanceID, studyKey, err)
+		return
+	}
+	if count > 0 {
+		logger.Info.Printf("%d delivered external events deleted from the outbox of %s (%s)", count, studyKey, instanceID)
+	}
+}
+
+func (s *ExternalEventDeliveryService) deliverDueExternalEventsForStudy(instanceID string, studyKey string) {
+	for i := 0; i < maxExternalEventsPerStudyAndRun; i++ {
+		now := time.Now().Unix()
+		entry, err := s.studyDBService.ClaimDueExternalEvent(instanceID, studyKey, now, externalEventDeliveryLockSeconds)
+		if err != nil {
+			if err != mongo.ErrNoDocuments {
+				logger.Error.Printf("unexpected error when reading external event outbox (%s, %s): %v", instanceID, studyKey, err)
+			}
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), externalEventDeliveryTimeout*time.Second)
+		err = studyengine.DeliverExternalEvent(ctx, s.studyEngineExternalServices, entry)
+		cancel()
+		entry = nextExternalEventDeliveryState(entry, err, s.maxAttempts(entry.ServiceName), time.Now().Unix())
+		if err != nil {
+			logger.Error.Printf("delivery of external event %s to '%s' failed (attempt %d): %v", entry.ID.Hex(), entry.ServiceName, entry.Attempts, err)
+		}
+		if err := s.studyDBService.UpdateExternalEventDeliveryState(instanceID, studyKey, entry); err != nil {
+			logger.Error.Printf("unexpected error when updating external event %s: %v", entry.ID.Hex(), err)
+		}
+	}
+}
+
+func (s *ExternalEventDeliveryService) maxAttempts(serviceName string) int {
+	for _, service := range s.studyEngineExternalServices {
+		if service.Name == serviceName && service.MaxAttempts > 0 {
+			return service.MaxAttempts
+		}
+	}
+	return defaultExternalEventMaxAttempts
+}
+
+// nextExternalEventDeliveryState updates the entry after a delivery attempt: delivered, retried with exponential backoff or moved to the dead letters
+func nextExternalEventDeliveryState(entry types.ExternalEventOutboxEntry, deliveryErr error, maxAttempts int, now int64) types.ExternalEventOutboxEntry {
+	entry.Attempts += 1
+	if deliveryErr == nil {
+		entry.Status = types.EXTERNAL_EVENT_STATUS_DELIVERED
+		entry.DeliveredAt = now
+		entry.LastError = ""
+		return entry
+	}
+
+	entry.LastError = deliveryErr.Error()
+	if entry.Attempts >= maxAttempts {
+		entry.Status = types.EXTERNAL_EVENT_STATUS_DEAD_LETTER
+		return entry
+	}
+
+	delay := int64(externalEventRetryMaxDelay)
+	if entry.Attempts <= 20 {
+		delay = int64(externalEventRetryBaseDelay) << (entry.Attempts - 1)
+		if delay > externalEventRetryMaxDelay {
+			delay = externalEventRetryMaxDelay
+		}
+	}
+	entry.Status = types.EXTERNAL_EVENT_STATUS_PENDING
+	entry.NextAttemptAt = now + delay
+	return entry
+}
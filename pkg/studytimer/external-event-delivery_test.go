@@ -0,0 +1,48 @@
+package studytimer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influenzanet/study-service/pkg/types"
+)
+
+func TestNextExternalEventDeliveryState(t *testing.T) {
+	entry := types.ExternalEventOutboxEntry{Status: types.EXTERNAL_EVENT_STATUS_PENDING}
+
+	t.Run("delivered", func(t *testing.T) {
+		next := nextExternalEventDeliveryState(entry, nil, 3, 1000)
+		if next.Status != types.EXTERNAL_EVENT_STATUS_DELIVERED || next.DeliveredAt != 1000 || next.Attempts != 1 {
+			t.Errorf("unexpected state: %v", next)
+		}
+	})
+
+	t.Run("retry with backoff", func(t *testing.T) {
+		next := nextExternalEventDeliveryState(entry, errors.New("unavailable"), 3, 1000)
+		if next.Status != types.EXTERNAL_EVENT_STATUS_PENDING || next.NextAttemptAt != 1000+externalEventRetryBaseDelay || next.LastError != "unavailable" {
+			t.Errorf("unexpected state: %v", next)
+		}
+		next = nextExternalEventDeliveryState(next, errors.New("unavailable"), 3, 2000)
+		if next.Status != types.EXTERNAL_EVENT_STATUS_PENDING || next.NextAttemptAt != 2000+2*externalEventRetryBaseDelay {
+			t.Errorf("unexpected state: %v", next)
+		}
+	})
+
+	t.Run("max delay", func(t *testing.T) {
+		failing := entry
+		failing.Attempts = 40
+		next := nextExternalEventDeliveryState(failing, errors.New("unavailable"), 100, 1000)
+		if next.NextAttemptAt != 1000+externalEventRetryMaxDelay {
+			t.Errorf("unexpected state: %v", next)
+		}
+	})
+
+	t.Run("dead letter", func(t *testing.T) {
+		failing := entry
+		failing.Attempts = 2
+		next := nextExternalEventDeliveryState(failing, errors.New("unavailable"), 3, 1000)
+		if next.Status != types.EXTERNAL_EVENT_STATUS_DEAD_LETTER || next.Attempts != 3 {
+			t.Errorf("unexpected state: %v", next)
+		}
+	})
+}
@@ -3,6 +3,7 @@ package studytimer
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/study-service/pkg/dbs/studydb"
@@ -30,12 +31,13 @@ func (s *StudyTimerService) StudyTimerEvent() {
 
 			s.UpdateStudyStats(instance.InstanceID, study.Key)
 			s.UpdateParticipantStates(instance.InstanceID, study)
+			s.CleanUpParticipantStateHistory(instance.InstanceID, study)
 		}
 	}
 }
 
 func (s *StudyTimerService) UpdateParticipantStates(instanceID string, study types.Study) {
-	rules, err := s.studyDBService.GetStudyRules(instanceID, study.Key)
+	rules, err := studyengine.StudyRulesCache.GetStudyRules(s.studyDBService, instanceID, study.Key)
 	if err != nil {
 		logger.Error.Printf("ERROR in UpdateParticipantStates.GetStudyRules (%s, %s): %v", instanceID, study.Key, err)
 		return
@@ -47,13 +49,19 @@ func (s *StudyTimerService) UpdateParticipantStates(instanceID string, study typ
 		StudyKey:   study.Key,
 	}
 
-	if !s.hasRuleForEventType(rules, studyEvent) {
-		logger.Info.Printf("UpdateParticipantStates (%s, %s): has no timer related rules, skipped.", instanceID, study.Key)
+	// no version, if the rules are stored in the study (old model)
+	rulesVersionID := rules.VersionID
+
+	ctx := context.Background()
+	if !s.hasRuleForEventType(rules.Rules, studyEvent) {
+		logger.Info.Printf("UpdateParticipantStates (%s, %s): has no timer related rules, only due scheduled actions are performed.", instanceID, study.Key)
+		if err := s.studyDBService.FindAndExecuteOnParticipantsWithDueScheduledActions(ctx, instanceID, study.Key, studyengine.Now().Unix(), s.getAndUpdateParticipantState, &studyengine.CachedStudyRules{VersionID: rulesVersionID}, studyEvent, study, rulesVersionID); err != nil {
+			logger.Error.Printf("ERROR in UpdateParticipantStates.FindAndExecuteOnParticipantsWithDueScheduledActions (%s, %s): %v", instanceID, study.Key, err)
+		}
 		return
 	}
 
-	ctx := context.Background()
-	if err := s.studyDBService.FindAndExecuteOnParticipantsStates(ctx, instanceID, study.Key, types.STUDY_STATUS_ACTIVE, s.getAndUpdateParticipantState, rules, studyEvent, study); err != nil {
+	if err := s.studyDBService.FindAndExecuteOnParticipantsStates(ctx, instanceID, study.Key, types.STUDY_STATUS_ACTIVE, s.getAndUpdateParticipantState, rules, studyEvent, study, rulesVersionID); err != nil {
 		logger.Error.Printf("ERROR in UpdateParticipantStates.FindAndExecuteOnParticipantsStates (%s, %s): %v", instanceID, study.Key, err)
 	}
 }
@@ -65,16 +73,17 @@ func (s *StudyTimerService) getAndUpdateParticipantState(
 	studyKey string,
 	args ...interface{},
 ) (err error) {
-	if len(args) != 3 {
+	if len(args) != 4 {
 		err = errors.New("unexpected number of args")
 		logger.Error.Printf("ERROR in getAndUpdateParticipantState: %v", err)
 		return
 	}
-	rules := args[0].([]types.Expression)
+	rules := args[0].(*studyengine.CachedStudyRules)
 	studyEvent := args[1].(types.StudyEvent)
 	studyEvent.StudyKey = studyKey
 	studyEvent.InstanceID = instanceID
 	study := args[2].(types.Study)
+	rulesVersionID := args[3].(string)
 
 	participantID2, err := utils.ProfileIDtoParticipantID(pState.ParticipantID, s.studyGlobalSecret, study.SecretKey, study.Configs.IdMappingMethod)
 	if err != nil {
@@ -83,27 +92,52 @@ func (s *StudyTimerService) getAndUpdateParticipantState(
 	}
 	studyEvent.ParticipantIDForConfidentialResponses = participantID2
 
+	oldState := studyengine.CopyParticipantState(pState)
 	actionState := studyengine.ActionData{
 		PState:          pState,
 		ReportsToCreate: map[string]types.Report{},
 	}
-
-	for _, rule := range rules {
-		actionState, err = studyengine.ActionEval(rule, actionState, studyEvent, studyengine.ActionConfigs{
-			DBService:              s.studyDBService,
-			ExternalServiceConfigs: s.studyEngineExternalServices,
-		})
-		if err != nil {
-			logger.Error.Printf("ERROR in getAndUpdateParticipantState.ActionEval (%s, %s): %v", instanceID, studyKey, err)
-			continue
+	// lookups are cached and the limits of the evaluation apply for the participant, shared by the scheduled actions and the rules
+	actionConfigs, _ := studyengine.WithEventDBCache(studyengine.ActionConfigs{
+		DBService:              s.studyDBService,
+		ExternalServiceConfigs: s.studyEngineExternalServices,
+	})
+	actionConfigs, cancel := studyengine.WithEvalBudget(context.Background(), actionConfigs)
+	defer cancel()
+
+	// scheduled actions are performed before the timer rules, so rules see their effect
+	actionState, ruleErrors := studyengine.RunDueScheduledActions(actionState, studyEvent, actionConfigs, studyengine.Now().Unix(), study.Configs.RuleErrorPolicy)
+	if len(ruleErrors) == 0 || study.Configs.RuleErrorPolicy != types.RULE_ERROR_POLICY_ALL_OR_NOTHING {
+		var rulesErrors []studyengine.RuleError
+		actionState, rulesErrors = studyengine.PerformRules(rules.Rules, actionState, studyEvent, actionConfigs, study.Configs.RuleErrorPolicy)
+		if len(rulesErrors) > 0 && study.Configs.RuleErrorPolicy == types.RULE_ERROR_POLICY_ALL_OR_NOTHING {
+			// the scheduled actions are rolled back with the rules, due entries stay scheduled for the next run
+			studyengine.CommitRandomizationSlots(studyDBServ, instanceID, studyKey, actionState.RandomizationSlots, false)
+			actionState = studyengine.ActionData{
+				PState:          studyengine.CopyParticipantState(oldState),
+				ReportsToCreate: map[string]types.Report{},
+			}
 		}
+		ruleErrors = append(ruleErrors, rulesErrors...)
+	}
+	if len(ruleErrors) > 0 {
+		studyengine.ReportRuleErrors(ruleErrors, pState.ParticipantID, studyEvent, actionConfigs, study.Configs.RuleErrorPolicy, rulesVersionID)
 	}
 
 	// save state back to DB
 	_, err = studyDBServ.SaveParticipantState(instanceID, studyKey, actionState.PState)
+	studyengine.CommitRandomizationSlots(studyDBServ, instanceID, studyKey, actionState.RandomizationSlots, err == nil)
 	if err != nil {
 		logger.Error.Printf("unexpected error when saving participant state: %v", err)
 	}
+	if err == nil && study.Configs.ParticipantStateHistory.Enabled {
+		entry := types.NewParticipantStateHistoryEntry(oldState, actionState.PState, types.PARTICIPANT_STATE_HISTORY_EVENT_TIMER, "", rulesVersionID, time.Now().Unix())
+		if !entry.Diff.IsEmpty() {
+			if err := studyDBServ.AddParticipantStateHistoryEntry(instanceID, studyKey, entry); err != nil {
+				logger.Error.Printf("unexpected error when saving participant state history: %v", err)
+			}
+		}
+	}
 
 	for _, report := range actionState.ReportsToCreate {
 		report.ResponseID = "TIMER"
@@ -117,6 +151,22 @@ func (s *StudyTimerService) getAndUpdateParticipantState(
 	return err
 }
 
+// CleanUpParticipantStateHistory removes the history entries older than the retention period of the study
+func (s *StudyTimerService) CleanUpParticipantStateHistory(instanceID string, study types.Study) {
+	retentionDays := study.Configs.ParticipantStateHistory.RetentionDays
+	if retentionDays <= 0 {
+		return
+	}
+	count, err := s.studyDBService.DeleteParticipantStateHistoryOlderThan(instanceID, study.Key, time.Now().Unix()-retentionDays*24*60*60)
+	if err != nil {
+		logger.Error.Printf("DB ERROR for cleaning up participant state history for study: %s -> %s", study.Key, err.Error())
+		return
+	}
+	if count > 0 {
+		logger.Info.Printf("removed %d participant state history entries for study: %s - %s", count, instanceID, study.Key)
+	}
+}
+
 func (s *StudyTimerService) hasRuleForEventType(rules []types.Expression, event types.StudyEvent) bool {
 	for _, rule := range rules {
 		if len(rule.Data) < 1 {
@@ -9,18 +9,46 @@ type DBConfig struct {
 }
 
 type StudyConfig struct {
-	GlobalSecret               string // GlobalSecret is used, with studySecret to create the participantID from user profileID,
-	TimerEventFrequency        int64  // how often the timer event should be performed (only from one instance of the service) - seconds
-	TimerEventCheckIntervalMin int    // approx. how often this serice should check if to perform the timer event - seconds
-	TimerEventCheckIntervalVar int    // range of the uniform random distribution - varying the check interval to avoid a steady collisions
+	GlobalSecret                  string // GlobalSecret is used, with studySecret to create the participantID from user profileID,
+	TimerEventFrequency           int64  // how often the timer event should be performed (only from one instance of the service) - seconds
+	TimerEventCheckIntervalMin    int    // approx. how often this serice should check if to perform the timer event - seconds
+	TimerEventCheckIntervalVar    int    // range of the uniform random distribution - varying the check interval to avoid a steady collisions
+	ExternalEventDeliveryInterval int    // how often the outbox is checked for external events to deliver - seconds
+	ExternalEventRetentionDays    int    // delivered external events are deleted from the outbox after this time, 0 keeps them
 }
 
+// EvalLimits bound the evaluation of the study rules for one event and participant, zero disables a limit
+type EvalLimits struct {
+	MaxDepth       int // nesting depth of actions and expressions
+	MaxNodes       int // number of evaluated actions and expressions
+	TimeoutSeconds int // wall-clock time, including calls to external services
+}
+
+// transport used to call an external service
+const (
+	EXTERNAL_SERVICE_PROTOCOL_HTTP = "http" // default, POST of the JSON payload to the url
+	EXTERNAL_SERVICE_PROTOCOL_GRPC = "grpc" // ExternalStudyEngineService (api/external_study_engine) at the url (host:port)
+)
+
 type ExternalService struct {
-	Name            string           `yaml:"name"`
-	URL             string           `yaml:"url"`
-	APIKey          string           `yaml:"apiKey"`
-	Timeout         int              `yaml:"timeout"`
-	MutualTLSConfig *MutualTLSConfig `yaml:"mTLSConfig"`
+	Name            string                `yaml:"name"`
+	URL             string                `yaml:"url"`
+	Protocol        string                `yaml:"protocol"` // http (default) or grpc
+	APIKey          string                `yaml:"apiKey"`
+	SigningSecret   string                `yaml:"signingSecret"` // if set, requests are signed with HMAC-SHA256 over "<timestamp>.<body>", http only
+	Timeout         int                   `yaml:"timeout"`
+	StrictReply     bool                  `yaml:"strictReply"` // reject replies with unknown fields, otherwise they are ignored
+	MutualTLSConfig *MutualTLSConfig      `yaml:"mTLSConfig"`
+	Async           bool                  `yaml:"async"`       // events are written to the outbox and delivered in the background, the reply is ignored
+	MaxAttempts     int                   `yaml:"maxAttempts"` // for async delivery, before the event is moved to the dead letters (default 10)
+	CircuitBreaker  *CircuitBreakerConfig `yaml:"circuitBreaker"`
+}
+
+// CircuitBreakerConfig sets when calls to an external service are stopped after failures
+type CircuitBreakerConfig struct {
+	Disabled         bool `yaml:"disabled"`
+	FailureThreshold int  `yaml:"failureThreshold"` // consecutive failures opening the breaker (default 5)
+	OpenSeconds      int  `yaml:"openSeconds"`      // calls are rejected for this time, before a trial call is let through (default 30)
 }
 
 type MutualTLSConfig struct {
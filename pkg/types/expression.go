@@ -9,6 +9,7 @@ type Expression struct {
 	Name       string          `bson:"name" json:"name"` // Name of the operation to be evaluated
 	ReturnType string          `bson:"returnType,omitempty" json:"returnType,omitempty"`
 	Data       []ExpressionArg `bson:"data,omitempty" json:"data,omitempty"` // Operation arguments
+	Compiled   interface{}     `bson:"-" json:"-"`                           // resolved handler, set when the study engine compiles the rules
 }
 
 type ExpressionArg struct {
@@ -16,6 +17,7 @@ type ExpressionArg struct {
 	Exp   *Expression `bson:"exp,omitempty" json:"exp,omitempty"`
 	Str   string      `bson:"str,omitempty" json:"str,omitempty"`
 	Num   float64     `bson:"num,omitempty" json:"num,omitempty"`
+	Value interface{} `bson:"-" json:"-"` // value of a literal argument, set when the study engine compiles the rules
 }
 
 func (e *ExpressionArg) ToAPI() *api.ExpressionArg {
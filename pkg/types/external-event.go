@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/influenzanet/study-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// delivery status of external events in the outbox
+const (
+	EXTERNAL_EVENT_STATUS_PENDING     = "pending"
+	EXTERNAL_EVENT_STATUS_DELIVERED   = "delivered"
+	EXTERNAL_EVENT_STATUS_DEAD_LETTER = "deadLetter" // max attempts reached, only delivered again if re-driven
+)
+
+// ExternalEventPayload is the body sent to external services by EXTERNAL_EVENT_HANDLER
+type ExternalEventPayload struct {
+	ParticipantState ParticipantState `bson:"participantState" json:"participantState"`
+	EventType        string           `bson:"eventType" json:"eventType"`
+	StudyKey         string           `bson:"studyKey" json:"studyKey"`
+	InstanceID       string           `bson:"instanceID" json:"instanceID"`
+	Response         SurveyResponse   `bson:"surveyResponses" json:"surveyResponses"`
+}
+
+// ExternalEventOutboxEntry is an external event waiting for (or done with) asynchronous delivery
+type ExternalEventOutboxEntry struct {
+	ID            primitive.ObjectID   `bson:"_id,omitempty" json:"id,omitempty"`
+	ServiceName   string               `bson:"serviceName" json:"serviceName"`
+	Route         string               `bson:"route,omitempty" json:"route,omitempty"` // appended to the URL of the service
+	Payload       ExternalEventPayload `bson:"payload" json:"payload"`
+	Status        string               `bson:"status" json:"status"`
+	Attempts      int                  `bson:"attempts" json:"attempts"`
+	NextAttemptAt int64                `bson:"nextAttemptAt" json:"nextAttemptAt"`
+	LastError     string               `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	CreatedAt     int64                `bson:"createdAt" json:"createdAt"`
+	DeliveredAt   int64                `bson:"deliveredAt,omitempty" json:"deliveredAt,omitempty"`
+}
+
+func (e ExternalEventOutboxEntry) ToAPI() *api.ExternalEventOutboxEntry {
+	return &api.ExternalEventOutboxEntry{
+		Id:            e.ID.Hex(),
+		ServiceName:   e.ServiceName,
+		Route:         e.Route,
+		EventType:     e.Payload.EventType,
+		ParticipantId: e.Payload.ParticipantState.ParticipantID,
+		Status:        e.Status,
+		Attempts:      int32(e.Attempts),
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     e.LastError,
+		CreatedAt:     e.CreatedAt,
+		DeliveredAt:   e.DeliveredAt,
+	}
+}
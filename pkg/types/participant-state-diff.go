@@ -0,0 +1,195 @@
+package types
+
+import "github.com/influenzanet/study-service/pkg/api"
+
+// ParticipantStateDiff describes what changed between two versions of a participant state
+type ParticipantStateDiff struct {
+	StudyStatus             *ValueChange           `bson:"studyStatus,omitempty" json:"studyStatus,omitempty"`
+	Flags                   map[string]ValueChange `bson:"flags,omitempty" json:"flags,omitempty"`
+	AddedSurveys            []AssignedSurvey       `bson:"addedSurveys,omitempty" json:"addedSurveys,omitempty"`
+	RemovedSurveys          []AssignedSurvey       `bson:"removedSurveys,omitempty" json:"removedSurveys,omitempty"`
+	AddedMessages           []ParticipantMessage   `bson:"addedMessages,omitempty" json:"addedMessages,omitempty"`
+	RemovedMessages         []ParticipantMessage   `bson:"removedMessages,omitempty" json:"removedMessages,omitempty"`
+	AddedScheduledActions   []ScheduledAction      `bson:"addedScheduledActions,omitempty" json:"addedScheduledActions,omitempty"`
+	RemovedScheduledActions []ScheduledAction      `bson:"removedScheduledActions,omitempty" json:"removedScheduledActions,omitempty"`
+}
+
+// ValueChange holds the value before and after the change - nil means the value did not exist
+type ValueChange struct {
+	Before *string `bson:"before,omitempty" json:"before,omitempty"`
+	After  *string `bson:"after,omitempty" json:"after,omitempty"`
+}
+
+func (c ValueChange) ToAPI() *api.ValueChange {
+	change := &api.ValueChange{
+		Added:   c.Before == nil,
+		Removed: c.After == nil,
+	}
+	if c.Before != nil {
+		change.Before = *c.Before
+	}
+	if c.After != nil {
+		change.After = *c.After
+	}
+	return change
+}
+
+func (d ParticipantStateDiff) ToAPI() *api.ParticipantStateDiff {
+	diff := &api.ParticipantStateDiff{
+		Flags:                   make(map[string]*api.ValueChange, len(d.Flags)),
+		AddedSurveys:            make([]*api.AssignedSurvey, len(d.AddedSurveys)),
+		RemovedSurveys:          make([]*api.AssignedSurvey, len(d.RemovedSurveys)),
+		AddedMessages:           make([]*api.ParticipantMessage, len(d.AddedMessages)),
+		RemovedMessages:         make([]*api.ParticipantMessage, len(d.RemovedMessages)),
+		AddedScheduledActions:   make([]*api.ScheduledAction, len(d.AddedScheduledActions)),
+		RemovedScheduledActions: make([]*api.ScheduledAction, len(d.RemovedScheduledActions)),
+	}
+	if d.StudyStatus != nil {
+		diff.StudyStatus = d.StudyStatus.ToAPI()
+	}
+	for k, v := range d.Flags {
+		diff.Flags[k] = v.ToAPI()
+	}
+	for i, s := range d.AddedSurveys {
+		diff.AddedSurveys[i] = s.ToAPI()
+	}
+	for i, s := range d.RemovedSurveys {
+		diff.RemovedSurveys[i] = s.ToAPI()
+	}
+	for i, m := range d.AddedMessages {
+		diff.AddedMessages[i] = m.ToAPI()
+	}
+	for i, m := range d.RemovedMessages {
+		diff.RemovedMessages[i] = m.ToAPI()
+	}
+	for i, sa := range d.AddedScheduledActions {
+		diff.AddedScheduledActions[i] = sa.ToAPI()
+	}
+	for i, sa := range d.RemovedScheduledActions {
+		diff.RemovedScheduledActions[i] = sa.ToAPI()
+	}
+	return diff
+}
+
+// DiffParticipantStates computes the changes needed to go from oldState to newState
+func DiffParticipantStates(oldState ParticipantState, newState ParticipantState) ParticipantStateDiff {
+	diff := ParticipantStateDiff{}
+
+	if oldState.StudyStatus != newState.StudyStatus {
+		before := oldState.StudyStatus
+		after := newState.StudyStatus
+		diff.StudyStatus = &ValueChange{Before: &before, After: &after}
+	}
+
+	for k, v := range oldState.Flags {
+		before := v
+		newV, ok := newState.Flags[k]
+		if !ok {
+			diff.addFlagChange(k, ValueChange{Before: &before})
+			continue
+		}
+		if newV != v {
+			after := newV
+			diff.addFlagChange(k, ValueChange{Before: &before, After: &after})
+		}
+	}
+	for k, v := range newState.Flags {
+		if _, ok := oldState.Flags[k]; ok {
+			continue
+		}
+		after := v
+		diff.addFlagChange(k, ValueChange{After: &after})
+	}
+
+	diff.RemovedSurveys, diff.AddedSurveys = diffAssignedSurveys(oldState.AssignedSurveys, newState.AssignedSurveys)
+	diff.RemovedMessages, diff.AddedMessages = diffMessages(oldState.Messages, newState.Messages)
+	diff.RemovedScheduledActions, diff.AddedScheduledActions = diffScheduledActions(oldState.ScheduledActions, newState.ScheduledActions)
+	return diff
+}
+
+// IsEmpty returns true if the diff does not contain any changes
+func (d ParticipantStateDiff) IsEmpty() bool {
+	return d.StudyStatus == nil &&
+		len(d.Flags) == 0 &&
+		len(d.AddedSurveys) == 0 &&
+		len(d.RemovedSurveys) == 0 &&
+		len(d.AddedMessages) == 0 &&
+		len(d.RemovedMessages) == 0 &&
+		len(d.AddedScheduledActions) == 0 &&
+		len(d.RemovedScheduledActions) == 0
+}
+
+func (d *ParticipantStateDiff) addFlagChange(key string, change ValueChange) {
+	if d.Flags == nil {
+		d.Flags = map[string]ValueChange{}
+	}
+	d.Flags[key] = change
+}
+
+// diffAssignedSurveys compares the two lists as multisets, so order changes are not reported
+func diffAssignedSurveys(oldList []AssignedSurvey, newList []AssignedSurvey) (removed []AssignedSurvey, added []AssignedSurvey) {
+	matched := make([]bool, len(newList))
+	for _, o := range oldList {
+		found := false
+		for i, n := range newList {
+			if !matched[i] && o == n {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, o)
+		}
+	}
+	for i, n := range newList {
+		if !matched[i] {
+			added = append(added, n)
+		}
+	}
+	return
+}
+
+func diffMessages(oldList []ParticipantMessage, newList []ParticipantMessage) (removed []ParticipantMessage, added []ParticipantMessage) {
+	matched := make([]bool, len(newList))
+	for _, o := range oldList {
+		found := false
+		for i, n := range newList {
+			if !matched[i] && o == n {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, o)
+		}
+	}
+	for i, n := range newList {
+		if !matched[i] {
+			added = append(added, n)
+		}
+	}
+	return
+}
+
+// diffScheduledActions compares by ID, since scheduled actions are not modified after creation
+func diffScheduledActions(oldList []ScheduledAction, newList []ScheduledAction) (removed []ScheduledAction, added []ScheduledAction) {
+	oldIDs := map[string]bool{}
+	for _, o := range oldList {
+		oldIDs[o.ID] = true
+	}
+	newIDs := map[string]bool{}
+	for _, n := range newList {
+		newIDs[n.ID] = true
+		if !oldIDs[n.ID] {
+			added = append(added, n)
+		}
+	}
+	for _, o := range oldList {
+		if !newIDs[o.ID] {
+			removed = append(removed, o)
+		}
+	}
+	return
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"github.com/influenzanet/study-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// event types recorded for state changes not caused by a study event
+const (
+	PARTICIPANT_STATE_HISTORY_EVENT_CUSTOM_RULES      = "CUSTOM_RULES"      // custom rules run by researchers
+	PARTICIPANT_STATE_HISTORY_EVENT_CONVERT_TEMPORARY = "CONVERT_TEMPORARY" // temporary participant converted to a new participant
+	PARTICIPANT_STATE_HISTORY_EVENT_TIMER             = "TIMER"             // scheduled actions and status updates of the study timer
+)
+
+// ParticipantStateHistoryConfig enables recording the changes of participant states for a study
+type ParticipantStateHistoryConfig struct {
+	Enabled       bool  `bson:"enabled" json:"enabled"`
+	RetentionDays int64 `bson:"retentionDays,omitempty" json:"retentionDays,omitempty"` // entries older than this are removed by the study timer, 0 keeps them
+}
+
+func (c ParticipantStateHistoryConfig) ToAPI() *api.ParticipantStateHistoryConfig {
+	return &api.ParticipantStateHistoryConfig{
+		Enabled:       c.Enabled,
+		RetentionDays: c.RetentionDays,
+	}
+}
+
+func ParticipantStateHistoryConfigFromAPI(c *api.ParticipantStateHistoryConfig) ParticipantStateHistoryConfig {
+	if c == nil {
+		return ParticipantStateHistoryConfig{}
+	}
+	return ParticipantStateHistoryConfig{
+		Enabled:       c.Enabled,
+		RetentionDays: c.RetentionDays,
+	}
+}
+
+// ParticipantStateHistoryEntry records one change of a participant state and the event causing it
+type ParticipantStateHistoryEntry struct {
+	ID             primitive.ObjectID   `bson:"_id,omitempty" json:"id,omitempty"`
+	ParticipantID  string               `bson:"participantID" json:"participantID"`
+	Timestamp      int64                `bson:"timestamp" json:"timestamp"`
+	EventType      string               `bson:"eventType" json:"eventType"`
+	SurveyKey      string               `bson:"surveyKey,omitempty" json:"surveyKey,omitempty"`           // for submissions
+	RulesVersionID string               `bson:"rulesVersionID,omitempty" json:"rulesVersionID,omitempty"` // study rules in effect, empty if the rules are stored in the study
+	Diff           ParticipantStateDiff `bson:"diff" json:"diff"`
+}
+
+func (e ParticipantStateHistoryEntry) ToAPI() *api.ParticipantStateHistoryEntry {
+	return &api.ParticipantStateHistoryEntry{
+		Id:             e.ID.Hex(),
+		ParticipantId:  e.ParticipantID,
+		Timestamp:      e.Timestamp,
+		EventType:      e.EventType,
+		SurveyKey:      e.SurveyKey,
+		RulesVersionId: e.RulesVersionID,
+		Diff:           e.Diff.ToAPI(),
+	}
+}
+
+// NewParticipantStateHistoryEntry records the changes from oldState to newState
+func NewParticipantStateHistoryEntry(oldState ParticipantState, newState ParticipantState, eventType string, surveyKey string, rulesVersionID string, timestamp int64) ParticipantStateHistoryEntry {
+	return ParticipantStateHistoryEntry{
+		ParticipantID:  newState.ParticipantID,
+		Timestamp:      timestamp,
+		EventType:      eventType,
+		SurveyKey:      surveyKey,
+		RulesVersionID: rulesVersionID,
+		Diff:           DiffParticipantStates(oldState, newState),
+	}
+}
@@ -23,6 +23,30 @@ type ParticipantState struct {
 	AssignedSurveys     []AssignedSurvey     `bson:"assignedSurveys" json:"assignedSurveys"`
 	LastSubmissions     map[string]int64     `bson:"lastSubmission" json:"lastSubmission"` // surveyKey with timestamp
 	Messages            []ParticipantMessage `bson:"messages" json:"messages"`
+	ScheduledActions    []ScheduledAction    `bson:"scheduledActions,omitempty" json:"scheduledActions,omitempty"` // actions performed by the timer once they are due
+}
+
+// ScheduledAction is a list of actions deferred until ExecuteAt, created by the SCHEDULE_ACTIONS action
+type ScheduledAction struct {
+	ID        string       `bson:"id" json:"id"`
+	Label     string       `bson:"label,omitempty" json:"label,omitempty"` // used to cancel pending actions
+	ExecuteAt int64        `bson:"executeAt" json:"executeAt"`
+	Actions   []Expression `bson:"actions" json:"actions"`
+	// values of the variables (LET, FOREACH) when the actions were scheduled, read with getVar when they are performed
+	Variables map[string]interface{} `bson:"variables,omitempty" json:"variables,omitempty"`
+}
+
+func (sa ScheduledAction) ToAPI() *api.ScheduledAction {
+	actions := make([]*api.Expression, len(sa.Actions))
+	for i, a := range sa.Actions {
+		actions[i] = a.ToAPI()
+	}
+	return &api.ScheduledAction{
+		Id:        sa.ID,
+		Label:     sa.Label,
+		ExecuteAt: sa.ExecuteAt,
+		Actions:   actions,
+	}
 }
 
 type ParticipantMessage struct {
@@ -48,6 +72,10 @@ func (p ParticipantState) ToAPI() *api.ParticipantState {
 	for i, s := range p.Messages {
 		messages[i] = s.ToAPI()
 	}
+	scheduledActions := make([]*api.ScheduledAction, len(p.ScheduledActions))
+	for i, sa := range p.ScheduledActions {
+		scheduledActions[i] = sa.ToAPI()
+	}
 
 	return &api.ParticipantState{
 		Id:                  p.ID.Hex(),
@@ -59,5 +87,6 @@ func (p ParticipantState) ToAPI() *api.ParticipantState {
 		AssignedSurveys:     assignedSurveys,
 		LastSubmissions:     p.LastSubmissions,
 		Messages:            messages,
+		ScheduledActions:    scheduledActions,
 	}
 }
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/influenzanet/study-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RandomizationAllocation counts the participants allocated by RANDOMIZE_TO_ARM for one randomization key and stratum
+type RandomizationAllocation struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	RandomizationKey string             `bson:"randomizationKey" json:"randomizationKey"`
+	Stratum          string             `bson:"stratum" json:"stratum"`
+	Seed             int64              `bson:"seed" json:"-"`                            // used to shuffle the blocks, not exposed to keep the allocation sequence concealed
+	Total            int64              `bson:"total" json:"total"`                       // reserved slots
+	Released         []int64            `bson:"released,omitempty" json:"-"`              // slots of participants not saved, reserved again first
+	Counts           map[string]int64   `bson:"counts,omitempty" json:"counts,omitempty"` // participants per arm
+	UpdatedAt        int64              `bson:"updatedAt" json:"updatedAt"`
+}
+
+func (a RandomizationAllocation) ToAPI() *api.RandomizationAllocation {
+	return &api.RandomizationAllocation{
+		RandomizationKey: a.RandomizationKey,
+		Stratum:          a.Stratum,
+		Total:            a.Total - int64(len(a.Released)),
+		Counts:           a.Counts,
+		UpdatedAt:        a.UpdatedAt,
+	}
+}
+
+// RandomizationSlot is an allocation by RANDOMIZE_TO_ARM, reserved in the RandomizationAllocation during the evaluation.
+// It is committed once the participant state is saved and released otherwise.
+type RandomizationSlot struct {
+	RandomizationKey string `json:"randomizationKey"`
+	Stratum          string `json:"stratum"`
+	Slot             int64  `json:"slot"` // position in the allocation sequence, starting at 0
+	Seed             int64  `json:"-"`
+	Arm              string `json:"arm"`
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strconv"
+
 	"github.com/influenzanet/study-service/pkg/api"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -10,13 +12,24 @@ type StudyMessage struct {
 	Type          string             `bson:"type,omitempty"`
 	Payload       map[string]string  `bson:"payload,omitempty"`
 	ParticipantID string             `bson:"participantID,omitempty"`
+	// if set, only one message with this key is saved, further messages increase Count
+	DedupKey string `bson:"dedupKey,omitempty" json:"-"`
+	Count    int64  `bson:"count,omitempty" json:"count,omitempty"`
 }
 
 func (m StudyMessage) ToAPI() *api.StudyMessage {
+	payload := m.Payload
+	if m.Count > 1 {
+		payload = make(map[string]string, len(m.Payload)+1)
+		for k, v := range m.Payload {
+			payload[k] = v
+		}
+		payload["count"] = strconv.FormatInt(m.Count, 10)
+	}
 	return &api.StudyMessage{
 		Id:            m.ID.Hex(),
 		Type:          m.Type,
-		Payload:       m.Payload,
+		Payload:       payload,
 		ParticipantId: m.ParticipantID,
 	}
 }
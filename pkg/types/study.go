@@ -15,6 +15,11 @@ const (
 	STUDY_STATUS_INACTIVE = "inactive"
 )
 
+const (
+	RULE_ERROR_POLICY_SKIP_FAILING_RULE = "skipFailingRule" // default, if not defined
+	RULE_ERROR_POLICY_ALL_OR_NOTHING    = "allOrNothing"
+)
+
 type Study struct {
 	ID                        primitive.ObjectID         `bson:"_id,omitempty" json:"id,omitempty"`
 	Key                       string                     `bson:"key"`
@@ -45,8 +50,10 @@ type StudyProps struct {
 }
 
 type StudyConfigs struct {
-	ParticipantFileUploadRule *Expression `bson:"participantFileUploadRule"`
-	IdMappingMethod           string      `bson:"idMappingMethod"`
+	ParticipantFileUploadRule *Expression                   `bson:"participantFileUploadRule"`
+	IdMappingMethod           string                        `bson:"idMappingMethod"`
+	RuleErrorPolicy           string                        `bson:"ruleErrorPolicy,omitempty"`
+	ParticipantStateHistory   ParticipantStateHistoryConfig `bson:"participantStateHistory,omitempty"`
 }
 
 type StudyStats struct {
@@ -91,6 +98,8 @@ func (s StudyConfigs) ToAPI() *api.Study_Configs {
 	return &api.Study_Configs{
 		ParticipantFileUploadRule: s.ParticipantFileUploadRule.ToAPI(),
 		IdMappingMethod:           s.IdMappingMethod,
+		ParticipantStateHistory:   s.ParticipantStateHistory.ToAPI(),
+		RuleErrorPolicy:           s.RuleErrorPolicy,
 	}
 }
 
@@ -101,6 +110,8 @@ func StudyConfigsFromAPI(s *api.Study_Configs) StudyConfigs {
 	return StudyConfigs{
 		ParticipantFileUploadRule: ExpressionFromAPI(s.ParticipantFileUploadRule),
 		IdMappingMethod:           s.IdMappingMethod,
+		ParticipantStateHistory:   ParticipantStateHistoryConfigFromAPI(s.ParticipantStateHistory),
+		RuleErrorPolicy:           s.RuleErrorPolicy,
 	}
 }
 
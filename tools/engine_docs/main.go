@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/study-service/pkg/studyengine"
+)
+
+// Generates the reference of all registered study actions and expressions from the studyengine registry
+func main() {
+	outputDir := flag.String("out", "docs/reference", "output directory for the generated markdown files")
+	flag.Parse()
+
+	err := os.MkdirAll(*outputDir, os.ModePerm)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+
+	writeFile(filepath.Join(*outputDir, "studyActions.md"), studyengine.WriteActionDocs)
+	writeFile(filepath.Join(*outputDir, "studyExpressions.md"), studyengine.WriteExpressionDocs)
+}
+
+func writeFile(path string, write func(w io.Writer) error) {
+	f, err := os.Create(path)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := write(f); err != nil {
+		logger.Error.Fatal(err)
+	}
+	logger.Info.Printf("written %s", path)
+}
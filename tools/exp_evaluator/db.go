@@ -8,6 +8,7 @@ import(
 
 type MemoryDBService struct {
 	Data []types.SurveyResponse
+	Reports []types.Report
 }
 
 
@@ -57,6 +58,32 @@ func (m MemoryDBService) FindSurveyResponses(instanceID string, studyKey string,
 	return responses, nil
 }
 
+func (m MemoryDBService) FindReports(instanceID string, studyKey string, query studydb.ReportQuery) (reports []types.Report, err error) {
+	reports = make([]types.Report, 0)
+	for _, r := range m.Reports {
+		if query.ParticipantID != "" && query.ParticipantID != r.ParticipantID {
+			continue
+		}
+		if(query.Key != "" && r.Key != query.Key) {
+			continue
+		}
+		if (query.Since > 0 && r.Timestamp <= query.Since) || (query.Until > 0 && r.Timestamp >= query.Until) {
+			continue
+		}
+		reports = append(reports, r)
+	}
+
+	// Sort in reverse order of creation time
+	sort.SliceStable(reports, func(i,j int) bool {
+		return reports[i].Timestamp > reports[j].Timestamp
+	})
+
+	if(query.Limit > 0 && len(reports) > int(query.Limit)) {
+		reports = reports[:query.Limit]
+	}
+	return reports, nil
+}
+
 func (m MemoryDBService) DeleteConfidentialResponses(instanceID string, studyKey string, participantID string, key string) (count int64, err error) {
 	return 0, nil
 }
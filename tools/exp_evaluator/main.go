@@ -15,19 +15,22 @@ type ImportStruct struct {
 	State types.ParticipantState`json:"state"`
 	Rules []types.Expression `json:"rules"`
 	Data []types.SurveyResponse `json:"responses"`	
+	Reports []types.Report `json:"reports"`
+	Trace bool `json:"trace"`
 }
 
 type EvalResult struct {
 	Index int `json:"index"`
 	Data studyengine.ActionData`json:"result"`
 	Error string `json:"error"`
+	Trace []*studyengine.EvalTraceNode `json:"trace,omitempty"`
 }
 
 func Evaluate(input ImportStruct) []EvalResult {
 	instanceID := "dummy"
 	studyKey := "dummy"
 	
-	dbService := MemoryDBService{Data: input.Data}
+	dbService := MemoryDBService{Data: input.Data, Reports: input.Reports}
 	
 	event := types.StudyEvent{
 		InstanceID:                            instanceID,
@@ -43,9 +46,14 @@ func Evaluate(input ImportStruct) []EvalResult {
 	results := make([]EvalResult, 0)
 
 	for index, rule := range input.Rules {
+		var tracer *studyengine.EvalTracer
+		if(input.Trace) {
+			tracer = studyengine.NewEvalTracer()
+		}
 		newState, err := studyengine.ActionEval(rule, actionData, event, studyengine.ActionConfigs{
 			DBService:            dbService ,
 			ExternalServiceConfigs: nil,
+			Tracer: tracer,
 		})
 
 		r := EvalResult{
@@ -55,6 +63,9 @@ func Evaluate(input ImportStruct) []EvalResult {
 		if(err != nil) {
 			r.Error =  fmt.Sprintf("%s", err)
 		}
+		if(tracer != nil) {
+			r.Trace = tracer.Roots
+		}
 
 		results = append(results, r )
 
@@ -113,6 +124,7 @@ type Options struct {
 	Input string
 	Output string
 	server int
+	trace bool
 }
 
 
@@ -120,6 +132,7 @@ func handleFlags() Options {
 	inputJSON := flag.String("input", "", "path and name of the input file that should be converted")
 	outputJSON := flag.String("output", "", "path and name of the input file that should be converted")
 	server := flag.Int("server", 0, "Use server port (0 = disable)")
+	trace := flag.Bool("trace", false, "add the evaluation trace of each rule to the results")
 	flag.Parse()
 	
 	inputFile := *inputJSON
@@ -139,6 +152,7 @@ func handleFlags() Options {
 		Input: inputFile,
 		Output: outputFile,
 		server:serverPort,
+		trace: *trace,
 	}
 }
 
@@ -154,6 +168,7 @@ func main() {
 		fmt.Println("Using files")
 		
 		input := readImportStructFromJSON(opts.Input)
+		input.Trace = input.Trace || opts.trace
 		r := Evaluate(input)
 		saveResultJSON(r, opts.Output)
 	}